@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/backup"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+)
+
+// backupCmd represents the backup command
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Dump all data of the database into a single archive, which restore loads into any supported database",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		output, _ := cmd.Flags().GetString("output")
+
+		c := conf.New()
+		archiver, cleanup, err := initializeArchiver(cmd.Context(), c)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		var counts backup.Counts
+		if output == "" || output == "-" {
+			counts, err = archiver.Backup(cmd.Context(), os.Stdout)
+		} else {
+			var f *os.File
+			if f, err = os.Create(output); err != nil {
+				return err
+			}
+			if counts, err = archiver.Backup(cmd.Context(), f); err != nil {
+				_ = f.Close()
+				return err
+			}
+			err = f.Close()
+		}
+		if err != nil {
+			return err
+		}
+
+		// Counts go to standard error, so they do not end up in an archive written to standard output.
+		printCounts(os.Stderr, "Backed up", counts)
+		return nil
+	},
+}
+
+// restoreCmd represents the restore command
+var restoreCmd = &cobra.Command{
+	Use:   "restore FILE",
+	Short: "Load an archive made by backup into an empty database, applying migrations first",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		c := conf.New()
+		archiver, cleanup, err := initializeArchiver(cmd.Context(), c)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		counts, err := archiver.Restore(cmd.Context(), f)
+		if err != nil {
+			return err
+		}
+
+		printCounts(os.Stdout, "Restored", counts)
+		return nil
+	},
+}
+
+func printCounts(w io.Writer, verb string, counts backup.Counts) {
+	for _, table := range backup.Tables() {
+		_, _ = fmt.Fprintf(w, "%s %d rows of %s\n", verb, counts[table], table)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(backupCmd, restoreCmd)
+
+	backupCmd.Flags().StringP("output", "o", "", "Path of the archive (default standard output)")
+}
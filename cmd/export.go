@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/export"
+	"github.com/spf13/cobra"
+	"net/url"
+	"os"
+)
+
+// exportFilterFlags maps flags of export command to query parameters of the export endpoint, so both filter alike.
+var exportFilterFlags = map[string]string{
+	"wallet":      "walletId",
+	"from":        "createdFrom",
+	"to":          "createdTo",
+	"amount-min":  "amountMin",
+	"amount-max":  "amountMax",
+	"description": "description",
+	"category":    "categoryId",
+	"sign":        "sign",
+}
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export expenses of a wallet to a CSV, JSON or XLSX file",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		email, _ := cmd.Flags().GetString("user")
+		formatName, _ := cmd.Flags().GetString("format")
+		output, _ := cmd.Flags().GetString("output")
+
+		format, err := export.ParseFormat(formatName)
+		if err != nil {
+			return err
+		}
+
+		values := url.Values{}
+		for flag, param := range exportFilterFlags {
+			if v, _ := cmd.Flags().GetString(flag); v != "" {
+				values.Set(param, v)
+			}
+		}
+		values["tagsAny"], _ = cmd.Flags().GetStringSlice("tag-any")
+		values["tagsAll"], _ = cmd.Flags().GetStringSlice("tag-all")
+		arg, err := export.ParseParams(values)
+		if err != nil {
+			return err
+		}
+
+		c := conf.New()
+		exporter, cleanup, err := initializeExporter(cmd.Context(), c)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		user, err := exporter.LookupUser(cmd.Context(), email)
+		if err != nil {
+			return err
+		}
+
+		if output == "" || output == "-" {
+			return exporter.Export(cmd.Context(), os.Stdout, user, format, arg)
+		}
+
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		if err = exporter.Export(cmd.Context(), f, user, format, arg); err != nil {
+			_ = f.Close()
+			return err
+		}
+		return f.Close()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().StringP("wallet", "w", "", "ID of the exported wallet")
+	exportCmd.Flags().StringP("user", "u", "", "Email of the user exporting, who must be able to see the wallet")
+	exportCmd.Flags().StringP("format", "f", string(export.FormatCSV), "File format: csv, json or xlsx")
+	exportCmd.Flags().StringP("output", "o", "", "Path of the exported file (default standard output)")
+	exportCmd.Flags().String("from", "", "Export expenses created at or after this date or RFC3339 time")
+	exportCmd.Flags().String("to", "", "Export expenses created before this date or RFC3339 time")
+	exportCmd.Flags().String("amount-min", "", "Export expenses with amount greater or equal to this one")
+	exportCmd.Flags().String("amount-max", "", "Export expenses with amount less or equal to this one")
+	exportCmd.Flags().String("description", "", "Export expenses whose description contains this text")
+	exportCmd.Flags().String("category", "", "Export expenses in this category or any of its subcategories")
+	exportCmd.Flags().String("sign", "", "Export only positive or negative amounts")
+	exportCmd.Flags().StringSlice("tag-any", nil, "Export expenses having any of these tags")
+	exportCmd.Flags().StringSlice("tag-all", nil, "Export expenses having all of these tags")
+	_ = exportCmd.MarkFlagRequired("wallet")
+	_ = exportCmd.MarkFlagRequired("user")
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/importer"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+)
+
+// importCmd represents the import command
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import expenses from bank statements into a wallet",
+}
+
+// importCSVCmd represents the import csv command
+var importCSVCmd = &cobra.Command{
+	Use:   "csv FILE",
+	Short: "Import expenses from a CSV bank statement laid out as described by a configured profile",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		profile, _ := cmd.Flags().GetString("profile")
+		return runImport(cmd, args[0], func(imp *importer.Importer, r io.Reader) (importer.Reader, error) {
+			return imp.CSV(profile, r)
+		})
+	},
+}
+
+// importOFXCmd represents the import ofx command
+var importOFXCmd = &cobra.Command{
+	Use:     "ofx FILE",
+	Aliases: []string{"qfx"},
+	Short:   "Import expenses from an OFX or QFX bank statement, skipping transactions imported before",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runImport(cmd, args[0], (*importer.Importer).OFX)
+	},
+}
+
+// importQIFCmd represents the import qif command
+var importQIFCmd = &cobra.Command{
+	Use:   "qif FILE",
+	Short: "Import expenses from a QIF statement, skipping transactions imported before",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runImport(cmd, args[0], (*importer.Importer).QIF)
+	},
+}
+
+// importCamt053Cmd represents the import camt053 command
+var importCamt053Cmd = &cobra.Command{
+	Use:   "camt053 FILE",
+	Short: "Import expenses and incomes from an ISO 20022 camt.053 statement and reconcile the wallet with it",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runImport(cmd, args[0], (*importer.Importer).Camt053)
+	},
+}
+
+// importMT940Cmd represents the import mt940 command
+var importMT940Cmd = &cobra.Command{
+	Use:   "mt940 FILE",
+	Short: "Import expenses and incomes from a SWIFT MT940 statement and reconcile the wallet with it",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runImport(cmd, args[0], (*importer.Importer).MT940)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+	importCmd.AddCommand(importCSVCmd, importOFXCmd, importQIFCmd, importCamt053Cmd, importMT940Cmd)
+
+	importCmd.PersistentFlags().StringP("wallet", "w", "", "ID of the wallet receiving expenses")
+	importCmd.PersistentFlags().StringP("user", "u", "", "Email of the user importing, who must be able to edit the wallet")
+	importCmd.PersistentFlags().Bool("allow-duplicates", false, "Import expenses which look like ones already in the wallet")
+	importCSVCmd.Flags().StringP("profile", "p", importer.DefaultProfile, "Name of the CSV profile in config file")
+	_ = importCmd.MarkPersistentFlagRequired("wallet")
+	_ = importCmd.MarkPersistentFlagRequired("user")
+}
+
+// runImport imports the statement at path, read with the Reader returned by open, into the wallet given by flags.
+func runImport(cmd *cobra.Command, path string, open func(*importer.Importer, io.Reader) (importer.Reader, error)) error {
+	walletID, _ := cmd.Flags().GetString("wallet")
+	email, _ := cmd.Flags().GetString("user")
+	allowDuplicates, _ := cmd.Flags().GetBool("allow-duplicates")
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	c := conf.New()
+	imp, cleanup, err := initializeImporter(cmd.Context(), c)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	user, err := imp.LookupUser(cmd.Context(), email)
+	if err != nil {
+		return err
+	}
+
+	statement, err := open(imp, f)
+	if err != nil {
+		return err
+	}
+
+	result, err := imp.Import(cmd.Context(), user, walletID, statement, allowDuplicates)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Inserted %d, skipped %d, failed %d rows\n", result.Inserted, result.Skipped, result.Failed)
+	for _, rowErr := range result.Errors {
+		fmt.Println(rowErr)
+	}
+	for _, dup := range result.Duplicates {
+		fmt.Printf("line %d: possible duplicate of expense %s, not imported\n", dup.Line, dup.ExpenseID)
+	}
+	if rec := result.Reconciliation; rec != nil {
+		if rec.Reconciled() {
+			fmt.Printf("Wallet balance %s agrees with the statement\n", rec.WalletBalance)
+		}
+		for _, mismatch := range rec.Mismatches {
+			fmt.Println("Mismatch:", mismatch)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/portfolio"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+// pricesCmd represents the prices command
+var pricesCmd = &cobra.Command{
+	Use:   "prices",
+	Short: "Import prices of securities valuing investment wallets from local price files",
+}
+
+// pricesCSVCmd represents the prices csv command
+var pricesCSVCmd = &cobra.Command{
+	Use:   "csv FILE",
+	Short: "Import closing prices from a CSV file having Date and Close or Price columns, such as a Yahoo Finance export",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ticker, _ := cmd.Flags().GetString("ticker")
+		currency, _ := cmd.Flags().GetString("currency")
+
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		c := conf.New()
+		store, cleanup, err := initializePriceStore(cmd.Context(), c)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		r, err := portfolio.ReadCSV(f, ticker, currency)
+		if err != nil {
+			return err
+		}
+
+		count, err := store.Import(cmd.Context(), r, "csv")
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Imported %d prices\n", count)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(pricesCmd)
+	pricesCmd.AddCommand(pricesCSVCmd)
+
+	pricesCSVCmd.Flags().StringP("ticker", "t", "", "Security priced in the file, required unless it has a Ticker column")
+	pricesCSVCmd.Flags().StringP("currency", "c", "", "Currency of the prices, required unless the file has a Currency column")
+}
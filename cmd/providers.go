@@ -7,10 +7,16 @@ import (
 	"context"
 	"github.com/google/wire"
 	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/backup"
 	"github.com/piotrekmonko/portfello/pkg/conf"
 	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/exchange"
+	"github.com/piotrekmonko/portfello/pkg/export"
+	"github.com/piotrekmonko/portfello/pkg/importer"
 	"github.com/piotrekmonko/portfello/pkg/logz"
+	"github.com/piotrekmonko/portfello/pkg/portfolio"
 	"github.com/piotrekmonko/portfello/pkg/provision"
+	"github.com/piotrekmonko/portfello/pkg/recurring"
 	"github.com/piotrekmonko/portfello/pkg/server"
 	"net/http"
 )
@@ -20,12 +26,42 @@ func initializeProvisioner(ctx context.Context, c *conf.Config) (*provision.Prov
 	return &provision.Provisioner{}, func() {}, nil
 }
 
-func initializeServer(ctx context.Context, c *conf.Config) (*http.Server, func(), error) {
-	wire.Build(server.NewServer, server.NewRouter, dao.NewDAO, auth.NewFromConfig, logz.NewLogger)
-	return &http.Server{}, nil, nil
+func initializeServer(ctx context.Context, c *conf.Config) (*serverApp, func(), error) {
+	wire.Build(newServerApp, server.NewServer, server.NewRouter, recurring.NewScheduler, dao.NewDAO, auth.NewFromConfig, logz.NewLogger)
+	return &serverApp{}, nil, nil
 }
 
 func initializeRouter(ctx context.Context, c *conf.Config) (*http.ServeMux, func(), error) {
 	wire.Build(server.NewRouter, dao.NewDAO, auth.NewFromConfig, logz.NewLogger)
 	return &http.ServeMux{}, nil, nil
 }
+
+func initializeScheduler(ctx context.Context, c *conf.Config) (*recurring.Scheduler, func(), error) {
+	wire.Build(recurring.NewScheduler, dao.NewDAO, logz.NewLogger)
+	return &recurring.Scheduler{}, nil, nil
+}
+
+func initializeExporter(ctx context.Context, c *conf.Config) (*export.Exporter, func(), error) {
+	wire.Build(export.NewExporter, dao.NewDAO, auth.NewFromConfig, logz.NewLogger)
+	return &export.Exporter{}, nil, nil
+}
+
+func initializeImporter(ctx context.Context, c *conf.Config) (*importer.Importer, func(), error) {
+	wire.Build(importer.NewImporter, dao.NewDAO, auth.NewFromConfig, logz.NewLogger)
+	return &importer.Importer{}, nil, nil
+}
+
+func initializeArchiver(ctx context.Context, c *conf.Config) (*backup.Archiver, func(), error) {
+	wire.Build(backup.NewArchiver, dao.NewDAO, logz.NewLogger)
+	return &backup.Archiver{}, nil, nil
+}
+
+func initializeRateStore(ctx context.Context, c *conf.Config) (*exchange.Store, func(), error) {
+	wire.Build(exchange.NewStore, dao.NewDAO, logz.NewLogger)
+	return &exchange.Store{}, nil, nil
+}
+
+func initializePriceStore(ctx context.Context, c *conf.Config) (*portfolio.Store, func(), error) {
+	wire.Build(portfolio.NewStore, dao.NewDAO, logz.NewLogger)
+	return &portfolio.Store{}, nil, nil
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/exchange"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+)
+
+// ratesCmd represents the rates command
+var ratesCmd = &cobra.Command{
+	Use:   "rates",
+	Short: "Import exchange rates converting between wallet currencies from local rate files",
+}
+
+// ratesECBCmd represents the rates ecb command
+var ratesECBCmd = &cobra.Command{
+	Use:   "ecb FILE",
+	Short: "Import ECB euro reference rates from an XML file, such as eurofxref-daily.xml or eurofxref-hist.xml",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRatesImport(cmd, args[0], "ecb", func(r io.Reader) (exchange.Reader, error) {
+			return exchange.ReadECB(r), nil
+		})
+	},
+}
+
+// ratesCSVCmd represents the rates csv command
+var ratesCSVCmd = &cobra.Command{
+	Use:   "csv FILE",
+	Short: "Import rates from a CSV file laid out like ECB eurofxref.csv: a Date column followed by a column per currency",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		base, _ := cmd.Flags().GetString("base")
+		return runRatesImport(cmd, args[0], "csv", func(r io.Reader) (exchange.Reader, error) {
+			return exchange.ReadCSV(r, base)
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(ratesCmd)
+	ratesCmd.AddCommand(ratesECBCmd, ratesCSVCmd)
+
+	ratesCSVCmd.Flags().StringP("base", "b", exchange.ECBBase, "Currency one unit of which is worth the rates in the file")
+}
+
+// runRatesImport stores rates of the file at path, read with the Reader returned by open.
+func runRatesImport(cmd *cobra.Command, path, source string, open func(io.Reader) (exchange.Reader, error)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	c := conf.New()
+	store, cleanup, err := initializeRateStore(cmd.Context(), c)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	r, err := open(f)
+	if err != nil {
+		return err
+	}
+
+	count, err := store.Import(cmd.Context(), r, source)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Imported %d exchange rates\n", count)
+	return nil
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/spf13/cobra"
+	"time"
+)
+
+// recurringCmd represents the recurring command
+var recurringCmd = &cobra.Command{
+	Use:   "recurring",
+	Short: "Manage recurring operations",
+}
+
+// recurringRunCmd represents the recurring run command
+var recurringRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Create operations of recurring rules due until given time, backfilling any missed ones",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		until, err := parseUntil(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := conf.New()
+		scheduler, cleanup, err := initializeScheduler(cmd.Context(), c)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		n, err := scheduler.RunUntil(cmd.Context(), until)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Created %d operations due until %s\n", n, until.Format(time.RFC3339))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(recurringCmd)
+	recurringCmd.AddCommand(recurringRunCmd)
+
+	recurringRunCmd.Flags().String("until", "", "Create operations due until this date or RFC3339 time (default now)")
+}
+
+// parseUntil reads the --until flag. Dates include the whole day.
+func parseUntil(cmd *cobra.Command) (time.Time, error) {
+	value, _ := cmd.Flags().GetString("until")
+	if value == "" {
+		return time.Now().UTC(), nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), nil
+	}
+
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --until, expected a date or RFC3339 time: %s", value)
+	}
+
+	return t.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+}
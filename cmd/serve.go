@@ -1,10 +1,12 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"github.com/fsnotify/fsnotify"
 	"github.com/piotrekmonko/portfello/pkg/conf"
 	"github.com/piotrekmonko/portfello/pkg/logz"
+	"github.com/piotrekmonko/portfello/pkg/recurring"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"log"
@@ -35,6 +37,19 @@ func init() {
 	rootCmd.AddCommand(serveCmd)
 }
 
+// serverApp is the HTTP server with the scheduler of recurring rules running next to it, both using the same database.
+type serverApp struct {
+	server    *http.Server
+	scheduler *recurring.Scheduler
+}
+
+func newServerApp(server *http.Server, scheduler *recurring.Scheduler) *serverApp {
+	return &serverApp{
+		server:    server,
+		scheduler: scheduler,
+	}
+}
+
 func serveWithRestartOnConfigChange(cmd *cobra.Command, sigs chan os.Signal) error {
 	ctx := cmd.Context()
 	c := conf.New()
@@ -44,19 +59,28 @@ func serveWithRestartOnConfigChange(cmd *cobra.Command, sigs chan os.Signal) err
 		return err
 	}
 
-	httpSrv, httpCloser, err := initializeServer(cmd.Context(), c)
+	app, httpCloser, err := initializeServer(cmd.Context(), c)
 	if err != nil {
 		return err
 	}
 
+	schedulerCtx, stopScheduler := context.WithCancel(ctx)
+	schedulerDone := make(chan struct{})
+	go func() {
+		defer close(schedulerDone)
+		app.scheduler.Run(schedulerCtx)
+	}()
+
 	go func() {
-		if errz := httpSrv.ListenAndServe(); !errors.Is(errz, http.ErrServerClosed) {
+		if errz := app.server.ListenAndServe(); !errors.Is(errz, http.ErrServerClosed) {
 			_ = log.Errorw(ctx, errz, "error while running http server")
 			sigs <- syscall.SIGQUIT
 		}
 	}()
 
 	reason := <-sigs
+	stopScheduler()
+	<-schedulerDone
 	httpCloser()
 	log.Infow(ctx, "Stopped")
 	syncer()
@@ -9,10 +9,16 @@ package cmd
 import (
 	"context"
 	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/backup"
 	"github.com/piotrekmonko/portfello/pkg/conf"
 	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/exchange"
+	"github.com/piotrekmonko/portfello/pkg/export"
+	"github.com/piotrekmonko/portfello/pkg/importer"
 	"github.com/piotrekmonko/portfello/pkg/logz"
+	"github.com/piotrekmonko/portfello/pkg/portfolio"
 	"github.com/piotrekmonko/portfello/pkg/provision"
+	"github.com/piotrekmonko/portfello/pkg/recurring"
 	"github.com/piotrekmonko/portfello/pkg/server"
 	"net/http"
 )
@@ -42,7 +48,7 @@ func initializeProvisioner(ctx context.Context, c *conf.Config) (*provision.Prov
 	}, nil
 }
 
-func initializeServer(ctx context.Context, c *conf.Config) (*http.Server, func(), error) {
+func initializeServer(ctx context.Context, c *conf.Config) (*serverApp, func(), error) {
 	log, cleanup, err := logz.NewLogger(c)
 	if err != nil {
 		return nil, nil, err
@@ -58,7 +64,7 @@ func initializeServer(ctx context.Context, c *conf.Config) (*http.Server, func()
 		cleanup()
 		return nil, nil, err
 	}
-	serveMux, err := server.NewRouter(c, daoDAO, service)
+	serveMux, err := server.NewRouter(log, c, daoDAO, service)
 	if err != nil {
 		cleanup2()
 		cleanup()
@@ -70,7 +76,9 @@ func initializeServer(ctx context.Context, c *conf.Config) (*http.Server, func()
 		cleanup()
 		return nil, nil, err
 	}
-	return httpServer, func() {
+	scheduler := recurring.NewScheduler(log, daoDAO)
+	cmdServerApp := newServerApp(httpServer, scheduler)
+	return cmdServerApp, func() {
 		cleanup3()
 		cleanup2()
 		cleanup()
@@ -93,7 +101,7 @@ func initializeRouter(ctx context.Context, c *conf.Config) (*http.ServeMux, func
 		cleanup()
 		return nil, nil, err
 	}
-	serveMux, err := server.NewRouter(c, daoDAO, service)
+	serveMux, err := server.NewRouter(log, c, daoDAO, service)
 	if err != nil {
 		cleanup2()
 		cleanup()
@@ -104,3 +112,117 @@ func initializeRouter(ctx context.Context, c *conf.Config) (*http.ServeMux, func
 		cleanup()
 	}, nil
 }
+
+func initializeScheduler(ctx context.Context, c *conf.Config) (*recurring.Scheduler, func(), error) {
+	log, cleanup, err := logz.NewLogger(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	daoDAO, cleanup2, err := dao.NewDAO(ctx, log, c)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	scheduler := recurring.NewScheduler(log, daoDAO)
+	return scheduler, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func initializeExporter(ctx context.Context, c *conf.Config) (*export.Exporter, func(), error) {
+	log, cleanup, err := logz.NewLogger(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	daoDAO, cleanup2, err := dao.NewDAO(ctx, log, c)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	service, err := auth.NewFromConfig(ctx, log, c, daoDAO)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	exporter := export.NewExporter(daoDAO, service)
+	return exporter, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func initializeImporter(ctx context.Context, c *conf.Config) (*importer.Importer, func(), error) {
+	log, cleanup, err := logz.NewLogger(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	daoDAO, cleanup2, err := dao.NewDAO(ctx, log, c)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	service, err := auth.NewFromConfig(ctx, log, c, daoDAO)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	importerImporter := importer.NewImporter(c, daoDAO, service)
+	return importerImporter, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func initializeArchiver(ctx context.Context, c *conf.Config) (*backup.Archiver, func(), error) {
+	log, cleanup, err := logz.NewLogger(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	daoDAO, cleanup2, err := dao.NewDAO(ctx, log, c)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	archiver := backup.NewArchiver(c, daoDAO)
+	return archiver, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func initializeRateStore(ctx context.Context, c *conf.Config) (*exchange.Store, func(), error) {
+	log, cleanup, err := logz.NewLogger(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	daoDAO, cleanup2, err := dao.NewDAO(ctx, log, c)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	store := exchange.NewStore(daoDAO)
+	return store, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func initializePriceStore(ctx context.Context, c *conf.Config) (*portfolio.Store, func(), error) {
+	log, cleanup, err := logz.NewLogger(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	daoDAO, cleanup2, err := dao.NewDAO(ctx, log, c)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	store := portfolio.NewStore(daoDAO)
+	return store, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
@@ -2,18 +2,20 @@ package dbschema
 
 import (
 	"embed"
+	"errors"
 	"fmt"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres" // database driver
 	_ "github.com/golang-migrate/migrate/v4/database/sqlite"   // database driver
 	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"io/fs"
 )
 
 //go:embed migrations/*.sql
-var fs embed.FS
+var migrations embed.FS
 
 func NewMigrator(dsn string) (*migrate.Migrate, error) {
-	d, err := iofs.New(fs, "migrations")
+	d, err := iofs.New(migrations, "migrations")
 	if err != nil {
 		return nil, fmt.Errorf("cannot read migrations: %w", err)
 	}
@@ -25,3 +27,27 @@ func NewMigrator(dsn string) (*migrate.Migrate, error) {
 
 	return m, nil
 }
+
+// LatestVersion returns the version of the last migration, which is the schema version of an up to date database.
+func LatestVersion() (uint, error) {
+	d, err := iofs.New(migrations, "migrations")
+	if err != nil {
+		return 0, fmt.Errorf("cannot read migrations: %w", err)
+	}
+	defer d.Close()
+
+	version, err := d.First()
+	if err != nil {
+		return 0, fmt.Errorf("cannot read migrations: %w", err)
+	}
+	for {
+		next, err := d.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("cannot read migrations: %w", err)
+		}
+		version = next
+	}
+}
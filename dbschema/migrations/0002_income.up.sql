@@ -0,0 +1,13 @@
+-- Tracks incomes, such as salaries or refunds.
+create table income
+(
+    id          varchar(22)             not null
+        constraint income_pk
+            primary key, /* A base57 encoded uuid. */
+    wallet_id   varchar(22)             not null
+        constraint income_wallet_id_fk
+            references wallet,
+    amount      double precision        not null,
+    description text,
+    created_at  timestamp default CURRENT_TIMESTAMP not null
+);
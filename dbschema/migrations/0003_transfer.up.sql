@@ -0,0 +1,20 @@
+-- Records one side of a transfer of money between two wallets. The debit and the credit side share a transfer_id.
+create table transfer
+(
+    id                    varchar(22)             not null
+        constraint transfer_pk
+            primary key, /* A base57 encoded uuid. */
+    transfer_id           varchar(22)             not null, /* A base57 encoded uuid, common to both sides of a transfer. */
+    wallet_id             varchar(22)             not null
+        constraint transfer_wallet_id_fk
+            references wallet,
+    counterpart_wallet_id varchar(22)             not null
+        constraint transfer_counterpart_wallet_id_fk
+            references wallet, /* The wallet on the other side of this transfer. */
+    amount                double precision        not null, /* Negative on the debit side, positive on the credit side. */
+    rate                  double precision        not null, /* Exchange rate from debited to credited wallet currency. */
+    description           text,
+    created_at            timestamp default CURRENT_TIMESTAMP not null
+);
+
+create index transfer_transfer_id_idx on transfer (transfer_id);
@@ -0,0 +1,19 @@
+alter table wallet add column balance_float double precision default 0 not null;
+update wallet set balance_float = balance / 10000.0;
+alter table wallet drop column balance;
+alter table wallet rename column balance_float to balance;
+
+alter table expense add column amount_float double precision default 0 not null;
+update expense set amount_float = amount / 10000.0;
+alter table expense drop column amount;
+alter table expense rename column amount_float to amount;
+
+alter table income add column amount_float double precision default 0 not null;
+update income set amount_float = amount / 10000.0;
+alter table income drop column amount;
+alter table income rename column amount_float to amount;
+
+alter table transfer add column amount_float double precision default 0 not null;
+update transfer set amount_float = amount / 10000.0;
+alter table transfer drop column amount;
+alter table transfer rename column amount_float to amount;
@@ -0,0 +1,21 @@
+-- Money is stored as integer number of ten-thousandths of a currency unit (see money.Decimal), because floating point
+-- sums drift and numeric columns are not exact in every supported database.
+alter table wallet add column balance_minor bigint default 0 not null;
+update wallet set balance_minor = cast(round(balance * 10000) as bigint);
+alter table wallet drop column balance;
+alter table wallet rename column balance_minor to balance;
+
+alter table expense add column amount_minor bigint default 0 not null;
+update expense set amount_minor = cast(round(amount * 10000) as bigint);
+alter table expense drop column amount;
+alter table expense rename column amount_minor to amount;
+
+alter table income add column amount_minor bigint default 0 not null;
+update income set amount_minor = cast(round(amount * 10000) as bigint);
+alter table income drop column amount;
+alter table income rename column amount_minor to amount;
+
+alter table transfer add column amount_minor bigint default 0 not null;
+update transfer set amount_minor = cast(round(amount * 10000) as bigint);
+alter table transfer drop column amount;
+alter table transfer rename column amount_minor to amount;
@@ -0,0 +1,2 @@
+alter table expense drop column category_id;
+drop table if exists category;
@@ -0,0 +1,19 @@
+-- Holds a tree of expense categories, separate for each user.
+create table category
+(
+    id         varchar(22)             not null
+        constraint category_pk
+            primary key, /* A base57 encoded uuid. */
+    user_id    varchar(256)            not null, /* User ID reference to auth provider. This is this category Owner. */
+    parent_id  varchar(22)
+        constraint category_parent_id_fk
+            references category, /* Empty for top level categories. */
+    name       text                    not null,
+    created_at timestamp default CURRENT_TIMESTAMP not null
+);
+
+create index category_user_id_idx on category (user_id);
+
+alter table expense add column category_id varchar(22)
+    constraint expense_category_id_fk
+        references category;
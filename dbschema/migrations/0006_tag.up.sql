@@ -0,0 +1,27 @@
+-- Holds free-form labels, separate for each user.
+create table tag
+(
+    id         varchar(22)             not null
+        constraint tag_pk
+            primary key, /* A base57 encoded uuid. */
+    user_id    varchar(256)            not null, /* User ID reference to auth provider. This is this tag Owner. */
+    name       varchar(128)            not null,
+    created_at timestamp default CURRENT_TIMESTAMP not null,
+    constraint tag_user_id_name_uq
+        unique (user_id, name)
+);
+
+-- Assigns tags to expenses.
+create table expense_tag
+(
+    expense_id varchar(22)             not null
+        constraint expense_tag_expense_id_fk
+            references expense,
+    tag_id     varchar(22)             not null
+        constraint expense_tag_tag_id_fk
+            references tag,
+    constraint expense_tag_pk
+        primary key (expense_id, tag_id)
+);
+
+create index expense_tag_tag_id_idx on expense_tag (tag_id);
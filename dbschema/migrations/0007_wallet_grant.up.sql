@@ -0,0 +1,17 @@
+-- Gives users other than the owner access to a wallet.
+create table wallet_grant
+(
+    wallet_id  varchar(22)             not null
+        constraint wallet_grant_wallet_id_fk
+            references wallet,
+    user_id    varchar(256)            not null, /* User ID reference to auth provider. */
+    email      varchar(256)            not null, /* Email of the user at the time of sharing. */
+    access     varchar(16)             not null
+        constraint wallet_grant_access_check
+            check (access in ('viewer', 'editor', 'owner')),
+    created_at timestamp default CURRENT_TIMESTAMP not null,
+    constraint wallet_grant_pk
+        primary key (wallet_id, user_id)
+);
+
+create index wallet_grant_user_id_idx on wallet_grant (user_id);
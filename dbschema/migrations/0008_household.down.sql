@@ -0,0 +1,4 @@
+alter table wallet drop column household_id;
+drop table if exists household_invitation;
+drop table if exists household_member;
+drop table if exists household;
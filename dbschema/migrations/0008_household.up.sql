@@ -0,0 +1,54 @@
+-- Groups users sharing wallets, such as a family.
+create table household
+(
+    id         varchar(22)             not null
+        constraint household_pk
+            primary key, /* A base57 encoded uuid. */
+    name       text                    not null,
+    created_at timestamp default CURRENT_TIMESTAMP not null
+);
+
+-- Holds users belonging to a household and their roles.
+create table household_member
+(
+    household_id varchar(22)             not null
+        constraint household_member_household_id_fk
+            references household,
+    user_id      varchar(256)            not null, /* User ID reference to auth provider. */
+    email        varchar(256)            not null, /* Email of the user at the time of joining. */
+    role         varchar(16)             not null
+        constraint household_member_role_check
+            check (role in ('owner', 'member', 'viewer')),
+    created_at   timestamp default CURRENT_TIMESTAMP not null,
+    constraint household_member_pk
+        primary key (household_id, user_id)
+);
+
+create index household_member_user_id_idx on household_member (user_id);
+
+-- Holds pending invitations to join a household.
+create table household_invitation
+(
+    id           varchar(22)             not null
+        constraint household_invitation_pk
+            primary key, /* A base57 encoded uuid. */
+    household_id varchar(22)             not null
+        constraint household_invitation_household_id_fk
+            references household,
+    user_id      varchar(256)            not null, /* User ID of the invited user. */
+    email        varchar(256)            not null,
+    role         varchar(16)             not null
+        constraint household_invitation_role_check
+            check (role in ('owner', 'member', 'viewer')),
+    invited_by   varchar(256)            not null, /* Email of the inviting user. */
+    created_at   timestamp default CURRENT_TIMESTAMP not null,
+    constraint household_invitation_household_id_user_id_uq
+        unique (household_id, user_id)
+);
+
+create index household_invitation_user_id_idx on household_invitation (user_id);
+
+-- Wallets owned by a household are available to its members, according to their roles.
+alter table wallet add column household_id varchar(22)
+    constraint wallet_household_id_fk
+        references household;
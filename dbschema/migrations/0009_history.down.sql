@@ -0,0 +1,8 @@
+drop index if exists history_created_at_idx;
+drop index if exists history_namespace_reference_idx;
+
+delete from history where length(reference) > 22;
+alter table history add column reference_narrow varchar(22) default '' not null;
+update history set reference_narrow = reference;
+alter table history drop column reference;
+alter table history rename column reference_narrow to reference;
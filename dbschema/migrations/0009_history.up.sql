@@ -0,0 +1,7 @@
+alter table history add column reference_wide varchar(256) default '' not null;
+update history set reference_wide = reference;
+alter table history drop column reference;
+alter table history rename column reference_wide to reference;
+
+create index history_namespace_reference_idx on history (namespace, reference);
+create index history_created_at_idx on history (created_at);
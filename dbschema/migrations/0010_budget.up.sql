@@ -0,0 +1,24 @@
+-- Limits spending of a user in a wallet, a category or a category within a wallet, renewed every period.
+create table budget
+(
+    id          varchar(22)             not null
+        constraint budget_pk
+            primary key, /* A base57 encoded uuid. */
+    user_id     varchar(256)            not null, /* User ID reference to auth provider. This is this budget Owner. */
+    wallet_id   varchar(22)
+        constraint budget_wallet_id_fk
+            references wallet, /* Empty for budgets of a category in all wallets. */
+    category_id varchar(22)
+        constraint budget_category_id_fk
+            references category, /* Empty for budgets of a whole wallet. */
+    currency    varchar(8)              not null, /* Only expenses of wallets in this currency are counted. */
+    period      varchar(16)             not null
+        constraint budget_period_check
+            check (period in ('week', 'month', 'year')),
+    amount      bigint                  not null, /* Spending limit for a period, in ten-thousandths of currency. */
+    rollover    boolean default false   not null, /* Carries unused or overspent amount over to the next period. */
+    starts_at   timestamp               not null, /* Start of the first period, later periods start at the same offset. */
+    created_at  timestamp default CURRENT_TIMESTAMP not null
+);
+
+create index budget_user_id_idx on budget (user_id);
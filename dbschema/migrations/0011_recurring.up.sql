@@ -0,0 +1,29 @@
+-- Describes operations repeating on a schedule, such as rent or salary. Operations are created when they become due.
+create table recurring
+(
+    id          varchar(22)             not null
+        constraint recurring_pk
+            primary key, /* A base57 encoded uuid. */
+    user_id     varchar(256)            not null, /* User ID reference to auth provider. This is this rule Owner. */
+    email       varchar(256)            not null, /* Email of the owner, recorded in history of created operations. */
+    wallet_id   varchar(22)             not null
+        constraint recurring_wallet_id_fk
+            references wallet,
+    kind        varchar(16)             not null
+        constraint recurring_kind_check
+            check (kind in ('expense', 'income')),
+    amount      bigint                  not null, /* Amount of every created operation, in ten-thousandths of currency. */
+    description text,
+    category_id varchar(22)
+        constraint recurring_category_id_fk
+            references category, /* Category of created expenses. */
+    rrule       text                    not null, /* Frequency in RRULE format, eg. FREQ=MONTHLY;INTERVAL=1. */
+    starts_at   timestamp               not null, /* Time of the first occurrence. */
+    ends_at     timestamp, /* Empty for rules repeating until their count runs out or forever. */
+    next_at     timestamp, /* Time of the next occurrence to create, empty once the rule has ended. */
+    occurrences integer default 0       not null, /* Number of occurrences already created. */
+    created_at  timestamp default CURRENT_TIMESTAMP not null
+);
+
+create index recurring_user_id_idx on recurring (user_id);
+create index recurring_next_at_idx on recurring (next_at);
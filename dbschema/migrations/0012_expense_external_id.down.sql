@@ -0,0 +1,2 @@
+drop index expense_wallet_external_id_idx;
+alter table expense drop column external_id;
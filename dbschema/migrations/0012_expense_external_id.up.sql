@@ -0,0 +1,4 @@
+-- External ID identifies an imported expense in the statement it came from, such as OFX FITID. Importing the same
+-- statement again skips expenses whose external ID is already present in the wallet.
+alter table expense add column external_id varchar(256);
+create unique index expense_wallet_external_id_idx on expense (wallet_id, external_id);
@@ -0,0 +1,2 @@
+drop index income_wallet_external_id_idx;
+alter table income drop column external_id;
@@ -0,0 +1,3 @@
+-- Statements booking incoming money, such as camt.053 and MT940, are imported as incomes, identified like expenses.
+alter table income add column external_id varchar(256);
+create unique index income_wallet_external_id_idx on income (wallet_id, external_id);
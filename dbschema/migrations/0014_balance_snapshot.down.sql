@@ -0,0 +1,4 @@
+drop index transfer_wallet_id_created_at_idx;
+drop index income_wallet_id_created_at_idx;
+drop index expense_wallet_id_created_at_idx;
+drop table if exists balance_snapshot;
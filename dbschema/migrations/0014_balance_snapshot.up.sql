@@ -0,0 +1,16 @@
+-- Caches balances of wallets at the start of months, so balance history does not sum every operation since a wallet
+-- was created. Snapshots are derived from operations and are deleted when an operation created before them changes.
+create table balance_snapshot
+(
+    wallet_id varchar(22)             not null
+        constraint balance_snapshot_wallet_id_fk
+            references wallet,
+    taken_at  timestamp               not null, /* Start of a month in UTC. */
+    balance   bigint                  not null, /* Sum of operations created before taken_at, in ten-thousandths of currency. */
+    constraint balance_snapshot_pk
+        primary key (wallet_id, taken_at)
+);
+
+create index expense_wallet_id_created_at_idx on expense (wallet_id, created_at);
+create index income_wallet_id_created_at_idx on income (wallet_id, created_at);
+create index transfer_wallet_id_created_at_idx on transfer (wallet_id, created_at);
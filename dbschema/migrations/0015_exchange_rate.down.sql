@@ -0,0 +1,2 @@
+drop index exchange_rate_currency_idx;
+drop table if exists exchange_rate;
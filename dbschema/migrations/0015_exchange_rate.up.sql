@@ -0,0 +1,15 @@
+-- Holds exchange rates imported from local rate files, such as ECB reference rates. A rate of a pair of currencies is
+-- valid from the start of valid_on until the next rate of the same pair.
+create table exchange_rate
+(
+    base       varchar(8)                          not null,
+    currency   varchar(8)                          not null,
+    valid_on   timestamp                           not null, /* Day the rate was published for, at midnight UTC. */
+    rate       double precision                    not null, /* Units of currency worth one unit of base. */
+    source     varchar(32)                         not null, /* Format of the imported file, such as ecb or csv. */
+    created_at timestamp default CURRENT_TIMESTAMP not null,
+    constraint exchange_rate_pk
+        primary key (base, currency, valid_on)
+);
+
+create index exchange_rate_currency_idx on exchange_rate (currency);
@@ -0,0 +1,4 @@
+drop table if exists security_price;
+drop index trade_wallet_id_created_at_idx;
+drop table if exists trade;
+alter table wallet drop column kind;
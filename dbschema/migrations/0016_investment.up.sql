@@ -0,0 +1,42 @@
+-- Investment wallets hold securities besides cash, bought and sold with trades.
+alter table wallet add column kind varchar(16) default 'cash' not null
+    constraint wallet_kind_check
+        check (kind in ('cash', 'investment'));
+
+-- Records buys, sells and dividends of securities held in investment wallets. Amount is the change of cash balance of
+-- the wallet, so trades are operations like expenses and incomes.
+create table trade
+(
+    id          varchar(22)             not null
+        constraint trade_pk
+            primary key, /* A base57 encoded uuid. */
+    wallet_id   varchar(22)             not null
+        constraint trade_wallet_id_fk
+            references wallet,
+    ticker      varchar(32)             not null,
+    kind        varchar(16)             not null
+        constraint trade_kind_check
+            check (kind in ('buy', 'sell', 'dividend')),
+    quantity    bigint                  not null, /* Units bought or sold in ten-thousandths, 0 for dividends. */
+    price       bigint                  not null, /* Price of a unit in ten-thousandths of wallet currency, 0 for dividends. */
+    fee         bigint                  not null, /* Fees and taxes in ten-thousandths of wallet currency. */
+    amount      bigint                  not null, /* Negative for buys, positive for sells and dividends, net of fee. */
+    description text,
+    created_at  timestamp default CURRENT_TIMESTAMP not null
+);
+
+create index trade_wallet_id_created_at_idx on trade (wallet_id, created_at);
+
+-- Holds closing prices of securities imported from local price files. A price is valid from the start of priced_on
+-- until the next price of the same ticker.
+create table security_price
+(
+    ticker     varchar(32)                         not null,
+    priced_on  timestamp                           not null, /* Day of the price, at midnight UTC. */
+    price      bigint                              not null, /* Price of a unit in ten-thousandths of currency. */
+    currency   varchar(8)                          not null,
+    source     varchar(32)                         not null, /* Format of the imported file, such as csv. */
+    created_at timestamp default CURRENT_TIMESTAMP not null,
+    constraint security_price_pk
+        primary key (ticker, priced_on)
+);
@@ -0,0 +1,4 @@
+drop index expense_debt_id_idx;
+alter table expense drop column debt_id;
+drop index debt_user_id_idx;
+drop table if exists debt;
@@ -0,0 +1,26 @@
+-- Describes loans and mortgages owed by a user, repaid in equal monthly payments. Expenses repaying a debt refer to it.
+create table debt
+(
+    id            varchar(22)             not null
+        constraint debt_pk
+            primary key, /* A base57 encoded uuid. */
+    user_id       varchar(256)            not null, /* User ID reference to auth provider. This is this debt Owner. */
+    name          varchar(128)            not null,
+    lender        varchar(128), /* Bank or person the debt is owed to. */
+    currency      varchar(8)              not null,
+    principal     bigint                  not null, /* Amount borrowed in ten-thousandths of currency. */
+    interest_rate double precision        not null, /* Annual nominal interest rate in percent. */
+    term_months   integer                 not null, /* Number of monthly payments. */
+    payment_day   integer                 not null /* Day of month payments are due on, the last day in shorter months. */
+        constraint debt_payment_day_check
+            check (payment_day between 1 and 31),
+    starts_at     timestamp               not null, /* Day the debt was taken, the first payment is due a month later. */
+    created_at    timestamp default CURRENT_TIMESTAMP not null
+);
+
+create index debt_user_id_idx on debt (user_id);
+
+alter table expense add column debt_id varchar(22)
+    constraint expense_debt_id_fk
+        references debt;
+create index expense_debt_id_idx on expense (debt_id);
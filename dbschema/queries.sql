@@ -4,20 +4,240 @@ SELECT * FROM history ORDER BY id;
 -- name: HistoryInsert :exec
 INSERT INTO history (id, namespace, reference, event, email, created_at) VALUES ($1, $2, $3, $4, $5, $6);
 
+-- name: HistoryPage :many
+SELECT * FROM history
+WHERE (history.namespace = sqlc.narg(namespace) OR sqlc.narg(namespace) IS NULL)
+  AND (history.reference = sqlc.narg(reference) OR sqlc.narg(reference) IS NULL)
+  AND (history.email = sqlc.narg(email) OR sqlc.narg(email) IS NULL)
+  AND (history.created_at >= sqlc.narg(created_from) OR sqlc.narg(created_from) IS NULL)
+  AND (history.created_at < sqlc.narg(created_to) OR sqlc.narg(created_to) IS NULL)
+  AND (history.created_at > sqlc.narg(after_created_at) OR (history.created_at = sqlc.narg(after_created_at) AND history.id > sqlc.narg(after_id)) OR sqlc.narg(after_id) IS NULL)
+  AND (history.created_at < sqlc.narg(before_created_at) OR (history.created_at = sqlc.narg(before_created_at) AND history.id < sqlc.narg(before_id)) OR sqlc.narg(before_id) IS NULL)
+ORDER BY history.created_at, history.id
+LIMIT sqlc.arg(page_size);
+
+-- name: HistoryPageFromEnd :many
+SELECT * FROM history
+WHERE (history.namespace = sqlc.narg(namespace) OR sqlc.narg(namespace) IS NULL)
+  AND (history.reference = sqlc.narg(reference) OR sqlc.narg(reference) IS NULL)
+  AND (history.email = sqlc.narg(email) OR sqlc.narg(email) IS NULL)
+  AND (history.created_at >= sqlc.narg(created_from) OR sqlc.narg(created_from) IS NULL)
+  AND (history.created_at < sqlc.narg(created_to) OR sqlc.narg(created_to) IS NULL)
+  AND (history.created_at > sqlc.narg(after_created_at) OR (history.created_at = sqlc.narg(after_created_at) AND history.id > sqlc.narg(after_id)) OR sqlc.narg(after_id) IS NULL)
+  AND (history.created_at < sqlc.narg(before_created_at) OR (history.created_at = sqlc.narg(before_created_at) AND history.id < sqlc.narg(before_id)) OR sqlc.narg(before_id) IS NULL)
+ORDER BY history.created_at DESC, history.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: HistoryCount :one
+SELECT count(*) FROM history
+WHERE (history.namespace = sqlc.narg(namespace) OR sqlc.narg(namespace) IS NULL)
+  AND (history.reference = sqlc.narg(reference) OR sqlc.narg(reference) IS NULL)
+  AND (history.email = sqlc.narg(email) OR sqlc.narg(email) IS NULL)
+  AND (history.created_at >= sqlc.narg(created_from) OR sqlc.narg(created_from) IS NULL)
+  AND (history.created_at < sqlc.narg(created_to) OR sqlc.narg(created_to) IS NULL);
+
 -- name: WalletsByAdmin :many
 SELECT * FROM wallet ORDER BY wallet.user_id, wallet.created_at;
 
 -- name: WalletsByUser :many
 SELECT * FROM wallet WHERE user_id = $1 ORDER BY wallet.created_at;
 
+-- WalletPage lists wallets visible to user, all of them without one. Household wallets are visible to members of the
+-- household rather than to their creator, as in access.Wallet.
+-- name: WalletPage :many
+SELECT * FROM wallet
+WHERE ((wallet.household_id IS NULL AND wallet.user_id = sqlc.narg(user_id))
+    OR wallet.id IN (SELECT wallet_grant.wallet_id FROM wallet_grant WHERE wallet_grant.user_id = sqlc.narg(user_id))
+    OR wallet.household_id IN (SELECT household_member.household_id FROM household_member WHERE household_member.user_id = sqlc.narg(user_id))
+    OR sqlc.narg(user_id) IS NULL)
+  AND (wallet.created_at > sqlc.narg(after_created_at) OR (wallet.created_at = sqlc.narg(after_created_at) AND wallet.id > sqlc.narg(after_id)) OR sqlc.narg(after_id) IS NULL)
+  AND (wallet.created_at < sqlc.narg(before_created_at) OR (wallet.created_at = sqlc.narg(before_created_at) AND wallet.id < sqlc.narg(before_id)) OR sqlc.narg(before_id) IS NULL)
+ORDER BY wallet.created_at, wallet.id
+LIMIT sqlc.arg(page_size);
+
+-- name: WalletPageFromEnd :many
+SELECT * FROM wallet
+WHERE ((wallet.household_id IS NULL AND wallet.user_id = sqlc.narg(user_id))
+    OR wallet.id IN (SELECT wallet_grant.wallet_id FROM wallet_grant WHERE wallet_grant.user_id = sqlc.narg(user_id))
+    OR wallet.household_id IN (SELECT household_member.household_id FROM household_member WHERE household_member.user_id = sqlc.narg(user_id))
+    OR sqlc.narg(user_id) IS NULL)
+  AND (wallet.created_at > sqlc.narg(after_created_at) OR (wallet.created_at = sqlc.narg(after_created_at) AND wallet.id > sqlc.narg(after_id)) OR sqlc.narg(after_id) IS NULL)
+  AND (wallet.created_at < sqlc.narg(before_created_at) OR (wallet.created_at = sqlc.narg(before_created_at) AND wallet.id < sqlc.narg(before_id)) OR sqlc.narg(before_id) IS NULL)
+ORDER BY wallet.created_at DESC, wallet.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: WalletCount :one
+SELECT count(*) FROM wallet WHERE ((wallet.household_id IS NULL AND wallet.user_id = sqlc.narg(user_id))
+    OR wallet.id IN (SELECT wallet_grant.wallet_id FROM wallet_grant WHERE wallet_grant.user_id = sqlc.narg(user_id))
+    OR wallet.household_id IN (SELECT household_member.household_id FROM household_member WHERE household_member.user_id = sqlc.narg(user_id))
+    OR sqlc.narg(user_id) IS NULL);
+
+-- name: WalletGrantUpsert :exec
+INSERT INTO wallet_grant (wallet_id, user_id, email, access, created_at) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (wallet_id, user_id) DO UPDATE SET email = excluded.email, access = excluded.access;
+
+-- name: WalletGrantGet :one
+SELECT * FROM wallet_grant WHERE wallet_id = $1 AND user_id = $2;
+
+-- name: WalletGrantListByWallet :many
+SELECT * FROM wallet_grant WHERE wallet_id = $1 ORDER BY created_at, user_id;
+
+-- name: WalletGrantDelete :exec
+DELETE FROM wallet_grant WHERE wallet_id = $1 AND user_id = $2;
+
 -- name: WalletInsert :exec
-INSERT INTO wallet (id, user_id, balance, currency, created_at) VALUES ($1, $2, $3, $4, $5);
+INSERT INTO wallet (id, user_id, household_id, balance, currency, created_at) VALUES ($1, $2, $3, $4, $5, $6);
+
+-- WalletInsertKind is WalletInsert of a wallet of given kind, WalletInsert creates cash wallets.
+-- name: WalletInsertKind :exec
+INSERT INTO wallet (id, user_id, household_id, balance, currency, kind, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: WalletGetByID :one
+SELECT * FROM wallet WHERE id = $1;
 
 -- name: WalletUpdateBalance :exec
-UPDATE wallet SET balance = $1 WHERE id = $2;
+UPDATE wallet SET balance = balance + sqlc.arg(delta) WHERE id = sqlc.arg(id);
+
+-- name: BalanceSnapshotUpsert :exec
+INSERT INTO balance_snapshot (wallet_id, taken_at, balance) VALUES ($1, $2, $3)
+ON CONFLICT (wallet_id, taken_at) DO UPDATE SET balance = excluded.balance;
+
+-- BalanceSnapshotLatest returns the latest snapshot of a wallet taken at or before given time.
+-- name: BalanceSnapshotLatest :one
+SELECT * FROM balance_snapshot WHERE wallet_id = sqlc.arg(wallet_id) AND taken_at <= sqlc.arg(taken_at)
+ORDER BY taken_at DESC LIMIT 1;
+
+-- BalanceSnapshotDeleteAfter deletes snapshots of a wallet invalidated by an operation created at given time. Call it
+-- whenever an operation is created, changed or deleted.
+-- name: BalanceSnapshotDeleteAfter :exec
+DELETE FROM balance_snapshot WHERE wallet_id = sqlc.arg(wallet_id) AND taken_at > sqlc.arg(created_at);
+
+-- name: ExchangeRateUpsert :exec
+INSERT INTO exchange_rate (base, currency, valid_on, rate, source, created_at) VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (base, currency, valid_on) DO UPDATE SET rate = excluded.rate, source = excluded.source,
+    created_at = excluded.created_at;
+
+-- ExchangeRateLatest returns the latest rate of a pair of currencies valid at or before given day.
+-- name: ExchangeRateLatest :one
+SELECT * FROM exchange_rate WHERE base = sqlc.arg(base) AND currency = sqlc.arg(currency) AND valid_on <= sqlc.arg(valid_on)
+ORDER BY valid_on DESC LIMIT 1;
+
+-- ExchangeRateBases lists base currencies of rates into given currency.
+-- name: ExchangeRateBases :many
+SELECT DISTINCT base FROM exchange_rate WHERE currency = $1 ORDER BY base;
+
+-- name: HouseholdInsert :exec
+INSERT INTO household (id, name, created_at) VALUES ($1, $2, $3);
+
+-- name: HouseholdGetByID :one
+SELECT * FROM household WHERE id = $1;
+
+-- name: HouseholdListByUser :many
+SELECT household.* FROM household JOIN household_member ON household_member.household_id = household.id
+WHERE household_member.user_id = $1 ORDER BY household.name, household.id;
+
+-- name: HouseholdMemberUpsert :exec
+INSERT INTO household_member (household_id, user_id, email, role, created_at) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (household_id, user_id) DO UPDATE SET email = excluded.email, role = excluded.role;
+
+-- name: HouseholdMemberGet :one
+SELECT * FROM household_member WHERE household_id = $1 AND user_id = $2;
+
+-- name: HouseholdMemberListByHousehold :many
+SELECT * FROM household_member WHERE household_id = $1 ORDER BY created_at, user_id;
+
+-- name: HouseholdMemberCountByRole :one
+SELECT count(*) FROM household_member WHERE household_id = $1 AND role = $2;
+
+-- name: HouseholdMemberDelete :exec
+DELETE FROM household_member WHERE household_id = $1 AND user_id = $2;
+
+-- name: HouseholdInvitationUpsert :exec
+INSERT INTO household_invitation (id, household_id, user_id, email, role, invited_by, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (household_id, user_id) DO UPDATE SET role = excluded.role, invited_by = excluded.invited_by;
+
+-- name: HouseholdInvitationGetByID :one
+SELECT * FROM household_invitation WHERE id = $1;
+
+-- name: HouseholdInvitationGetByUser :one
+SELECT * FROM household_invitation WHERE household_id = $1 AND user_id = $2;
+
+-- name: HouseholdInvitationListByUser :many
+SELECT * FROM household_invitation WHERE user_id = $1 ORDER BY created_at, id;
+
+-- name: HouseholdInvitationDelete :exec
+DELETE FROM household_invitation WHERE id = $1;
+
+-- name: BudgetInsert :exec
+INSERT INTO budget (id, user_id, wallet_id, category_id, currency, period, amount, rollover, starts_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: BudgetGetByID :one
+SELECT * FROM budget WHERE id = $1;
+
+-- name: BudgetListByUser :many
+SELECT * FROM budget WHERE user_id = $1 ORDER BY created_at, id;
+
+-- name: BudgetUpdate :exec
+UPDATE budget SET period = $1, amount = $2, rollover = $3, starts_at = $4 WHERE id = $5;
+
+-- name: BudgetSetCategory :exec
+UPDATE budget SET category_id = sqlc.narg(new_category_id) WHERE category_id = sqlc.arg(category_id);
+
+-- name: BudgetDeleteByCategory :exec
+DELETE FROM budget WHERE category_id = $1;
+
+-- name: BudgetDelete :exec
+DELETE FROM budget WHERE id = $1;
+
+-- name: RecurringInsert :exec
+INSERT INTO recurring (id, user_id, email, wallet_id, kind, amount, description, category_id, rrule, starts_at, ends_at,
+                       next_at, occurrences, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14);
+
+-- name: RecurringGetByID :one
+SELECT * FROM recurring WHERE id = $1;
+
+-- name: RecurringListByUser :many
+SELECT * FROM recurring WHERE user_id = $1 ORDER BY created_at, id;
+
+-- name: RecurringListDue :many
+SELECT * FROM recurring WHERE next_at IS NOT NULL AND next_at <= sqlc.arg(until) ORDER BY next_at, id;
+
+-- name: RecurringAdvance :execrows
+UPDATE recurring SET next_at = sqlc.narg(next_at), occurrences = sqlc.arg(occurrences)
+WHERE id = sqlc.arg(rule_id) AND occurrences = sqlc.arg(previous_occurrences);
+
+-- name: RecurringSetCategory :exec
+UPDATE recurring SET category_id = sqlc.narg(new_category_id) WHERE category_id = sqlc.arg(category_id);
+
+-- name: RecurringDelete :exec
+DELETE FROM recurring WHERE id = $1;
 
 -- name: ExpenseInsert :exec
-INSERT INTO expense (id, wallet_id, amount, description, created_at) VALUES ($1, $2, $3, $4, $5);
+INSERT INTO expense (id, wallet_id, amount, description, category_id, created_at, external_id) VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- ExpenseListByAmount lists expenses of a wallet with given amount, created between created_from and created_to.
+-- name: ExpenseListByAmount :many
+SELECT * FROM expense
+WHERE wallet_id = sqlc.arg(wallet_id) AND amount = sqlc.arg(amount)
+  AND created_at >= sqlc.arg(created_from) AND created_at <= sqlc.arg(created_to)
+ORDER BY created_at, id;
+
+-- name: ExpenseSetExternalID :exec
+UPDATE expense SET external_id = sqlc.narg(external_id) WHERE id = sqlc.arg(expense_id);
+
+-- name: ExpenseExternalIDCount :one
+SELECT count(*) FROM expense WHERE wallet_id = $1 AND external_id = $2;
+
+-- name: ExpenseGetByID :one
+SELECT * FROM expense WHERE id = $1;
+
+-- name: ExpenseUpdate :exec
+UPDATE expense SET amount = $1, description = $2, category_id = $3, created_at = $4 WHERE id = $5;
+
+-- name: ExpenseDelete :exec
+DELETE FROM expense WHERE id = $1;
 
 -- name: ExpenseListByWallet :many
 SELECT * FROM expense WHERE wallet_id = $1 ORDER BY id;
@@ -28,6 +248,134 @@ SELECT * FROM expense WHERE wallet_id = $1 AND wallet_id IN (
 ) 
 ORDER BY id;
 
+-- name: ExpenseSetCategory :exec
+UPDATE expense SET category_id = sqlc.narg(new_category_id) WHERE category_id = sqlc.arg(category_id);
+
+-- name: ExpenseSetDebt :exec
+UPDATE expense SET debt_id = sqlc.narg(debt_id) WHERE id = sqlc.arg(expense_id);
+
+-- name: ExpenseClearDebt :exec
+UPDATE expense SET debt_id = NULL WHERE debt_id = $1;
+
+-- ExpenseListByDebt lists expenses repaying a debt in order of time.
+-- name: ExpenseListByDebt :many
+SELECT * FROM expense WHERE debt_id = $1 ORDER BY created_at, id;
+
+-- name: TagInsert :exec
+INSERT INTO tag (id, user_id, name, created_at) VALUES ($1, $2, $3, $4);
+
+-- name: TagGetByName :one
+SELECT * FROM tag WHERE user_id = $1 AND name = $2;
+
+-- name: TagListByUser :many
+SELECT * FROM tag WHERE user_id = $1 ORDER BY name;
+
+-- name: TagListByExpense :many
+SELECT tag.* FROM tag JOIN expense_tag ON expense_tag.tag_id = tag.id WHERE expense_tag.expense_id = $1 ORDER BY tag.name;
+
+-- name: ExpenseTagInsert :exec
+INSERT INTO expense_tag (expense_id, tag_id) VALUES ($1, $2) ON CONFLICT DO NOTHING;
+
+-- name: ExpenseTagDeleteByName :exec
+DELETE FROM expense_tag WHERE expense_id = $1 AND tag_id IN (SELECT tag.id FROM tag WHERE tag.user_id = $2 AND tag.name = $3);
+
+-- name: ExpenseTagDeleteByExpense :exec
+DELETE FROM expense_tag WHERE expense_id = $1;
+
+-- name: CategoryInsert :exec
+INSERT INTO category (id, user_id, parent_id, name, created_at) VALUES ($1, $2, $3, $4, $5);
+
+-- name: CategoryGetByID :one
+SELECT * FROM category WHERE id = $1;
+
+-- name: CategoryListByUser :many
+SELECT * FROM category WHERE user_id = $1 ORDER BY name, id;
+
+-- CategoryListByWallet lists categories assigned to expenses of a wallet, which may belong to any user sharing it.
+-- name: CategoryListByWallet :many
+SELECT * FROM category WHERE id IN (
+    SELECT expense.category_id FROM expense WHERE expense.wallet_id = $1
+) ORDER BY name, id;
+
+-- name: CategoryCountByUser :one
+SELECT count(*) FROM category WHERE user_id = $1;
+
+-- name: CategoryUpdate :exec
+UPDATE category SET name = $1, parent_id = $2 WHERE id = $3;
+
+-- name: CategorySetParent :exec
+UPDATE category SET parent_id = sqlc.narg(new_parent_id) WHERE parent_id = sqlc.arg(parent_id);
+
+-- name: CategoryDelete :exec
+DELETE FROM category WHERE id = $1;
+
+-- name: IncomeInsert :exec
+INSERT INTO income (id, wallet_id, amount, description, created_at, external_id) VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: IncomeExternalIDCount :one
+SELECT count(*) FROM income WHERE wallet_id = $1 AND external_id = $2;
+
+-- name: IncomeGetByID :one
+SELECT * FROM income WHERE id = $1;
+
+-- name: IncomeUpdate :exec
+UPDATE income SET amount = $1, description = $2, created_at = $3 WHERE id = $4;
+
+-- name: IncomeDelete :exec
+DELETE FROM income WHERE id = $1;
+
+-- name: IncomeListByWallet :many
+SELECT * FROM income WHERE wallet_id = $1 ORDER BY id;
+
+-- name: TransferInsert :exec
+INSERT INTO transfer (id, transfer_id, wallet_id, counterpart_wallet_id, amount, rate, description, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: TransferListByTransferID :many
+SELECT * FROM transfer WHERE transfer_id = $1 ORDER BY amount;
+
+-- name: TransferListByWallet :many
+SELECT * FROM transfer WHERE wallet_id = $1 ORDER BY id;
+
+-- name: TradeInsert :exec
+INSERT INTO trade (id, wallet_id, ticker, kind, quantity, price, fee, amount, description, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: TradeGetByID :one
+SELECT * FROM trade WHERE id = $1;
+
+-- name: TradeDelete :exec
+DELETE FROM trade WHERE id = $1;
+
+-- TradeListByWallet lists trades of a wallet in order of time. Buys come before dividends and sells made at the same
+-- time, so lots are bought before they are sold.
+-- name: TradeListByWallet :many
+SELECT * FROM trade WHERE wallet_id = $1 ORDER BY created_at, kind, id;
+
+-- name: SecurityPriceUpsert :exec
+INSERT INTO security_price (ticker, priced_on, price, currency, source, created_at) VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (ticker, priced_on) DO UPDATE SET price = excluded.price, currency = excluded.currency,
+    source = excluded.source, created_at = excluded.created_at;
+
+-- SecurityPriceLatest returns the latest price of a security on given day or before.
+-- name: SecurityPriceLatest :one
+SELECT * FROM security_price WHERE ticker = sqlc.arg(ticker) AND priced_on <= sqlc.arg(priced_on)
+ORDER BY priced_on DESC LIMIT 1;
+
+-- name: DebtInsert :exec
+INSERT INTO debt (id, user_id, name, lender, currency, principal, interest_rate, term_months, payment_day, starts_at,
+                  created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: DebtGetByID :one
+SELECT * FROM debt WHERE id = $1;
+
+-- name: DebtListByUser :many
+SELECT * FROM debt WHERE user_id = $1 ORDER BY starts_at, id;
+
+-- name: DebtDelete :exec
+DELETE FROM debt WHERE id = $1;
+
 -- name: LocalUserInsert :exec
 INSERT INTO local_user (id, email, display_name, roles, created_at, pwdhash) VALUES ($1, $2, $3, $4, $5, $6);
 
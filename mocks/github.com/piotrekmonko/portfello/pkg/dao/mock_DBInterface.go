@@ -8,7 +8,11 @@ import (
 	dao "github.com/piotrekmonko/portfello/pkg/dao"
 	mock "github.com/stretchr/testify/mock"
 
+	money "github.com/piotrekmonko/portfello/pkg/money"
+
 	sql "database/sql"
+
+	time "time"
 )
 
 // MockDBInterface is an autogenerated mock type for the DBInterface type
@@ -24,6 +28,213 @@ func (_m *MockDBInterface) EXPECT() *MockDBInterface_Expecter {
 	return &MockDBInterface_Expecter{mock: &_m.Mock}
 }
 
+// Audit provides a mock function with given fields: ctx, email, namespace, reference, event
+func (_m *MockDBInterface) Audit(ctx context.Context, email string, namespace string, reference string, event string) error {
+	ret := _m.Called(ctx, email, namespace, reference, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Audit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, email, namespace, reference, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_Audit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Audit'
+type MockDBInterface_Audit_Call struct {
+	*mock.Call
+}
+
+// Audit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+//   - namespace string
+//   - reference string
+//   - event string
+func (_e *MockDBInterface_Expecter) Audit(ctx interface{}, email interface{}, namespace interface{}, reference interface{}, event interface{}) *MockDBInterface_Audit_Call {
+	return &MockDBInterface_Audit_Call{Call: _e.mock.On("Audit", ctx, email, namespace, reference, event)}
+}
+
+func (_c *MockDBInterface_Audit_Call) Run(run func(ctx context.Context, email string, namespace string, reference string, event string)) *MockDBInterface_Audit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_Audit_Call) Return(_a0 error) *MockDBInterface_Audit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_Audit_Call) RunAndReturn(run func(context.Context, string, string, string, string) error) *MockDBInterface_Audit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BalanceSnapshotDeleteAfter provides a mock function with given fields: ctx, walletID, createdAt
+func (_m *MockDBInterface) BalanceSnapshotDeleteAfter(ctx context.Context, walletID string, createdAt time.Time) error {
+	ret := _m.Called(ctx, walletID, createdAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BalanceSnapshotDeleteAfter")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, walletID, createdAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_BalanceSnapshotDeleteAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BalanceSnapshotDeleteAfter'
+type MockDBInterface_BalanceSnapshotDeleteAfter_Call struct {
+	*mock.Call
+}
+
+// BalanceSnapshotDeleteAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - createdAt time.Time
+func (_e *MockDBInterface_Expecter) BalanceSnapshotDeleteAfter(ctx interface{}, walletID interface{}, createdAt interface{}) *MockDBInterface_BalanceSnapshotDeleteAfter_Call {
+	return &MockDBInterface_BalanceSnapshotDeleteAfter_Call{Call: _e.mock.On("BalanceSnapshotDeleteAfter", ctx, walletID, createdAt)}
+}
+
+func (_c *MockDBInterface_BalanceSnapshotDeleteAfter_Call) Run(run func(ctx context.Context, walletID string, createdAt time.Time)) *MockDBInterface_BalanceSnapshotDeleteAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_BalanceSnapshotDeleteAfter_Call) Return(_a0 error) *MockDBInterface_BalanceSnapshotDeleteAfter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_BalanceSnapshotDeleteAfter_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *MockDBInterface_BalanceSnapshotDeleteAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BalanceSnapshotLatest provides a mock function with given fields: ctx, walletID, takenAt
+func (_m *MockDBInterface) BalanceSnapshotLatest(ctx context.Context, walletID string, takenAt time.Time) (*dao.BalanceSnapshot, error) {
+	ret := _m.Called(ctx, walletID, takenAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BalanceSnapshotLatest")
+	}
+
+	var r0 *dao.BalanceSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (*dao.BalanceSnapshot, error)); ok {
+		return rf(ctx, walletID, takenAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) *dao.BalanceSnapshot); ok {
+		r0 = rf(ctx, walletID, takenAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.BalanceSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, walletID, takenAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_BalanceSnapshotLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BalanceSnapshotLatest'
+type MockDBInterface_BalanceSnapshotLatest_Call struct {
+	*mock.Call
+}
+
+// BalanceSnapshotLatest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - takenAt time.Time
+func (_e *MockDBInterface_Expecter) BalanceSnapshotLatest(ctx interface{}, walletID interface{}, takenAt interface{}) *MockDBInterface_BalanceSnapshotLatest_Call {
+	return &MockDBInterface_BalanceSnapshotLatest_Call{Call: _e.mock.On("BalanceSnapshotLatest", ctx, walletID, takenAt)}
+}
+
+func (_c *MockDBInterface_BalanceSnapshotLatest_Call) Run(run func(ctx context.Context, walletID string, takenAt time.Time)) *MockDBInterface_BalanceSnapshotLatest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_BalanceSnapshotLatest_Call) Return(_a0 *dao.BalanceSnapshot, _a1 error) *MockDBInterface_BalanceSnapshotLatest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_BalanceSnapshotLatest_Call) RunAndReturn(run func(context.Context, string, time.Time) (*dao.BalanceSnapshot, error)) *MockDBInterface_BalanceSnapshotLatest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BalanceSnapshotUpsert provides a mock function with given fields: ctx, walletID, takenAt, balance
+func (_m *MockDBInterface) BalanceSnapshotUpsert(ctx context.Context, walletID string, takenAt time.Time, balance money.Decimal) error {
+	ret := _m.Called(ctx, walletID, takenAt, balance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BalanceSnapshotUpsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, money.Decimal) error); ok {
+		r0 = rf(ctx, walletID, takenAt, balance)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_BalanceSnapshotUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BalanceSnapshotUpsert'
+type MockDBInterface_BalanceSnapshotUpsert_Call struct {
+	*mock.Call
+}
+
+// BalanceSnapshotUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - takenAt time.Time
+//   - balance money.Decimal
+func (_e *MockDBInterface_Expecter) BalanceSnapshotUpsert(ctx interface{}, walletID interface{}, takenAt interface{}, balance interface{}) *MockDBInterface_BalanceSnapshotUpsert_Call {
+	return &MockDBInterface_BalanceSnapshotUpsert_Call{Call: _e.mock.On("BalanceSnapshotUpsert", ctx, walletID, takenAt, balance)}
+}
+
+func (_c *MockDBInterface_BalanceSnapshotUpsert_Call) Run(run func(ctx context.Context, walletID string, takenAt time.Time, balance money.Decimal)) *MockDBInterface_BalanceSnapshotUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(money.Decimal))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_BalanceSnapshotUpsert_Call) Return(_a0 error) *MockDBInterface_BalanceSnapshotUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_BalanceSnapshotUpsert_Call) RunAndReturn(run func(context.Context, string, time.Time, money.Decimal) error) *MockDBInterface_BalanceSnapshotUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BeginTx provides a mock function with given fields: ctx
 func (_m *MockDBInterface) BeginTx(ctx context.Context) (dao.DBInterface, func(), error) {
 	ret := _m.Called(ctx)
@@ -46,62 +257,5161 @@ func (_m *MockDBInterface) BeginTx(ctx context.Context) (dao.DBInterface, func()
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) func()); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context) func()); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDBInterface_BeginTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginTx'
+type MockDBInterface_BeginTx_Call struct {
+	*mock.Call
+}
+
+// BeginTx is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDBInterface_Expecter) BeginTx(ctx interface{}) *MockDBInterface_BeginTx_Call {
+	return &MockDBInterface_BeginTx_Call{Call: _e.mock.On("BeginTx", ctx)}
+}
+
+func (_c *MockDBInterface_BeginTx_Call) Run(run func(ctx context.Context)) *MockDBInterface_BeginTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_BeginTx_Call) Return(_a0 dao.DBInterface, _a1 func(), _a2 error) *MockDBInterface_BeginTx_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockDBInterface_BeginTx_Call) RunAndReturn(run func(context.Context) (dao.DBInterface, func(), error)) *MockDBInterface_BeginTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BudgetDelete provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) BudgetDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BudgetDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_BudgetDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BudgetDelete'
+type MockDBInterface_BudgetDelete_Call struct {
+	*mock.Call
+}
+
+// BudgetDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) BudgetDelete(ctx interface{}, id interface{}) *MockDBInterface_BudgetDelete_Call {
+	return &MockDBInterface_BudgetDelete_Call{Call: _e.mock.On("BudgetDelete", ctx, id)}
+}
+
+func (_c *MockDBInterface_BudgetDelete_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_BudgetDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_BudgetDelete_Call) Return(_a0 error) *MockDBInterface_BudgetDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_BudgetDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockDBInterface_BudgetDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BudgetDeleteByCategory provides a mock function with given fields: ctx, categoryID
+func (_m *MockDBInterface) BudgetDeleteByCategory(ctx context.Context, categoryID sql.NullString) error {
+	ret := _m.Called(ctx, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BudgetDeleteByCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString) error); ok {
+		r0 = rf(ctx, categoryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_BudgetDeleteByCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BudgetDeleteByCategory'
+type MockDBInterface_BudgetDeleteByCategory_Call struct {
+	*mock.Call
+}
+
+// BudgetDeleteByCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - categoryID sql.NullString
+func (_e *MockDBInterface_Expecter) BudgetDeleteByCategory(ctx interface{}, categoryID interface{}) *MockDBInterface_BudgetDeleteByCategory_Call {
+	return &MockDBInterface_BudgetDeleteByCategory_Call{Call: _e.mock.On("BudgetDeleteByCategory", ctx, categoryID)}
+}
+
+func (_c *MockDBInterface_BudgetDeleteByCategory_Call) Run(run func(ctx context.Context, categoryID sql.NullString)) *MockDBInterface_BudgetDeleteByCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_BudgetDeleteByCategory_Call) Return(_a0 error) *MockDBInterface_BudgetDeleteByCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_BudgetDeleteByCategory_Call) RunAndReturn(run func(context.Context, sql.NullString) error) *MockDBInterface_BudgetDeleteByCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BudgetGetByID provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) BudgetGetByID(ctx context.Context, id string) (*dao.Budget, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BudgetGetByID")
+	}
+
+	var r0 *dao.Budget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Budget, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Budget); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Budget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_BudgetGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BudgetGetByID'
+type MockDBInterface_BudgetGetByID_Call struct {
+	*mock.Call
+}
+
+// BudgetGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) BudgetGetByID(ctx interface{}, id interface{}) *MockDBInterface_BudgetGetByID_Call {
+	return &MockDBInterface_BudgetGetByID_Call{Call: _e.mock.On("BudgetGetByID", ctx, id)}
+}
+
+func (_c *MockDBInterface_BudgetGetByID_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_BudgetGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_BudgetGetByID_Call) Return(_a0 *dao.Budget, _a1 error) *MockDBInterface_BudgetGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_BudgetGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Budget, error)) *MockDBInterface_BudgetGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BudgetInsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) BudgetInsert(ctx context.Context, arg *dao.BudgetInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BudgetInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.BudgetInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_BudgetInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BudgetInsert'
+type MockDBInterface_BudgetInsert_Call struct {
+	*mock.Call
+}
+
+// BudgetInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.BudgetInsertParams
+func (_e *MockDBInterface_Expecter) BudgetInsert(ctx interface{}, arg interface{}) *MockDBInterface_BudgetInsert_Call {
+	return &MockDBInterface_BudgetInsert_Call{Call: _e.mock.On("BudgetInsert", ctx, arg)}
+}
+
+func (_c *MockDBInterface_BudgetInsert_Call) Run(run func(ctx context.Context, arg *dao.BudgetInsertParams)) *MockDBInterface_BudgetInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.BudgetInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_BudgetInsert_Call) Return(_a0 error) *MockDBInterface_BudgetInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_BudgetInsert_Call) RunAndReturn(run func(context.Context, *dao.BudgetInsertParams) error) *MockDBInterface_BudgetInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BudgetListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockDBInterface) BudgetListByUser(ctx context.Context, userID string) ([]*dao.Budget, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BudgetListByUser")
+	}
+
+	var r0 []*dao.Budget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Budget, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Budget); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Budget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_BudgetListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BudgetListByUser'
+type MockDBInterface_BudgetListByUser_Call struct {
+	*mock.Call
+}
+
+// BudgetListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockDBInterface_Expecter) BudgetListByUser(ctx interface{}, userID interface{}) *MockDBInterface_BudgetListByUser_Call {
+	return &MockDBInterface_BudgetListByUser_Call{Call: _e.mock.On("BudgetListByUser", ctx, userID)}
+}
+
+func (_c *MockDBInterface_BudgetListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockDBInterface_BudgetListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_BudgetListByUser_Call) Return(_a0 []*dao.Budget, _a1 error) *MockDBInterface_BudgetListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_BudgetListByUser_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Budget, error)) *MockDBInterface_BudgetListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BudgetSetCategory provides a mock function with given fields: ctx, newCategoryID, categoryID
+func (_m *MockDBInterface) BudgetSetCategory(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString) error {
+	ret := _m.Called(ctx, newCategoryID, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BudgetSetCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString, sql.NullString) error); ok {
+		r0 = rf(ctx, newCategoryID, categoryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_BudgetSetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BudgetSetCategory'
+type MockDBInterface_BudgetSetCategory_Call struct {
+	*mock.Call
+}
+
+// BudgetSetCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newCategoryID sql.NullString
+//   - categoryID sql.NullString
+func (_e *MockDBInterface_Expecter) BudgetSetCategory(ctx interface{}, newCategoryID interface{}, categoryID interface{}) *MockDBInterface_BudgetSetCategory_Call {
+	return &MockDBInterface_BudgetSetCategory_Call{Call: _e.mock.On("BudgetSetCategory", ctx, newCategoryID, categoryID)}
+}
+
+func (_c *MockDBInterface_BudgetSetCategory_Call) Run(run func(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString)) *MockDBInterface_BudgetSetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString), args[2].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_BudgetSetCategory_Call) Return(_a0 error) *MockDBInterface_BudgetSetCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_BudgetSetCategory_Call) RunAndReturn(run func(context.Context, sql.NullString, sql.NullString) error) *MockDBInterface_BudgetSetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BudgetUpdate provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) BudgetUpdate(ctx context.Context, arg *dao.BudgetUpdateParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BudgetUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.BudgetUpdateParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_BudgetUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BudgetUpdate'
+type MockDBInterface_BudgetUpdate_Call struct {
+	*mock.Call
+}
+
+// BudgetUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.BudgetUpdateParams
+func (_e *MockDBInterface_Expecter) BudgetUpdate(ctx interface{}, arg interface{}) *MockDBInterface_BudgetUpdate_Call {
+	return &MockDBInterface_BudgetUpdate_Call{Call: _e.mock.On("BudgetUpdate", ctx, arg)}
+}
+
+func (_c *MockDBInterface_BudgetUpdate_Call) Run(run func(ctx context.Context, arg *dao.BudgetUpdateParams)) *MockDBInterface_BudgetUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.BudgetUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_BudgetUpdate_Call) Return(_a0 error) *MockDBInterface_BudgetUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_BudgetUpdate_Call) RunAndReturn(run func(context.Context, *dao.BudgetUpdateParams) error) *MockDBInterface_BudgetUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryCountByUser provides a mock function with given fields: ctx, userID
+func (_m *MockDBInterface) CategoryCountByUser(ctx context.Context, userID string) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryCountByUser")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_CategoryCountByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryCountByUser'
+type MockDBInterface_CategoryCountByUser_Call struct {
+	*mock.Call
+}
+
+// CategoryCountByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockDBInterface_Expecter) CategoryCountByUser(ctx interface{}, userID interface{}) *MockDBInterface_CategoryCountByUser_Call {
+	return &MockDBInterface_CategoryCountByUser_Call{Call: _e.mock.On("CategoryCountByUser", ctx, userID)}
+}
+
+func (_c *MockDBInterface_CategoryCountByUser_Call) Run(run func(ctx context.Context, userID string)) *MockDBInterface_CategoryCountByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_CategoryCountByUser_Call) Return(_a0 int64, _a1 error) *MockDBInterface_CategoryCountByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_CategoryCountByUser_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *MockDBInterface_CategoryCountByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryDelete provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) CategoryDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_CategoryDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryDelete'
+type MockDBInterface_CategoryDelete_Call struct {
+	*mock.Call
+}
+
+// CategoryDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) CategoryDelete(ctx interface{}, id interface{}) *MockDBInterface_CategoryDelete_Call {
+	return &MockDBInterface_CategoryDelete_Call{Call: _e.mock.On("CategoryDelete", ctx, id)}
+}
+
+func (_c *MockDBInterface_CategoryDelete_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_CategoryDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_CategoryDelete_Call) Return(_a0 error) *MockDBInterface_CategoryDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_CategoryDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockDBInterface_CategoryDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryGetByID provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) CategoryGetByID(ctx context.Context, id string) (*dao.Category, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryGetByID")
+	}
+
+	var r0 *dao.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Category, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Category); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_CategoryGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryGetByID'
+type MockDBInterface_CategoryGetByID_Call struct {
+	*mock.Call
+}
+
+// CategoryGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) CategoryGetByID(ctx interface{}, id interface{}) *MockDBInterface_CategoryGetByID_Call {
+	return &MockDBInterface_CategoryGetByID_Call{Call: _e.mock.On("CategoryGetByID", ctx, id)}
+}
+
+func (_c *MockDBInterface_CategoryGetByID_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_CategoryGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_CategoryGetByID_Call) Return(_a0 *dao.Category, _a1 error) *MockDBInterface_CategoryGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_CategoryGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Category, error)) *MockDBInterface_CategoryGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryInsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) CategoryInsert(ctx context.Context, arg *dao.CategoryInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CategoryInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_CategoryInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryInsert'
+type MockDBInterface_CategoryInsert_Call struct {
+	*mock.Call
+}
+
+// CategoryInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.CategoryInsertParams
+func (_e *MockDBInterface_Expecter) CategoryInsert(ctx interface{}, arg interface{}) *MockDBInterface_CategoryInsert_Call {
+	return &MockDBInterface_CategoryInsert_Call{Call: _e.mock.On("CategoryInsert", ctx, arg)}
+}
+
+func (_c *MockDBInterface_CategoryInsert_Call) Run(run func(ctx context.Context, arg *dao.CategoryInsertParams)) *MockDBInterface_CategoryInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.CategoryInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_CategoryInsert_Call) Return(_a0 error) *MockDBInterface_CategoryInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_CategoryInsert_Call) RunAndReturn(run func(context.Context, *dao.CategoryInsertParams) error) *MockDBInterface_CategoryInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockDBInterface) CategoryListByUser(ctx context.Context, userID string) ([]*dao.Category, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryListByUser")
+	}
+
+	var r0 []*dao.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Category, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Category); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_CategoryListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryListByUser'
+type MockDBInterface_CategoryListByUser_Call struct {
+	*mock.Call
+}
+
+// CategoryListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockDBInterface_Expecter) CategoryListByUser(ctx interface{}, userID interface{}) *MockDBInterface_CategoryListByUser_Call {
+	return &MockDBInterface_CategoryListByUser_Call{Call: _e.mock.On("CategoryListByUser", ctx, userID)}
+}
+
+func (_c *MockDBInterface_CategoryListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockDBInterface_CategoryListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_CategoryListByUser_Call) Return(_a0 []*dao.Category, _a1 error) *MockDBInterface_CategoryListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_CategoryListByUser_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Category, error)) *MockDBInterface_CategoryListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryListByWallet provides a mock function with given fields: ctx, walletID
+func (_m *MockDBInterface) CategoryListByWallet(ctx context.Context, walletID string) ([]*dao.Category, error) {
+	ret := _m.Called(ctx, walletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryListByWallet")
+	}
+
+	var r0 []*dao.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Category, error)); ok {
+		return rf(ctx, walletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Category); ok {
+		r0 = rf(ctx, walletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, walletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_CategoryListByWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryListByWallet'
+type MockDBInterface_CategoryListByWallet_Call struct {
+	*mock.Call
+}
+
+// CategoryListByWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+func (_e *MockDBInterface_Expecter) CategoryListByWallet(ctx interface{}, walletID interface{}) *MockDBInterface_CategoryListByWallet_Call {
+	return &MockDBInterface_CategoryListByWallet_Call{Call: _e.mock.On("CategoryListByWallet", ctx, walletID)}
+}
+
+func (_c *MockDBInterface_CategoryListByWallet_Call) Run(run func(ctx context.Context, walletID string)) *MockDBInterface_CategoryListByWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_CategoryListByWallet_Call) Return(_a0 []*dao.Category, _a1 error) *MockDBInterface_CategoryListByWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_CategoryListByWallet_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Category, error)) *MockDBInterface_CategoryListByWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategorySetParent provides a mock function with given fields: ctx, newParentID, parentID
+func (_m *MockDBInterface) CategorySetParent(ctx context.Context, newParentID sql.NullString, parentID sql.NullString) error {
+	ret := _m.Called(ctx, newParentID, parentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategorySetParent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString, sql.NullString) error); ok {
+		r0 = rf(ctx, newParentID, parentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_CategorySetParent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategorySetParent'
+type MockDBInterface_CategorySetParent_Call struct {
+	*mock.Call
+}
+
+// CategorySetParent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newParentID sql.NullString
+//   - parentID sql.NullString
+func (_e *MockDBInterface_Expecter) CategorySetParent(ctx interface{}, newParentID interface{}, parentID interface{}) *MockDBInterface_CategorySetParent_Call {
+	return &MockDBInterface_CategorySetParent_Call{Call: _e.mock.On("CategorySetParent", ctx, newParentID, parentID)}
+}
+
+func (_c *MockDBInterface_CategorySetParent_Call) Run(run func(ctx context.Context, newParentID sql.NullString, parentID sql.NullString)) *MockDBInterface_CategorySetParent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString), args[2].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_CategorySetParent_Call) Return(_a0 error) *MockDBInterface_CategorySetParent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_CategorySetParent_Call) RunAndReturn(run func(context.Context, sql.NullString, sql.NullString) error) *MockDBInterface_CategorySetParent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryUpdate provides a mock function with given fields: ctx, name, parentID, iD
+func (_m *MockDBInterface) CategoryUpdate(ctx context.Context, name string, parentID sql.NullString, iD string) error {
+	ret := _m.Called(ctx, name, parentID, iD)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, sql.NullString, string) error); ok {
+		r0 = rf(ctx, name, parentID, iD)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_CategoryUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryUpdate'
+type MockDBInterface_CategoryUpdate_Call struct {
+	*mock.Call
+}
+
+// CategoryUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - parentID sql.NullString
+//   - iD string
+func (_e *MockDBInterface_Expecter) CategoryUpdate(ctx interface{}, name interface{}, parentID interface{}, iD interface{}) *MockDBInterface_CategoryUpdate_Call {
+	return &MockDBInterface_CategoryUpdate_Call{Call: _e.mock.On("CategoryUpdate", ctx, name, parentID, iD)}
+}
+
+func (_c *MockDBInterface_CategoryUpdate_Call) Run(run func(ctx context.Context, name string, parentID sql.NullString, iD string)) *MockDBInterface_CategoryUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(sql.NullString), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_CategoryUpdate_Call) Return(_a0 error) *MockDBInterface_CategoryUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_CategoryUpdate_Call) RunAndReturn(run func(context.Context, string, sql.NullString, string) error) *MockDBInterface_CategoryUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Commit provides a mock function with given fields: ctx
+func (_m *MockDBInterface) Commit(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type MockDBInterface_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDBInterface_Expecter) Commit(ctx interface{}) *MockDBInterface_Commit_Call {
+	return &MockDBInterface_Commit_Call{Call: _e.mock.On("Commit", ctx)}
+}
+
+func (_c *MockDBInterface_Commit_Call) Run(run func(ctx context.Context)) *MockDBInterface_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_Commit_Call) Return(_a0 error) *MockDBInterface_Commit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_Commit_Call) RunAndReturn(run func(context.Context) error) *MockDBInterface_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DB provides a mock function with given fields:
+func (_m *MockDBInterface) DB() *sql.DB {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DB")
+	}
+
+	var r0 *sql.DB
+	if rf, ok := ret.Get(0).(func() *sql.DB); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.DB)
+		}
+	}
+
+	return r0
+}
+
+// MockDBInterface_DB_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DB'
+type MockDBInterface_DB_Call struct {
+	*mock.Call
+}
+
+// DB is a helper method to define mock.On call
+func (_e *MockDBInterface_Expecter) DB() *MockDBInterface_DB_Call {
+	return &MockDBInterface_DB_Call{Call: _e.mock.On("DB")}
+}
+
+func (_c *MockDBInterface_DB_Call) Run(run func()) *MockDBInterface_DB_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_DB_Call) Return(_a0 *sql.DB) *MockDBInterface_DB_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_DB_Call) RunAndReturn(run func() *sql.DB) *MockDBInterface_DB_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DebtDelete provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) DebtDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DebtDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_DebtDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DebtDelete'
+type MockDBInterface_DebtDelete_Call struct {
+	*mock.Call
+}
+
+// DebtDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) DebtDelete(ctx interface{}, id interface{}) *MockDBInterface_DebtDelete_Call {
+	return &MockDBInterface_DebtDelete_Call{Call: _e.mock.On("DebtDelete", ctx, id)}
+}
+
+func (_c *MockDBInterface_DebtDelete_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_DebtDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_DebtDelete_Call) Return(_a0 error) *MockDBInterface_DebtDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_DebtDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockDBInterface_DebtDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DebtGetByID provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) DebtGetByID(ctx context.Context, id string) (*dao.Debt, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DebtGetByID")
+	}
+
+	var r0 *dao.Debt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Debt, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Debt); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Debt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_DebtGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DebtGetByID'
+type MockDBInterface_DebtGetByID_Call struct {
+	*mock.Call
+}
+
+// DebtGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) DebtGetByID(ctx interface{}, id interface{}) *MockDBInterface_DebtGetByID_Call {
+	return &MockDBInterface_DebtGetByID_Call{Call: _e.mock.On("DebtGetByID", ctx, id)}
+}
+
+func (_c *MockDBInterface_DebtGetByID_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_DebtGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_DebtGetByID_Call) Return(_a0 *dao.Debt, _a1 error) *MockDBInterface_DebtGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_DebtGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Debt, error)) *MockDBInterface_DebtGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DebtInsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) DebtInsert(ctx context.Context, arg *dao.DebtInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DebtInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.DebtInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_DebtInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DebtInsert'
+type MockDBInterface_DebtInsert_Call struct {
+	*mock.Call
+}
+
+// DebtInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.DebtInsertParams
+func (_e *MockDBInterface_Expecter) DebtInsert(ctx interface{}, arg interface{}) *MockDBInterface_DebtInsert_Call {
+	return &MockDBInterface_DebtInsert_Call{Call: _e.mock.On("DebtInsert", ctx, arg)}
+}
+
+func (_c *MockDBInterface_DebtInsert_Call) Run(run func(ctx context.Context, arg *dao.DebtInsertParams)) *MockDBInterface_DebtInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.DebtInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_DebtInsert_Call) Return(_a0 error) *MockDBInterface_DebtInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_DebtInsert_Call) RunAndReturn(run func(context.Context, *dao.DebtInsertParams) error) *MockDBInterface_DebtInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DebtListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockDBInterface) DebtListByUser(ctx context.Context, userID string) ([]*dao.Debt, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DebtListByUser")
+	}
+
+	var r0 []*dao.Debt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Debt, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Debt); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Debt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_DebtListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DebtListByUser'
+type MockDBInterface_DebtListByUser_Call struct {
+	*mock.Call
+}
+
+// DebtListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockDBInterface_Expecter) DebtListByUser(ctx interface{}, userID interface{}) *MockDBInterface_DebtListByUser_Call {
+	return &MockDBInterface_DebtListByUser_Call{Call: _e.mock.On("DebtListByUser", ctx, userID)}
+}
+
+func (_c *MockDBInterface_DebtListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockDBInterface_DebtListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_DebtListByUser_Call) Return(_a0 []*dao.Debt, _a1 error) *MockDBInterface_DebtListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_DebtListByUser_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Debt, error)) *MockDBInterface_DebtListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExchangeRateBases provides a mock function with given fields: ctx, currency
+func (_m *MockDBInterface) ExchangeRateBases(ctx context.Context, currency string) ([]string, error) {
+	ret := _m.Called(ctx, currency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExchangeRateBases")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, currency)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, currency)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_ExchangeRateBases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeRateBases'
+type MockDBInterface_ExchangeRateBases_Call struct {
+	*mock.Call
+}
+
+// ExchangeRateBases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - currency string
+func (_e *MockDBInterface_Expecter) ExchangeRateBases(ctx interface{}, currency interface{}) *MockDBInterface_ExchangeRateBases_Call {
+	return &MockDBInterface_ExchangeRateBases_Call{Call: _e.mock.On("ExchangeRateBases", ctx, currency)}
+}
+
+func (_c *MockDBInterface_ExchangeRateBases_Call) Run(run func(ctx context.Context, currency string)) *MockDBInterface_ExchangeRateBases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExchangeRateBases_Call) Return(_a0 []string, _a1 error) *MockDBInterface_ExchangeRateBases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_ExchangeRateBases_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *MockDBInterface_ExchangeRateBases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExchangeRateLatest provides a mock function with given fields: ctx, base, currency, validOn
+func (_m *MockDBInterface) ExchangeRateLatest(ctx context.Context, base string, currency string, validOn time.Time) (*dao.ExchangeRate, error) {
+	ret := _m.Called(ctx, base, currency, validOn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExchangeRateLatest")
+	}
+
+	var r0 *dao.ExchangeRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (*dao.ExchangeRate, error)); ok {
+		return rf(ctx, base, currency, validOn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) *dao.ExchangeRate); ok {
+		r0 = rf(ctx, base, currency, validOn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ExchangeRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, base, currency, validOn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_ExchangeRateLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeRateLatest'
+type MockDBInterface_ExchangeRateLatest_Call struct {
+	*mock.Call
+}
+
+// ExchangeRateLatest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - base string
+//   - currency string
+//   - validOn time.Time
+func (_e *MockDBInterface_Expecter) ExchangeRateLatest(ctx interface{}, base interface{}, currency interface{}, validOn interface{}) *MockDBInterface_ExchangeRateLatest_Call {
+	return &MockDBInterface_ExchangeRateLatest_Call{Call: _e.mock.On("ExchangeRateLatest", ctx, base, currency, validOn)}
+}
+
+func (_c *MockDBInterface_ExchangeRateLatest_Call) Run(run func(ctx context.Context, base string, currency string, validOn time.Time)) *MockDBInterface_ExchangeRateLatest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExchangeRateLatest_Call) Return(_a0 *dao.ExchangeRate, _a1 error) *MockDBInterface_ExchangeRateLatest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_ExchangeRateLatest_Call) RunAndReturn(run func(context.Context, string, string, time.Time) (*dao.ExchangeRate, error)) *MockDBInterface_ExchangeRateLatest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExchangeRateUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) ExchangeRateUpsert(ctx context.Context, arg *dao.ExchangeRateUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExchangeRateUpsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExchangeRateUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_ExchangeRateUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeRateUpsert'
+type MockDBInterface_ExchangeRateUpsert_Call struct {
+	*mock.Call
+}
+
+// ExchangeRateUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.ExchangeRateUpsertParams
+func (_e *MockDBInterface_Expecter) ExchangeRateUpsert(ctx interface{}, arg interface{}) *MockDBInterface_ExchangeRateUpsert_Call {
+	return &MockDBInterface_ExchangeRateUpsert_Call{Call: _e.mock.On("ExchangeRateUpsert", ctx, arg)}
+}
+
+func (_c *MockDBInterface_ExchangeRateUpsert_Call) Run(run func(ctx context.Context, arg *dao.ExchangeRateUpsertParams)) *MockDBInterface_ExchangeRateUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.ExchangeRateUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExchangeRateUpsert_Call) Return(_a0 error) *MockDBInterface_ExchangeRateUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_ExchangeRateUpsert_Call) RunAndReturn(run func(context.Context, *dao.ExchangeRateUpsertParams) error) *MockDBInterface_ExchangeRateUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseClearDebt provides a mock function with given fields: ctx, debtID
+func (_m *MockDBInterface) ExpenseClearDebt(ctx context.Context, debtID sql.NullString) error {
+	ret := _m.Called(ctx, debtID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseClearDebt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString) error); ok {
+		r0 = rf(ctx, debtID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_ExpenseClearDebt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseClearDebt'
+type MockDBInterface_ExpenseClearDebt_Call struct {
+	*mock.Call
+}
+
+// ExpenseClearDebt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - debtID sql.NullString
+func (_e *MockDBInterface_Expecter) ExpenseClearDebt(ctx interface{}, debtID interface{}) *MockDBInterface_ExpenseClearDebt_Call {
+	return &MockDBInterface_ExpenseClearDebt_Call{Call: _e.mock.On("ExpenseClearDebt", ctx, debtID)}
+}
+
+func (_c *MockDBInterface_ExpenseClearDebt_Call) Run(run func(ctx context.Context, debtID sql.NullString)) *MockDBInterface_ExpenseClearDebt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseClearDebt_Call) Return(_a0 error) *MockDBInterface_ExpenseClearDebt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseClearDebt_Call) RunAndReturn(run func(context.Context, sql.NullString) error) *MockDBInterface_ExpenseClearDebt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseCount provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) ExpenseCount(ctx context.Context, arg *dao.ExpenseListParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExpenseListParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExpenseListParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.ExpenseListParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_ExpenseCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseCount'
+type MockDBInterface_ExpenseCount_Call struct {
+	*mock.Call
+}
+
+// ExpenseCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.ExpenseListParams
+func (_e *MockDBInterface_Expecter) ExpenseCount(ctx interface{}, arg interface{}) *MockDBInterface_ExpenseCount_Call {
+	return &MockDBInterface_ExpenseCount_Call{Call: _e.mock.On("ExpenseCount", ctx, arg)}
+}
+
+func (_c *MockDBInterface_ExpenseCount_Call) Run(run func(ctx context.Context, arg *dao.ExpenseListParams)) *MockDBInterface_ExpenseCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.ExpenseListParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseCount_Call) Return(_a0 int64, _a1 error) *MockDBInterface_ExpenseCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseCount_Call) RunAndReturn(run func(context.Context, *dao.ExpenseListParams) (int64, error)) *MockDBInterface_ExpenseCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseDelete provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) ExpenseDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_ExpenseDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseDelete'
+type MockDBInterface_ExpenseDelete_Call struct {
+	*mock.Call
+}
+
+// ExpenseDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) ExpenseDelete(ctx interface{}, id interface{}) *MockDBInterface_ExpenseDelete_Call {
+	return &MockDBInterface_ExpenseDelete_Call{Call: _e.mock.On("ExpenseDelete", ctx, id)}
+}
+
+func (_c *MockDBInterface_ExpenseDelete_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_ExpenseDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseDelete_Call) Return(_a0 error) *MockDBInterface_ExpenseDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockDBInterface_ExpenseDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseEach provides a mock function with given fields: ctx, arg, fn
+func (_m *MockDBInterface) ExpenseEach(ctx context.Context, arg *dao.ExpenseListParams, fn func(*dao.Expense) error) error {
+	ret := _m.Called(ctx, arg, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseEach")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExpenseListParams, func(*dao.Expense) error) error); ok {
+		r0 = rf(ctx, arg, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_ExpenseEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseEach'
+type MockDBInterface_ExpenseEach_Call struct {
+	*mock.Call
+}
+
+// ExpenseEach is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.ExpenseListParams
+//   - fn func(*dao.Expense) error
+func (_e *MockDBInterface_Expecter) ExpenseEach(ctx interface{}, arg interface{}, fn interface{}) *MockDBInterface_ExpenseEach_Call {
+	return &MockDBInterface_ExpenseEach_Call{Call: _e.mock.On("ExpenseEach", ctx, arg, fn)}
+}
+
+func (_c *MockDBInterface_ExpenseEach_Call) Run(run func(ctx context.Context, arg *dao.ExpenseListParams, fn func(*dao.Expense) error)) *MockDBInterface_ExpenseEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.ExpenseListParams), args[2].(func(*dao.Expense) error))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseEach_Call) Return(_a0 error) *MockDBInterface_ExpenseEach_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseEach_Call) RunAndReturn(run func(context.Context, *dao.ExpenseListParams, func(*dao.Expense) error) error) *MockDBInterface_ExpenseEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseExternalIDCount provides a mock function with given fields: ctx, walletID, externalID
+func (_m *MockDBInterface) ExpenseExternalIDCount(ctx context.Context, walletID string, externalID sql.NullString) (int64, error) {
+	ret := _m.Called(ctx, walletID, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseExternalIDCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, sql.NullString) (int64, error)); ok {
+		return rf(ctx, walletID, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, sql.NullString) int64); ok {
+		r0 = rf(ctx, walletID, externalID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, sql.NullString) error); ok {
+		r1 = rf(ctx, walletID, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_ExpenseExternalIDCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseExternalIDCount'
+type MockDBInterface_ExpenseExternalIDCount_Call struct {
+	*mock.Call
+}
+
+// ExpenseExternalIDCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - externalID sql.NullString
+func (_e *MockDBInterface_Expecter) ExpenseExternalIDCount(ctx interface{}, walletID interface{}, externalID interface{}) *MockDBInterface_ExpenseExternalIDCount_Call {
+	return &MockDBInterface_ExpenseExternalIDCount_Call{Call: _e.mock.On("ExpenseExternalIDCount", ctx, walletID, externalID)}
+}
+
+func (_c *MockDBInterface_ExpenseExternalIDCount_Call) Run(run func(ctx context.Context, walletID string, externalID sql.NullString)) *MockDBInterface_ExpenseExternalIDCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseExternalIDCount_Call) Return(_a0 int64, _a1 error) *MockDBInterface_ExpenseExternalIDCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseExternalIDCount_Call) RunAndReturn(run func(context.Context, string, sql.NullString) (int64, error)) *MockDBInterface_ExpenseExternalIDCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseGetByID provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) ExpenseGetByID(ctx context.Context, id string) (*dao.Expense, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseGetByID")
+	}
+
+	var r0 *dao.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Expense, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Expense); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_ExpenseGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseGetByID'
+type MockDBInterface_ExpenseGetByID_Call struct {
+	*mock.Call
+}
+
+// ExpenseGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) ExpenseGetByID(ctx interface{}, id interface{}) *MockDBInterface_ExpenseGetByID_Call {
+	return &MockDBInterface_ExpenseGetByID_Call{Call: _e.mock.On("ExpenseGetByID", ctx, id)}
+}
+
+func (_c *MockDBInterface_ExpenseGetByID_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_ExpenseGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseGetByID_Call) Return(_a0 *dao.Expense, _a1 error) *MockDBInterface_ExpenseGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Expense, error)) *MockDBInterface_ExpenseGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseInsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) ExpenseInsert(ctx context.Context, arg *dao.ExpenseInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExpenseInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_ExpenseInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseInsert'
+type MockDBInterface_ExpenseInsert_Call struct {
+	*mock.Call
+}
+
+// ExpenseInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.ExpenseInsertParams
+func (_e *MockDBInterface_Expecter) ExpenseInsert(ctx interface{}, arg interface{}) *MockDBInterface_ExpenseInsert_Call {
+	return &MockDBInterface_ExpenseInsert_Call{Call: _e.mock.On("ExpenseInsert", ctx, arg)}
+}
+
+func (_c *MockDBInterface_ExpenseInsert_Call) Run(run func(ctx context.Context, arg *dao.ExpenseInsertParams)) *MockDBInterface_ExpenseInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.ExpenseInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseInsert_Call) Return(_a0 error) *MockDBInterface_ExpenseInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseInsert_Call) RunAndReturn(run func(context.Context, *dao.ExpenseInsertParams) error) *MockDBInterface_ExpenseInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseList provides a mock function with given fields: ctx, arg, page
+func (_m *MockDBInterface) ExpenseList(ctx context.Context, arg *dao.ExpenseListParams, page *dao.Page) ([]*dao.Expense, error) {
+	ret := _m.Called(ctx, arg, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseList")
+	}
+
+	var r0 []*dao.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExpenseListParams, *dao.Page) ([]*dao.Expense, error)); ok {
+		return rf(ctx, arg, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExpenseListParams, *dao.Page) []*dao.Expense); ok {
+		r0 = rf(ctx, arg, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.ExpenseListParams, *dao.Page) error); ok {
+		r1 = rf(ctx, arg, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_ExpenseList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseList'
+type MockDBInterface_ExpenseList_Call struct {
+	*mock.Call
+}
+
+// ExpenseList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.ExpenseListParams
+//   - page *dao.Page
+func (_e *MockDBInterface_Expecter) ExpenseList(ctx interface{}, arg interface{}, page interface{}) *MockDBInterface_ExpenseList_Call {
+	return &MockDBInterface_ExpenseList_Call{Call: _e.mock.On("ExpenseList", ctx, arg, page)}
+}
+
+func (_c *MockDBInterface_ExpenseList_Call) Run(run func(ctx context.Context, arg *dao.ExpenseListParams, page *dao.Page)) *MockDBInterface_ExpenseList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.ExpenseListParams), args[2].(*dao.Page))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseList_Call) Return(_a0 []*dao.Expense, _a1 error) *MockDBInterface_ExpenseList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseList_Call) RunAndReturn(run func(context.Context, *dao.ExpenseListParams, *dao.Page) ([]*dao.Expense, error)) *MockDBInterface_ExpenseList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseListByAmount provides a mock function with given fields: ctx, walletID, amount, createdFrom, createdTo
+func (_m *MockDBInterface) ExpenseListByAmount(ctx context.Context, walletID string, amount money.Decimal, createdFrom time.Time, createdTo time.Time) ([]*dao.Expense, error) {
+	ret := _m.Called(ctx, walletID, amount, createdFrom, createdTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseListByAmount")
+	}
+
+	var r0 []*dao.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, money.Decimal, time.Time, time.Time) ([]*dao.Expense, error)); ok {
+		return rf(ctx, walletID, amount, createdFrom, createdTo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, money.Decimal, time.Time, time.Time) []*dao.Expense); ok {
+		r0 = rf(ctx, walletID, amount, createdFrom, createdTo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, money.Decimal, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, walletID, amount, createdFrom, createdTo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_ExpenseListByAmount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseListByAmount'
+type MockDBInterface_ExpenseListByAmount_Call struct {
+	*mock.Call
+}
+
+// ExpenseListByAmount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - amount money.Decimal
+//   - createdFrom time.Time
+//   - createdTo time.Time
+func (_e *MockDBInterface_Expecter) ExpenseListByAmount(ctx interface{}, walletID interface{}, amount interface{}, createdFrom interface{}, createdTo interface{}) *MockDBInterface_ExpenseListByAmount_Call {
+	return &MockDBInterface_ExpenseListByAmount_Call{Call: _e.mock.On("ExpenseListByAmount", ctx, walletID, amount, createdFrom, createdTo)}
+}
+
+func (_c *MockDBInterface_ExpenseListByAmount_Call) Run(run func(ctx context.Context, walletID string, amount money.Decimal, createdFrom time.Time, createdTo time.Time)) *MockDBInterface_ExpenseListByAmount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(money.Decimal), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseListByAmount_Call) Return(_a0 []*dao.Expense, _a1 error) *MockDBInterface_ExpenseListByAmount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseListByAmount_Call) RunAndReturn(run func(context.Context, string, money.Decimal, time.Time, time.Time) ([]*dao.Expense, error)) *MockDBInterface_ExpenseListByAmount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseListByDebt provides a mock function with given fields: ctx, debtID
+func (_m *MockDBInterface) ExpenseListByDebt(ctx context.Context, debtID sql.NullString) ([]*dao.Expense, error) {
+	ret := _m.Called(ctx, debtID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseListByDebt")
+	}
+
+	var r0 []*dao.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString) ([]*dao.Expense, error)); ok {
+		return rf(ctx, debtID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString) []*dao.Expense); ok {
+		r0 = rf(ctx, debtID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullString) error); ok {
+		r1 = rf(ctx, debtID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_ExpenseListByDebt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseListByDebt'
+type MockDBInterface_ExpenseListByDebt_Call struct {
+	*mock.Call
+}
+
+// ExpenseListByDebt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - debtID sql.NullString
+func (_e *MockDBInterface_Expecter) ExpenseListByDebt(ctx interface{}, debtID interface{}) *MockDBInterface_ExpenseListByDebt_Call {
+	return &MockDBInterface_ExpenseListByDebt_Call{Call: _e.mock.On("ExpenseListByDebt", ctx, debtID)}
+}
+
+func (_c *MockDBInterface_ExpenseListByDebt_Call) Run(run func(ctx context.Context, debtID sql.NullString)) *MockDBInterface_ExpenseListByDebt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseListByDebt_Call) Return(_a0 []*dao.Expense, _a1 error) *MockDBInterface_ExpenseListByDebt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseListByDebt_Call) RunAndReturn(run func(context.Context, sql.NullString) ([]*dao.Expense, error)) *MockDBInterface_ExpenseListByDebt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseListByWallet provides a mock function with given fields: ctx, walletID
+func (_m *MockDBInterface) ExpenseListByWallet(ctx context.Context, walletID string) ([]*dao.Expense, error) {
+	ret := _m.Called(ctx, walletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseListByWallet")
+	}
+
+	var r0 []*dao.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Expense, error)); ok {
+		return rf(ctx, walletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Expense); ok {
+		r0 = rf(ctx, walletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, walletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_ExpenseListByWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseListByWallet'
+type MockDBInterface_ExpenseListByWallet_Call struct {
+	*mock.Call
+}
+
+// ExpenseListByWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+func (_e *MockDBInterface_Expecter) ExpenseListByWallet(ctx interface{}, walletID interface{}) *MockDBInterface_ExpenseListByWallet_Call {
+	return &MockDBInterface_ExpenseListByWallet_Call{Call: _e.mock.On("ExpenseListByWallet", ctx, walletID)}
+}
+
+func (_c *MockDBInterface_ExpenseListByWallet_Call) Run(run func(ctx context.Context, walletID string)) *MockDBInterface_ExpenseListByWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseListByWallet_Call) Return(_a0 []*dao.Expense, _a1 error) *MockDBInterface_ExpenseListByWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseListByWallet_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Expense, error)) *MockDBInterface_ExpenseListByWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseListByWalletByUser provides a mock function with given fields: ctx, walletID, userID
+func (_m *MockDBInterface) ExpenseListByWalletByUser(ctx context.Context, walletID string, userID string) ([]*dao.Expense, error) {
+	ret := _m.Called(ctx, walletID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseListByWalletByUser")
+	}
+
+	var r0 []*dao.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*dao.Expense, error)); ok {
+		return rf(ctx, walletID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*dao.Expense); ok {
+		r0 = rf(ctx, walletID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, walletID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_ExpenseListByWalletByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseListByWalletByUser'
+type MockDBInterface_ExpenseListByWalletByUser_Call struct {
+	*mock.Call
+}
+
+// ExpenseListByWalletByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - userID string
+func (_e *MockDBInterface_Expecter) ExpenseListByWalletByUser(ctx interface{}, walletID interface{}, userID interface{}) *MockDBInterface_ExpenseListByWalletByUser_Call {
+	return &MockDBInterface_ExpenseListByWalletByUser_Call{Call: _e.mock.On("ExpenseListByWalletByUser", ctx, walletID, userID)}
+}
+
+func (_c *MockDBInterface_ExpenseListByWalletByUser_Call) Run(run func(ctx context.Context, walletID string, userID string)) *MockDBInterface_ExpenseListByWalletByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseListByWalletByUser_Call) Return(_a0 []*dao.Expense, _a1 error) *MockDBInterface_ExpenseListByWalletByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseListByWalletByUser_Call) RunAndReturn(run func(context.Context, string, string) ([]*dao.Expense, error)) *MockDBInterface_ExpenseListByWalletByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseSetCategory provides a mock function with given fields: ctx, newCategoryID, categoryID
+func (_m *MockDBInterface) ExpenseSetCategory(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString) error {
+	ret := _m.Called(ctx, newCategoryID, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseSetCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString, sql.NullString) error); ok {
+		r0 = rf(ctx, newCategoryID, categoryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_ExpenseSetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseSetCategory'
+type MockDBInterface_ExpenseSetCategory_Call struct {
+	*mock.Call
+}
+
+// ExpenseSetCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newCategoryID sql.NullString
+//   - categoryID sql.NullString
+func (_e *MockDBInterface_Expecter) ExpenseSetCategory(ctx interface{}, newCategoryID interface{}, categoryID interface{}) *MockDBInterface_ExpenseSetCategory_Call {
+	return &MockDBInterface_ExpenseSetCategory_Call{Call: _e.mock.On("ExpenseSetCategory", ctx, newCategoryID, categoryID)}
+}
+
+func (_c *MockDBInterface_ExpenseSetCategory_Call) Run(run func(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString)) *MockDBInterface_ExpenseSetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString), args[2].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseSetCategory_Call) Return(_a0 error) *MockDBInterface_ExpenseSetCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseSetCategory_Call) RunAndReturn(run func(context.Context, sql.NullString, sql.NullString) error) *MockDBInterface_ExpenseSetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseSetDebt provides a mock function with given fields: ctx, debtID, expenseID
+func (_m *MockDBInterface) ExpenseSetDebt(ctx context.Context, debtID sql.NullString, expenseID string) error {
+	ret := _m.Called(ctx, debtID, expenseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseSetDebt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString, string) error); ok {
+		r0 = rf(ctx, debtID, expenseID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_ExpenseSetDebt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseSetDebt'
+type MockDBInterface_ExpenseSetDebt_Call struct {
+	*mock.Call
+}
+
+// ExpenseSetDebt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - debtID sql.NullString
+//   - expenseID string
+func (_e *MockDBInterface_Expecter) ExpenseSetDebt(ctx interface{}, debtID interface{}, expenseID interface{}) *MockDBInterface_ExpenseSetDebt_Call {
+	return &MockDBInterface_ExpenseSetDebt_Call{Call: _e.mock.On("ExpenseSetDebt", ctx, debtID, expenseID)}
+}
+
+func (_c *MockDBInterface_ExpenseSetDebt_Call) Run(run func(ctx context.Context, debtID sql.NullString, expenseID string)) *MockDBInterface_ExpenseSetDebt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseSetDebt_Call) Return(_a0 error) *MockDBInterface_ExpenseSetDebt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseSetDebt_Call) RunAndReturn(run func(context.Context, sql.NullString, string) error) *MockDBInterface_ExpenseSetDebt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseSetExternalID provides a mock function with given fields: ctx, externalID, expenseID
+func (_m *MockDBInterface) ExpenseSetExternalID(ctx context.Context, externalID sql.NullString, expenseID string) error {
+	ret := _m.Called(ctx, externalID, expenseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseSetExternalID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString, string) error); ok {
+		r0 = rf(ctx, externalID, expenseID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_ExpenseSetExternalID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseSetExternalID'
+type MockDBInterface_ExpenseSetExternalID_Call struct {
+	*mock.Call
+}
+
+// ExpenseSetExternalID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalID sql.NullString
+//   - expenseID string
+func (_e *MockDBInterface_Expecter) ExpenseSetExternalID(ctx interface{}, externalID interface{}, expenseID interface{}) *MockDBInterface_ExpenseSetExternalID_Call {
+	return &MockDBInterface_ExpenseSetExternalID_Call{Call: _e.mock.On("ExpenseSetExternalID", ctx, externalID, expenseID)}
+}
+
+func (_c *MockDBInterface_ExpenseSetExternalID_Call) Run(run func(ctx context.Context, externalID sql.NullString, expenseID string)) *MockDBInterface_ExpenseSetExternalID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseSetExternalID_Call) Return(_a0 error) *MockDBInterface_ExpenseSetExternalID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseSetExternalID_Call) RunAndReturn(run func(context.Context, sql.NullString, string) error) *MockDBInterface_ExpenseSetExternalID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseSum provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) ExpenseSum(ctx context.Context, arg *dao.ExpenseListParams) (money.Decimal, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseSum")
+	}
+
+	var r0 money.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExpenseListParams) (money.Decimal, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExpenseListParams) money.Decimal); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(money.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.ExpenseListParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_ExpenseSum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseSum'
+type MockDBInterface_ExpenseSum_Call struct {
+	*mock.Call
+}
+
+// ExpenseSum is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.ExpenseListParams
+func (_e *MockDBInterface_Expecter) ExpenseSum(ctx interface{}, arg interface{}) *MockDBInterface_ExpenseSum_Call {
+	return &MockDBInterface_ExpenseSum_Call{Call: _e.mock.On("ExpenseSum", ctx, arg)}
+}
+
+func (_c *MockDBInterface_ExpenseSum_Call) Run(run func(ctx context.Context, arg *dao.ExpenseListParams)) *MockDBInterface_ExpenseSum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.ExpenseListParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseSum_Call) Return(_a0 money.Decimal, _a1 error) *MockDBInterface_ExpenseSum_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseSum_Call) RunAndReturn(run func(context.Context, *dao.ExpenseListParams) (money.Decimal, error)) *MockDBInterface_ExpenseSum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseTagDeleteByExpense provides a mock function with given fields: ctx, expenseID
+func (_m *MockDBInterface) ExpenseTagDeleteByExpense(ctx context.Context, expenseID string) error {
+	ret := _m.Called(ctx, expenseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseTagDeleteByExpense")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, expenseID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_ExpenseTagDeleteByExpense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseTagDeleteByExpense'
+type MockDBInterface_ExpenseTagDeleteByExpense_Call struct {
+	*mock.Call
+}
+
+// ExpenseTagDeleteByExpense is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expenseID string
+func (_e *MockDBInterface_Expecter) ExpenseTagDeleteByExpense(ctx interface{}, expenseID interface{}) *MockDBInterface_ExpenseTagDeleteByExpense_Call {
+	return &MockDBInterface_ExpenseTagDeleteByExpense_Call{Call: _e.mock.On("ExpenseTagDeleteByExpense", ctx, expenseID)}
+}
+
+func (_c *MockDBInterface_ExpenseTagDeleteByExpense_Call) Run(run func(ctx context.Context, expenseID string)) *MockDBInterface_ExpenseTagDeleteByExpense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseTagDeleteByExpense_Call) Return(_a0 error) *MockDBInterface_ExpenseTagDeleteByExpense_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseTagDeleteByExpense_Call) RunAndReturn(run func(context.Context, string) error) *MockDBInterface_ExpenseTagDeleteByExpense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseTagDeleteByName provides a mock function with given fields: ctx, expenseID, userID, name
+func (_m *MockDBInterface) ExpenseTagDeleteByName(ctx context.Context, expenseID string, userID string, name string) error {
+	ret := _m.Called(ctx, expenseID, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseTagDeleteByName")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, expenseID, userID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_ExpenseTagDeleteByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseTagDeleteByName'
+type MockDBInterface_ExpenseTagDeleteByName_Call struct {
+	*mock.Call
+}
+
+// ExpenseTagDeleteByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expenseID string
+//   - userID string
+//   - name string
+func (_e *MockDBInterface_Expecter) ExpenseTagDeleteByName(ctx interface{}, expenseID interface{}, userID interface{}, name interface{}) *MockDBInterface_ExpenseTagDeleteByName_Call {
+	return &MockDBInterface_ExpenseTagDeleteByName_Call{Call: _e.mock.On("ExpenseTagDeleteByName", ctx, expenseID, userID, name)}
+}
+
+func (_c *MockDBInterface_ExpenseTagDeleteByName_Call) Run(run func(ctx context.Context, expenseID string, userID string, name string)) *MockDBInterface_ExpenseTagDeleteByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseTagDeleteByName_Call) Return(_a0 error) *MockDBInterface_ExpenseTagDeleteByName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseTagDeleteByName_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockDBInterface_ExpenseTagDeleteByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseTagInsert provides a mock function with given fields: ctx, expenseID, tagID
+func (_m *MockDBInterface) ExpenseTagInsert(ctx context.Context, expenseID string, tagID string) error {
+	ret := _m.Called(ctx, expenseID, tagID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseTagInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, expenseID, tagID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_ExpenseTagInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseTagInsert'
+type MockDBInterface_ExpenseTagInsert_Call struct {
+	*mock.Call
+}
+
+// ExpenseTagInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expenseID string
+//   - tagID string
+func (_e *MockDBInterface_Expecter) ExpenseTagInsert(ctx interface{}, expenseID interface{}, tagID interface{}) *MockDBInterface_ExpenseTagInsert_Call {
+	return &MockDBInterface_ExpenseTagInsert_Call{Call: _e.mock.On("ExpenseTagInsert", ctx, expenseID, tagID)}
+}
+
+func (_c *MockDBInterface_ExpenseTagInsert_Call) Run(run func(ctx context.Context, expenseID string, tagID string)) *MockDBInterface_ExpenseTagInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseTagInsert_Call) Return(_a0 error) *MockDBInterface_ExpenseTagInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseTagInsert_Call) RunAndReturn(run func(context.Context, string, string) error) *MockDBInterface_ExpenseTagInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseUpdate provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) ExpenseUpdate(ctx context.Context, arg *dao.ExpenseUpdateParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExpenseUpdateParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_ExpenseUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseUpdate'
+type MockDBInterface_ExpenseUpdate_Call struct {
+	*mock.Call
+}
+
+// ExpenseUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.ExpenseUpdateParams
+func (_e *MockDBInterface_Expecter) ExpenseUpdate(ctx interface{}, arg interface{}) *MockDBInterface_ExpenseUpdate_Call {
+	return &MockDBInterface_ExpenseUpdate_Call{Call: _e.mock.On("ExpenseUpdate", ctx, arg)}
+}
+
+func (_c *MockDBInterface_ExpenseUpdate_Call) Run(run func(ctx context.Context, arg *dao.ExpenseUpdateParams)) *MockDBInterface_ExpenseUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.ExpenseUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseUpdate_Call) Return(_a0 error) *MockDBInterface_ExpenseUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_ExpenseUpdate_Call) RunAndReturn(run func(context.Context, *dao.ExpenseUpdateParams) error) *MockDBInterface_ExpenseUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HistoryCount provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) HistoryCount(ctx context.Context, arg *dao.HistoryCountParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistoryCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryCountParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryCountParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.HistoryCountParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_HistoryCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryCount'
+type MockDBInterface_HistoryCount_Call struct {
+	*mock.Call
+}
+
+// HistoryCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.HistoryCountParams
+func (_e *MockDBInterface_Expecter) HistoryCount(ctx interface{}, arg interface{}) *MockDBInterface_HistoryCount_Call {
+	return &MockDBInterface_HistoryCount_Call{Call: _e.mock.On("HistoryCount", ctx, arg)}
+}
+
+func (_c *MockDBInterface_HistoryCount_Call) Run(run func(ctx context.Context, arg *dao.HistoryCountParams)) *MockDBInterface_HistoryCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.HistoryCountParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HistoryCount_Call) Return(_a0 int64, _a1 error) *MockDBInterface_HistoryCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_HistoryCount_Call) RunAndReturn(run func(context.Context, *dao.HistoryCountParams) (int64, error)) *MockDBInterface_HistoryCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HistoryInsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) HistoryInsert(ctx context.Context, arg *dao.HistoryInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistoryInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_HistoryInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryInsert'
+type MockDBInterface_HistoryInsert_Call struct {
+	*mock.Call
+}
+
+// HistoryInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.HistoryInsertParams
+func (_e *MockDBInterface_Expecter) HistoryInsert(ctx interface{}, arg interface{}) *MockDBInterface_HistoryInsert_Call {
+	return &MockDBInterface_HistoryInsert_Call{Call: _e.mock.On("HistoryInsert", ctx, arg)}
+}
+
+func (_c *MockDBInterface_HistoryInsert_Call) Run(run func(ctx context.Context, arg *dao.HistoryInsertParams)) *MockDBInterface_HistoryInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.HistoryInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HistoryInsert_Call) Return(_a0 error) *MockDBInterface_HistoryInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_HistoryInsert_Call) RunAndReturn(run func(context.Context, *dao.HistoryInsertParams) error) *MockDBInterface_HistoryInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HistoryList provides a mock function with given fields: ctx
+func (_m *MockDBInterface) HistoryList(ctx context.Context) ([]*dao.History, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistoryList")
+	}
+
+	var r0 []*dao.History
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*dao.History, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*dao.History); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.History)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_HistoryList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryList'
+type MockDBInterface_HistoryList_Call struct {
+	*mock.Call
+}
+
+// HistoryList is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDBInterface_Expecter) HistoryList(ctx interface{}) *MockDBInterface_HistoryList_Call {
+	return &MockDBInterface_HistoryList_Call{Call: _e.mock.On("HistoryList", ctx)}
+}
+
+func (_c *MockDBInterface_HistoryList_Call) Run(run func(ctx context.Context)) *MockDBInterface_HistoryList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HistoryList_Call) Return(_a0 []*dao.History, _a1 error) *MockDBInterface_HistoryList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_HistoryList_Call) RunAndReturn(run func(context.Context) ([]*dao.History, error)) *MockDBInterface_HistoryList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HistoryPage provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) HistoryPage(ctx context.Context, arg *dao.HistoryPageParams) ([]*dao.History, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistoryPage")
+	}
+
+	var r0 []*dao.History
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryPageParams) ([]*dao.History, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryPageParams) []*dao.History); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.History)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.HistoryPageParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_HistoryPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryPage'
+type MockDBInterface_HistoryPage_Call struct {
+	*mock.Call
+}
+
+// HistoryPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.HistoryPageParams
+func (_e *MockDBInterface_Expecter) HistoryPage(ctx interface{}, arg interface{}) *MockDBInterface_HistoryPage_Call {
+	return &MockDBInterface_HistoryPage_Call{Call: _e.mock.On("HistoryPage", ctx, arg)}
+}
+
+func (_c *MockDBInterface_HistoryPage_Call) Run(run func(ctx context.Context, arg *dao.HistoryPageParams)) *MockDBInterface_HistoryPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.HistoryPageParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HistoryPage_Call) Return(_a0 []*dao.History, _a1 error) *MockDBInterface_HistoryPage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_HistoryPage_Call) RunAndReturn(run func(context.Context, *dao.HistoryPageParams) ([]*dao.History, error)) *MockDBInterface_HistoryPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HistoryPageFromEnd provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) HistoryPageFromEnd(ctx context.Context, arg *dao.HistoryPageFromEndParams) ([]*dao.History, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistoryPageFromEnd")
+	}
+
+	var r0 []*dao.History
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryPageFromEndParams) ([]*dao.History, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryPageFromEndParams) []*dao.History); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.History)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.HistoryPageFromEndParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_HistoryPageFromEnd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryPageFromEnd'
+type MockDBInterface_HistoryPageFromEnd_Call struct {
+	*mock.Call
+}
+
+// HistoryPageFromEnd is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.HistoryPageFromEndParams
+func (_e *MockDBInterface_Expecter) HistoryPageFromEnd(ctx interface{}, arg interface{}) *MockDBInterface_HistoryPageFromEnd_Call {
+	return &MockDBInterface_HistoryPageFromEnd_Call{Call: _e.mock.On("HistoryPageFromEnd", ctx, arg)}
+}
+
+func (_c *MockDBInterface_HistoryPageFromEnd_Call) Run(run func(ctx context.Context, arg *dao.HistoryPageFromEndParams)) *MockDBInterface_HistoryPageFromEnd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.HistoryPageFromEndParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HistoryPageFromEnd_Call) Return(_a0 []*dao.History, _a1 error) *MockDBInterface_HistoryPageFromEnd_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_HistoryPageFromEnd_Call) RunAndReturn(run func(context.Context, *dao.HistoryPageFromEndParams) ([]*dao.History, error)) *MockDBInterface_HistoryPageFromEnd_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HistorySearch provides a mock function with given fields: ctx, filter, page
+func (_m *MockDBInterface) HistorySearch(ctx context.Context, filter *dao.HistoryFilter, page *dao.Page) ([]*dao.History, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistorySearch")
+	}
+
+	var r0 []*dao.History
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryFilter, *dao.Page) ([]*dao.History, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryFilter, *dao.Page) []*dao.History); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.History)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.HistoryFilter, *dao.Page) error); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_HistorySearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistorySearch'
+type MockDBInterface_HistorySearch_Call struct {
+	*mock.Call
+}
+
+// HistorySearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *dao.HistoryFilter
+//   - page *dao.Page
+func (_e *MockDBInterface_Expecter) HistorySearch(ctx interface{}, filter interface{}, page interface{}) *MockDBInterface_HistorySearch_Call {
+	return &MockDBInterface_HistorySearch_Call{Call: _e.mock.On("HistorySearch", ctx, filter, page)}
+}
+
+func (_c *MockDBInterface_HistorySearch_Call) Run(run func(ctx context.Context, filter *dao.HistoryFilter, page *dao.Page)) *MockDBInterface_HistorySearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.HistoryFilter), args[2].(*dao.Page))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HistorySearch_Call) Return(_a0 []*dao.History, _a1 error) *MockDBInterface_HistorySearch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_HistorySearch_Call) RunAndReturn(run func(context.Context, *dao.HistoryFilter, *dao.Page) ([]*dao.History, error)) *MockDBInterface_HistorySearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HistorySearchCount provides a mock function with given fields: ctx, filter
+func (_m *MockDBInterface) HistorySearchCount(ctx context.Context, filter *dao.HistoryFilter) (int64, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistorySearchCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryFilter) (int64, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryFilter) int64); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.HistoryFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_HistorySearchCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistorySearchCount'
+type MockDBInterface_HistorySearchCount_Call struct {
+	*mock.Call
+}
+
+// HistorySearchCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *dao.HistoryFilter
+func (_e *MockDBInterface_Expecter) HistorySearchCount(ctx interface{}, filter interface{}) *MockDBInterface_HistorySearchCount_Call {
+	return &MockDBInterface_HistorySearchCount_Call{Call: _e.mock.On("HistorySearchCount", ctx, filter)}
+}
+
+func (_c *MockDBInterface_HistorySearchCount_Call) Run(run func(ctx context.Context, filter *dao.HistoryFilter)) *MockDBInterface_HistorySearchCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.HistoryFilter))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HistorySearchCount_Call) Return(_a0 int64, _a1 error) *MockDBInterface_HistorySearchCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_HistorySearchCount_Call) RunAndReturn(run func(context.Context, *dao.HistoryFilter) (int64, error)) *MockDBInterface_HistorySearchCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdGetByID provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) HouseholdGetByID(ctx context.Context, id string) (*dao.Household, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdGetByID")
+	}
+
+	var r0 *dao.Household
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Household, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Household); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Household)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_HouseholdGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdGetByID'
+type MockDBInterface_HouseholdGetByID_Call struct {
+	*mock.Call
+}
+
+// HouseholdGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) HouseholdGetByID(ctx interface{}, id interface{}) *MockDBInterface_HouseholdGetByID_Call {
+	return &MockDBInterface_HouseholdGetByID_Call{Call: _e.mock.On("HouseholdGetByID", ctx, id)}
+}
+
+func (_c *MockDBInterface_HouseholdGetByID_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_HouseholdGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdGetByID_Call) Return(_a0 *dao.Household, _a1 error) *MockDBInterface_HouseholdGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Household, error)) *MockDBInterface_HouseholdGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdInsert provides a mock function with given fields: ctx, iD, name, createdAt
+func (_m *MockDBInterface) HouseholdInsert(ctx context.Context, iD string, name string, createdAt time.Time) error {
+	ret := _m.Called(ctx, iD, name, createdAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, iD, name, createdAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_HouseholdInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdInsert'
+type MockDBInterface_HouseholdInsert_Call struct {
+	*mock.Call
+}
+
+// HouseholdInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - iD string
+//   - name string
+//   - createdAt time.Time
+func (_e *MockDBInterface_Expecter) HouseholdInsert(ctx interface{}, iD interface{}, name interface{}, createdAt interface{}) *MockDBInterface_HouseholdInsert_Call {
+	return &MockDBInterface_HouseholdInsert_Call{Call: _e.mock.On("HouseholdInsert", ctx, iD, name, createdAt)}
+}
+
+func (_c *MockDBInterface_HouseholdInsert_Call) Run(run func(ctx context.Context, iD string, name string, createdAt time.Time)) *MockDBInterface_HouseholdInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdInsert_Call) Return(_a0 error) *MockDBInterface_HouseholdInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdInsert_Call) RunAndReturn(run func(context.Context, string, string, time.Time) error) *MockDBInterface_HouseholdInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdInvitationDelete provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) HouseholdInvitationDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdInvitationDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_HouseholdInvitationDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdInvitationDelete'
+type MockDBInterface_HouseholdInvitationDelete_Call struct {
+	*mock.Call
+}
+
+// HouseholdInvitationDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) HouseholdInvitationDelete(ctx interface{}, id interface{}) *MockDBInterface_HouseholdInvitationDelete_Call {
+	return &MockDBInterface_HouseholdInvitationDelete_Call{Call: _e.mock.On("HouseholdInvitationDelete", ctx, id)}
+}
+
+func (_c *MockDBInterface_HouseholdInvitationDelete_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_HouseholdInvitationDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdInvitationDelete_Call) Return(_a0 error) *MockDBInterface_HouseholdInvitationDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdInvitationDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockDBInterface_HouseholdInvitationDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdInvitationGetByID provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) HouseholdInvitationGetByID(ctx context.Context, id string) (*dao.HouseholdInvitation, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdInvitationGetByID")
+	}
+
+	var r0 *dao.HouseholdInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.HouseholdInvitation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.HouseholdInvitation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.HouseholdInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_HouseholdInvitationGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdInvitationGetByID'
+type MockDBInterface_HouseholdInvitationGetByID_Call struct {
+	*mock.Call
+}
+
+// HouseholdInvitationGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) HouseholdInvitationGetByID(ctx interface{}, id interface{}) *MockDBInterface_HouseholdInvitationGetByID_Call {
+	return &MockDBInterface_HouseholdInvitationGetByID_Call{Call: _e.mock.On("HouseholdInvitationGetByID", ctx, id)}
+}
+
+func (_c *MockDBInterface_HouseholdInvitationGetByID_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_HouseholdInvitationGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdInvitationGetByID_Call) Return(_a0 *dao.HouseholdInvitation, _a1 error) *MockDBInterface_HouseholdInvitationGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdInvitationGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.HouseholdInvitation, error)) *MockDBInterface_HouseholdInvitationGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdInvitationGetByUser provides a mock function with given fields: ctx, householdID, userID
+func (_m *MockDBInterface) HouseholdInvitationGetByUser(ctx context.Context, householdID string, userID string) (*dao.HouseholdInvitation, error) {
+	ret := _m.Called(ctx, householdID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdInvitationGetByUser")
+	}
+
+	var r0 *dao.HouseholdInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dao.HouseholdInvitation, error)); ok {
+		return rf(ctx, householdID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dao.HouseholdInvitation); ok {
+		r0 = rf(ctx, householdID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.HouseholdInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, householdID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_HouseholdInvitationGetByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdInvitationGetByUser'
+type MockDBInterface_HouseholdInvitationGetByUser_Call struct {
+	*mock.Call
+}
+
+// HouseholdInvitationGetByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - householdID string
+//   - userID string
+func (_e *MockDBInterface_Expecter) HouseholdInvitationGetByUser(ctx interface{}, householdID interface{}, userID interface{}) *MockDBInterface_HouseholdInvitationGetByUser_Call {
+	return &MockDBInterface_HouseholdInvitationGetByUser_Call{Call: _e.mock.On("HouseholdInvitationGetByUser", ctx, householdID, userID)}
+}
+
+func (_c *MockDBInterface_HouseholdInvitationGetByUser_Call) Run(run func(ctx context.Context, householdID string, userID string)) *MockDBInterface_HouseholdInvitationGetByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdInvitationGetByUser_Call) Return(_a0 *dao.HouseholdInvitation, _a1 error) *MockDBInterface_HouseholdInvitationGetByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdInvitationGetByUser_Call) RunAndReturn(run func(context.Context, string, string) (*dao.HouseholdInvitation, error)) *MockDBInterface_HouseholdInvitationGetByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdInvitationListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockDBInterface) HouseholdInvitationListByUser(ctx context.Context, userID string) ([]*dao.HouseholdInvitation, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdInvitationListByUser")
+	}
+
+	var r0 []*dao.HouseholdInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.HouseholdInvitation, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.HouseholdInvitation); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.HouseholdInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_HouseholdInvitationListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdInvitationListByUser'
+type MockDBInterface_HouseholdInvitationListByUser_Call struct {
+	*mock.Call
+}
+
+// HouseholdInvitationListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockDBInterface_Expecter) HouseholdInvitationListByUser(ctx interface{}, userID interface{}) *MockDBInterface_HouseholdInvitationListByUser_Call {
+	return &MockDBInterface_HouseholdInvitationListByUser_Call{Call: _e.mock.On("HouseholdInvitationListByUser", ctx, userID)}
+}
+
+func (_c *MockDBInterface_HouseholdInvitationListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockDBInterface_HouseholdInvitationListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdInvitationListByUser_Call) Return(_a0 []*dao.HouseholdInvitation, _a1 error) *MockDBInterface_HouseholdInvitationListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdInvitationListByUser_Call) RunAndReturn(run func(context.Context, string) ([]*dao.HouseholdInvitation, error)) *MockDBInterface_HouseholdInvitationListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdInvitationUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) HouseholdInvitationUpsert(ctx context.Context, arg *dao.HouseholdInvitationUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdInvitationUpsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HouseholdInvitationUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_HouseholdInvitationUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdInvitationUpsert'
+type MockDBInterface_HouseholdInvitationUpsert_Call struct {
+	*mock.Call
+}
+
+// HouseholdInvitationUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.HouseholdInvitationUpsertParams
+func (_e *MockDBInterface_Expecter) HouseholdInvitationUpsert(ctx interface{}, arg interface{}) *MockDBInterface_HouseholdInvitationUpsert_Call {
+	return &MockDBInterface_HouseholdInvitationUpsert_Call{Call: _e.mock.On("HouseholdInvitationUpsert", ctx, arg)}
+}
+
+func (_c *MockDBInterface_HouseholdInvitationUpsert_Call) Run(run func(ctx context.Context, arg *dao.HouseholdInvitationUpsertParams)) *MockDBInterface_HouseholdInvitationUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.HouseholdInvitationUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdInvitationUpsert_Call) Return(_a0 error) *MockDBInterface_HouseholdInvitationUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdInvitationUpsert_Call) RunAndReturn(run func(context.Context, *dao.HouseholdInvitationUpsertParams) error) *MockDBInterface_HouseholdInvitationUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockDBInterface) HouseholdListByUser(ctx context.Context, userID string) ([]*dao.Household, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdListByUser")
+	}
+
+	var r0 []*dao.Household
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Household, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Household); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Household)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_HouseholdListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdListByUser'
+type MockDBInterface_HouseholdListByUser_Call struct {
+	*mock.Call
+}
+
+// HouseholdListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockDBInterface_Expecter) HouseholdListByUser(ctx interface{}, userID interface{}) *MockDBInterface_HouseholdListByUser_Call {
+	return &MockDBInterface_HouseholdListByUser_Call{Call: _e.mock.On("HouseholdListByUser", ctx, userID)}
+}
+
+func (_c *MockDBInterface_HouseholdListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockDBInterface_HouseholdListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdListByUser_Call) Return(_a0 []*dao.Household, _a1 error) *MockDBInterface_HouseholdListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdListByUser_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Household, error)) *MockDBInterface_HouseholdListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdMemberCountByRole provides a mock function with given fields: ctx, householdID, role
+func (_m *MockDBInterface) HouseholdMemberCountByRole(ctx context.Context, householdID string, role string) (int64, error) {
+	ret := _m.Called(ctx, householdID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdMemberCountByRole")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int64, error)); ok {
+		return rf(ctx, householdID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, householdID, role)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, householdID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_HouseholdMemberCountByRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdMemberCountByRole'
+type MockDBInterface_HouseholdMemberCountByRole_Call struct {
+	*mock.Call
+}
+
+// HouseholdMemberCountByRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - householdID string
+//   - role string
+func (_e *MockDBInterface_Expecter) HouseholdMemberCountByRole(ctx interface{}, householdID interface{}, role interface{}) *MockDBInterface_HouseholdMemberCountByRole_Call {
+	return &MockDBInterface_HouseholdMemberCountByRole_Call{Call: _e.mock.On("HouseholdMemberCountByRole", ctx, householdID, role)}
+}
+
+func (_c *MockDBInterface_HouseholdMemberCountByRole_Call) Run(run func(ctx context.Context, householdID string, role string)) *MockDBInterface_HouseholdMemberCountByRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdMemberCountByRole_Call) Return(_a0 int64, _a1 error) *MockDBInterface_HouseholdMemberCountByRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdMemberCountByRole_Call) RunAndReturn(run func(context.Context, string, string) (int64, error)) *MockDBInterface_HouseholdMemberCountByRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdMemberDelete provides a mock function with given fields: ctx, householdID, userID
+func (_m *MockDBInterface) HouseholdMemberDelete(ctx context.Context, householdID string, userID string) error {
+	ret := _m.Called(ctx, householdID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdMemberDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, householdID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_HouseholdMemberDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdMemberDelete'
+type MockDBInterface_HouseholdMemberDelete_Call struct {
+	*mock.Call
+}
+
+// HouseholdMemberDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - householdID string
+//   - userID string
+func (_e *MockDBInterface_Expecter) HouseholdMemberDelete(ctx interface{}, householdID interface{}, userID interface{}) *MockDBInterface_HouseholdMemberDelete_Call {
+	return &MockDBInterface_HouseholdMemberDelete_Call{Call: _e.mock.On("HouseholdMemberDelete", ctx, householdID, userID)}
+}
+
+func (_c *MockDBInterface_HouseholdMemberDelete_Call) Run(run func(ctx context.Context, householdID string, userID string)) *MockDBInterface_HouseholdMemberDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdMemberDelete_Call) Return(_a0 error) *MockDBInterface_HouseholdMemberDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdMemberDelete_Call) RunAndReturn(run func(context.Context, string, string) error) *MockDBInterface_HouseholdMemberDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdMemberGet provides a mock function with given fields: ctx, householdID, userID
+func (_m *MockDBInterface) HouseholdMemberGet(ctx context.Context, householdID string, userID string) (*dao.HouseholdMember, error) {
+	ret := _m.Called(ctx, householdID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdMemberGet")
+	}
+
+	var r0 *dao.HouseholdMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dao.HouseholdMember, error)); ok {
+		return rf(ctx, householdID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dao.HouseholdMember); ok {
+		r0 = rf(ctx, householdID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.HouseholdMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, householdID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_HouseholdMemberGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdMemberGet'
+type MockDBInterface_HouseholdMemberGet_Call struct {
+	*mock.Call
+}
+
+// HouseholdMemberGet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - householdID string
+//   - userID string
+func (_e *MockDBInterface_Expecter) HouseholdMemberGet(ctx interface{}, householdID interface{}, userID interface{}) *MockDBInterface_HouseholdMemberGet_Call {
+	return &MockDBInterface_HouseholdMemberGet_Call{Call: _e.mock.On("HouseholdMemberGet", ctx, householdID, userID)}
+}
+
+func (_c *MockDBInterface_HouseholdMemberGet_Call) Run(run func(ctx context.Context, householdID string, userID string)) *MockDBInterface_HouseholdMemberGet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdMemberGet_Call) Return(_a0 *dao.HouseholdMember, _a1 error) *MockDBInterface_HouseholdMemberGet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdMemberGet_Call) RunAndReturn(run func(context.Context, string, string) (*dao.HouseholdMember, error)) *MockDBInterface_HouseholdMemberGet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdMemberListByHousehold provides a mock function with given fields: ctx, householdID
+func (_m *MockDBInterface) HouseholdMemberListByHousehold(ctx context.Context, householdID string) ([]*dao.HouseholdMember, error) {
+	ret := _m.Called(ctx, householdID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdMemberListByHousehold")
+	}
+
+	var r0 []*dao.HouseholdMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.HouseholdMember, error)); ok {
+		return rf(ctx, householdID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.HouseholdMember); ok {
+		r0 = rf(ctx, householdID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.HouseholdMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, householdID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_HouseholdMemberListByHousehold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdMemberListByHousehold'
+type MockDBInterface_HouseholdMemberListByHousehold_Call struct {
+	*mock.Call
+}
+
+// HouseholdMemberListByHousehold is a helper method to define mock.On call
+//   - ctx context.Context
+//   - householdID string
+func (_e *MockDBInterface_Expecter) HouseholdMemberListByHousehold(ctx interface{}, householdID interface{}) *MockDBInterface_HouseholdMemberListByHousehold_Call {
+	return &MockDBInterface_HouseholdMemberListByHousehold_Call{Call: _e.mock.On("HouseholdMemberListByHousehold", ctx, householdID)}
+}
+
+func (_c *MockDBInterface_HouseholdMemberListByHousehold_Call) Run(run func(ctx context.Context, householdID string)) *MockDBInterface_HouseholdMemberListByHousehold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdMemberListByHousehold_Call) Return(_a0 []*dao.HouseholdMember, _a1 error) *MockDBInterface_HouseholdMemberListByHousehold_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdMemberListByHousehold_Call) RunAndReturn(run func(context.Context, string) ([]*dao.HouseholdMember, error)) *MockDBInterface_HouseholdMemberListByHousehold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdMemberUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) HouseholdMemberUpsert(ctx context.Context, arg *dao.HouseholdMemberUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdMemberUpsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HouseholdMemberUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_HouseholdMemberUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdMemberUpsert'
+type MockDBInterface_HouseholdMemberUpsert_Call struct {
+	*mock.Call
+}
+
+// HouseholdMemberUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.HouseholdMemberUpsertParams
+func (_e *MockDBInterface_Expecter) HouseholdMemberUpsert(ctx interface{}, arg interface{}) *MockDBInterface_HouseholdMemberUpsert_Call {
+	return &MockDBInterface_HouseholdMemberUpsert_Call{Call: _e.mock.On("HouseholdMemberUpsert", ctx, arg)}
+}
+
+func (_c *MockDBInterface_HouseholdMemberUpsert_Call) Run(run func(ctx context.Context, arg *dao.HouseholdMemberUpsertParams)) *MockDBInterface_HouseholdMemberUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.HouseholdMemberUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdMemberUpsert_Call) Return(_a0 error) *MockDBInterface_HouseholdMemberUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_HouseholdMemberUpsert_Call) RunAndReturn(run func(context.Context, *dao.HouseholdMemberUpsertParams) error) *MockDBInterface_HouseholdMemberUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncomeDelete provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) IncomeDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncomeDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_IncomeDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncomeDelete'
+type MockDBInterface_IncomeDelete_Call struct {
+	*mock.Call
+}
+
+// IncomeDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) IncomeDelete(ctx interface{}, id interface{}) *MockDBInterface_IncomeDelete_Call {
+	return &MockDBInterface_IncomeDelete_Call{Call: _e.mock.On("IncomeDelete", ctx, id)}
+}
+
+func (_c *MockDBInterface_IncomeDelete_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_IncomeDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_IncomeDelete_Call) Return(_a0 error) *MockDBInterface_IncomeDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_IncomeDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockDBInterface_IncomeDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncomeExternalIDCount provides a mock function with given fields: ctx, walletID, externalID
+func (_m *MockDBInterface) IncomeExternalIDCount(ctx context.Context, walletID string, externalID sql.NullString) (int64, error) {
+	ret := _m.Called(ctx, walletID, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncomeExternalIDCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, sql.NullString) (int64, error)); ok {
+		return rf(ctx, walletID, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, sql.NullString) int64); ok {
+		r0 = rf(ctx, walletID, externalID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, sql.NullString) error); ok {
+		r1 = rf(ctx, walletID, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_IncomeExternalIDCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncomeExternalIDCount'
+type MockDBInterface_IncomeExternalIDCount_Call struct {
+	*mock.Call
+}
+
+// IncomeExternalIDCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - externalID sql.NullString
+func (_e *MockDBInterface_Expecter) IncomeExternalIDCount(ctx interface{}, walletID interface{}, externalID interface{}) *MockDBInterface_IncomeExternalIDCount_Call {
+	return &MockDBInterface_IncomeExternalIDCount_Call{Call: _e.mock.On("IncomeExternalIDCount", ctx, walletID, externalID)}
+}
+
+func (_c *MockDBInterface_IncomeExternalIDCount_Call) Run(run func(ctx context.Context, walletID string, externalID sql.NullString)) *MockDBInterface_IncomeExternalIDCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_IncomeExternalIDCount_Call) Return(_a0 int64, _a1 error) *MockDBInterface_IncomeExternalIDCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_IncomeExternalIDCount_Call) RunAndReturn(run func(context.Context, string, sql.NullString) (int64, error)) *MockDBInterface_IncomeExternalIDCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncomeGetByID provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) IncomeGetByID(ctx context.Context, id string) (*dao.Income, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncomeGetByID")
+	}
+
+	var r0 *dao.Income
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Income, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Income); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Income)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_IncomeGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncomeGetByID'
+type MockDBInterface_IncomeGetByID_Call struct {
+	*mock.Call
+}
+
+// IncomeGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) IncomeGetByID(ctx interface{}, id interface{}) *MockDBInterface_IncomeGetByID_Call {
+	return &MockDBInterface_IncomeGetByID_Call{Call: _e.mock.On("IncomeGetByID", ctx, id)}
+}
+
+func (_c *MockDBInterface_IncomeGetByID_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_IncomeGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_IncomeGetByID_Call) Return(_a0 *dao.Income, _a1 error) *MockDBInterface_IncomeGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_IncomeGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Income, error)) *MockDBInterface_IncomeGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncomeInsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) IncomeInsert(ctx context.Context, arg *dao.IncomeInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncomeInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.IncomeInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_IncomeInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncomeInsert'
+type MockDBInterface_IncomeInsert_Call struct {
+	*mock.Call
+}
+
+// IncomeInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.IncomeInsertParams
+func (_e *MockDBInterface_Expecter) IncomeInsert(ctx interface{}, arg interface{}) *MockDBInterface_IncomeInsert_Call {
+	return &MockDBInterface_IncomeInsert_Call{Call: _e.mock.On("IncomeInsert", ctx, arg)}
+}
+
+func (_c *MockDBInterface_IncomeInsert_Call) Run(run func(ctx context.Context, arg *dao.IncomeInsertParams)) *MockDBInterface_IncomeInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.IncomeInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_IncomeInsert_Call) Return(_a0 error) *MockDBInterface_IncomeInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_IncomeInsert_Call) RunAndReturn(run func(context.Context, *dao.IncomeInsertParams) error) *MockDBInterface_IncomeInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncomeListByWallet provides a mock function with given fields: ctx, walletID
+func (_m *MockDBInterface) IncomeListByWallet(ctx context.Context, walletID string) ([]*dao.Income, error) {
+	ret := _m.Called(ctx, walletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncomeListByWallet")
+	}
+
+	var r0 []*dao.Income
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Income, error)); ok {
+		return rf(ctx, walletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Income); ok {
+		r0 = rf(ctx, walletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Income)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, walletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_IncomeListByWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncomeListByWallet'
+type MockDBInterface_IncomeListByWallet_Call struct {
+	*mock.Call
+}
+
+// IncomeListByWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+func (_e *MockDBInterface_Expecter) IncomeListByWallet(ctx interface{}, walletID interface{}) *MockDBInterface_IncomeListByWallet_Call {
+	return &MockDBInterface_IncomeListByWallet_Call{Call: _e.mock.On("IncomeListByWallet", ctx, walletID)}
+}
+
+func (_c *MockDBInterface_IncomeListByWallet_Call) Run(run func(ctx context.Context, walletID string)) *MockDBInterface_IncomeListByWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_IncomeListByWallet_Call) Return(_a0 []*dao.Income, _a1 error) *MockDBInterface_IncomeListByWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_IncomeListByWallet_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Income, error)) *MockDBInterface_IncomeListByWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncomeUpdate provides a mock function with given fields: ctx, amount, description, createdAt, iD
+func (_m *MockDBInterface) IncomeUpdate(ctx context.Context, amount money.Decimal, description sql.NullString, createdAt time.Time, iD string) error {
+	ret := _m.Called(ctx, amount, description, createdAt, iD)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncomeUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, money.Decimal, sql.NullString, time.Time, string) error); ok {
+		r0 = rf(ctx, amount, description, createdAt, iD)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_IncomeUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncomeUpdate'
+type MockDBInterface_IncomeUpdate_Call struct {
+	*mock.Call
+}
+
+// IncomeUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - amount money.Decimal
+//   - description sql.NullString
+//   - createdAt time.Time
+//   - iD string
+func (_e *MockDBInterface_Expecter) IncomeUpdate(ctx interface{}, amount interface{}, description interface{}, createdAt interface{}, iD interface{}) *MockDBInterface_IncomeUpdate_Call {
+	return &MockDBInterface_IncomeUpdate_Call{Call: _e.mock.On("IncomeUpdate", ctx, amount, description, createdAt, iD)}
+}
+
+func (_c *MockDBInterface_IncomeUpdate_Call) Run(run func(ctx context.Context, amount money.Decimal, description sql.NullString, createdAt time.Time, iD string)) *MockDBInterface_IncomeUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(money.Decimal), args[2].(sql.NullString), args[3].(time.Time), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_IncomeUpdate_Call) Return(_a0 error) *MockDBInterface_IncomeUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_IncomeUpdate_Call) RunAndReturn(run func(context.Context, money.Decimal, sql.NullString, time.Time, string) error) *MockDBInterface_IncomeUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LocalUserGetByEmail provides a mock function with given fields: ctx, email
+func (_m *MockDBInterface) LocalUserGetByEmail(ctx context.Context, email string) (*dao.LocalUser, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LocalUserGetByEmail")
+	}
+
+	var r0 *dao.LocalUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.LocalUser, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.LocalUser); ok {
+		r0 = rf(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LocalUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_LocalUserGetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserGetByEmail'
+type MockDBInterface_LocalUserGetByEmail_Call struct {
+	*mock.Call
+}
+
+// LocalUserGetByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockDBInterface_Expecter) LocalUserGetByEmail(ctx interface{}, email interface{}) *MockDBInterface_LocalUserGetByEmail_Call {
+	return &MockDBInterface_LocalUserGetByEmail_Call{Call: _e.mock.On("LocalUserGetByEmail", ctx, email)}
+}
+
+func (_c *MockDBInterface_LocalUserGetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockDBInterface_LocalUserGetByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_LocalUserGetByEmail_Call) Return(_a0 *dao.LocalUser, _a1 error) *MockDBInterface_LocalUserGetByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_LocalUserGetByEmail_Call) RunAndReturn(run func(context.Context, string) (*dao.LocalUser, error)) *MockDBInterface_LocalUserGetByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LocalUserGetByID provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) LocalUserGetByID(ctx context.Context, id string) (*dao.LocalUser, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LocalUserGetByID")
+	}
+
+	var r0 *dao.LocalUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.LocalUser, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.LocalUser); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LocalUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_LocalUserGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserGetByID'
+type MockDBInterface_LocalUserGetByID_Call struct {
+	*mock.Call
+}
+
+// LocalUserGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) LocalUserGetByID(ctx interface{}, id interface{}) *MockDBInterface_LocalUserGetByID_Call {
+	return &MockDBInterface_LocalUserGetByID_Call{Call: _e.mock.On("LocalUserGetByID", ctx, id)}
+}
+
+func (_c *MockDBInterface_LocalUserGetByID_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_LocalUserGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_LocalUserGetByID_Call) Return(_a0 *dao.LocalUser, _a1 error) *MockDBInterface_LocalUserGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_LocalUserGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.LocalUser, error)) *MockDBInterface_LocalUserGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LocalUserInsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) LocalUserInsert(ctx context.Context, arg *dao.LocalUserInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LocalUserInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.LocalUserInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_LocalUserInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserInsert'
+type MockDBInterface_LocalUserInsert_Call struct {
+	*mock.Call
+}
+
+// LocalUserInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.LocalUserInsertParams
+func (_e *MockDBInterface_Expecter) LocalUserInsert(ctx interface{}, arg interface{}) *MockDBInterface_LocalUserInsert_Call {
+	return &MockDBInterface_LocalUserInsert_Call{Call: _e.mock.On("LocalUserInsert", ctx, arg)}
+}
+
+func (_c *MockDBInterface_LocalUserInsert_Call) Run(run func(ctx context.Context, arg *dao.LocalUserInsertParams)) *MockDBInterface_LocalUserInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.LocalUserInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_LocalUserInsert_Call) Return(_a0 error) *MockDBInterface_LocalUserInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_LocalUserInsert_Call) RunAndReturn(run func(context.Context, *dao.LocalUserInsertParams) error) *MockDBInterface_LocalUserInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LocalUserList provides a mock function with given fields: ctx
+func (_m *MockDBInterface) LocalUserList(ctx context.Context) ([]*dao.LocalUser, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LocalUserList")
+	}
+
+	var r0 []*dao.LocalUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*dao.LocalUser, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*dao.LocalUser); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.LocalUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_LocalUserList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserList'
+type MockDBInterface_LocalUserList_Call struct {
+	*mock.Call
+}
+
+// LocalUserList is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDBInterface_Expecter) LocalUserList(ctx interface{}) *MockDBInterface_LocalUserList_Call {
+	return &MockDBInterface_LocalUserList_Call{Call: _e.mock.On("LocalUserList", ctx)}
+}
+
+func (_c *MockDBInterface_LocalUserList_Call) Run(run func(ctx context.Context)) *MockDBInterface_LocalUserList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_LocalUserList_Call) Return(_a0 []*dao.LocalUser, _a1 error) *MockDBInterface_LocalUserList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_LocalUserList_Call) RunAndReturn(run func(context.Context) ([]*dao.LocalUser, error)) *MockDBInterface_LocalUserList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LocalUserSetPass provides a mock function with given fields: ctx, pwdhash, email
+func (_m *MockDBInterface) LocalUserSetPass(ctx context.Context, pwdhash string, email string) error {
+	ret := _m.Called(ctx, pwdhash, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LocalUserSetPass")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, pwdhash, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_LocalUserSetPass_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserSetPass'
+type MockDBInterface_LocalUserSetPass_Call struct {
+	*mock.Call
+}
+
+// LocalUserSetPass is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pwdhash string
+//   - email string
+func (_e *MockDBInterface_Expecter) LocalUserSetPass(ctx interface{}, pwdhash interface{}, email interface{}) *MockDBInterface_LocalUserSetPass_Call {
+	return &MockDBInterface_LocalUserSetPass_Call{Call: _e.mock.On("LocalUserSetPass", ctx, pwdhash, email)}
+}
+
+func (_c *MockDBInterface_LocalUserSetPass_Call) Run(run func(ctx context.Context, pwdhash string, email string)) *MockDBInterface_LocalUserSetPass_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_LocalUserSetPass_Call) Return(_a0 error) *MockDBInterface_LocalUserSetPass_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_LocalUserSetPass_Call) RunAndReturn(run func(context.Context, string, string) error) *MockDBInterface_LocalUserSetPass_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LocalUserUpdate provides a mock function with given fields: ctx, roles, email
+func (_m *MockDBInterface) LocalUserUpdate(ctx context.Context, roles string, email string) error {
+	ret := _m.Called(ctx, roles, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LocalUserUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, roles, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_LocalUserUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserUpdate'
+type MockDBInterface_LocalUserUpdate_Call struct {
+	*mock.Call
+}
+
+// LocalUserUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roles string
+//   - email string
+func (_e *MockDBInterface_Expecter) LocalUserUpdate(ctx interface{}, roles interface{}, email interface{}) *MockDBInterface_LocalUserUpdate_Call {
+	return &MockDBInterface_LocalUserUpdate_Call{Call: _e.mock.On("LocalUserUpdate", ctx, roles, email)}
+}
+
+func (_c *MockDBInterface_LocalUserUpdate_Call) Run(run func(ctx context.Context, roles string, email string)) *MockDBInterface_LocalUserUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_LocalUserUpdate_Call) Return(_a0 error) *MockDBInterface_LocalUserUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_LocalUserUpdate_Call) RunAndReturn(run func(context.Context, string, string) error) *MockDBInterface_LocalUserUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperationSum provides a mock function with given fields: ctx, walletID, from, to
+func (_m *MockDBInterface) OperationSum(ctx context.Context, walletID string, from *time.Time, to *time.Time) (money.Decimal, error) {
+	ret := _m.Called(ctx, walletID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OperationSum")
+	}
+
+	var r0 money.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time, *time.Time) (money.Decimal, error)); ok {
+		return rf(ctx, walletID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time, *time.Time) money.Decimal); ok {
+		r0 = rf(ctx, walletID, from, to)
+	} else {
+		r0 = ret.Get(0).(money.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *time.Time, *time.Time) error); ok {
+		r1 = rf(ctx, walletID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_OperationSum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperationSum'
+type MockDBInterface_OperationSum_Call struct {
+	*mock.Call
+}
+
+// OperationSum is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - from *time.Time
+//   - to *time.Time
+func (_e *MockDBInterface_Expecter) OperationSum(ctx interface{}, walletID interface{}, from interface{}, to interface{}) *MockDBInterface_OperationSum_Call {
+	return &MockDBInterface_OperationSum_Call{Call: _e.mock.On("OperationSum", ctx, walletID, from, to)}
+}
+
+func (_c *MockDBInterface_OperationSum_Call) Run(run func(ctx context.Context, walletID string, from *time.Time, to *time.Time)) *MockDBInterface_OperationSum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*time.Time), args[3].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_OperationSum_Call) Return(_a0 money.Decimal, _a1 error) *MockDBInterface_OperationSum_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_OperationSum_Call) RunAndReturn(run func(context.Context, string, *time.Time, *time.Time) (money.Decimal, error)) *MockDBInterface_OperationSum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperationSums provides a mock function with given fields: ctx, walletID, from, to, interval
+func (_m *MockDBInterface) OperationSums(ctx context.Context, walletID string, from *time.Time, to *time.Time, interval dao.BalanceInterval) ([]*dao.PeriodSum, error) {
+	ret := _m.Called(ctx, walletID, from, to, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OperationSums")
+	}
+
+	var r0 []*dao.PeriodSum
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time, *time.Time, dao.BalanceInterval) ([]*dao.PeriodSum, error)); ok {
+		return rf(ctx, walletID, from, to, interval)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time, *time.Time, dao.BalanceInterval) []*dao.PeriodSum); ok {
+		r0 = rf(ctx, walletID, from, to, interval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.PeriodSum)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *time.Time, *time.Time, dao.BalanceInterval) error); ok {
+		r1 = rf(ctx, walletID, from, to, interval)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_OperationSums_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperationSums'
+type MockDBInterface_OperationSums_Call struct {
+	*mock.Call
+}
+
+// OperationSums is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - from *time.Time
+//   - to *time.Time
+//   - interval dao.BalanceInterval
+func (_e *MockDBInterface_Expecter) OperationSums(ctx interface{}, walletID interface{}, from interface{}, to interface{}, interval interface{}) *MockDBInterface_OperationSums_Call {
+	return &MockDBInterface_OperationSums_Call{Call: _e.mock.On("OperationSums", ctx, walletID, from, to, interval)}
+}
+
+func (_c *MockDBInterface_OperationSums_Call) Run(run func(ctx context.Context, walletID string, from *time.Time, to *time.Time, interval dao.BalanceInterval)) *MockDBInterface_OperationSums_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*time.Time), args[3].(*time.Time), args[4].(dao.BalanceInterval))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_OperationSums_Call) Return(_a0 []*dao.PeriodSum, _a1 error) *MockDBInterface_OperationSums_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_OperationSums_Call) RunAndReturn(run func(context.Context, string, *time.Time, *time.Time, dao.BalanceInterval) ([]*dao.PeriodSum, error)) *MockDBInterface_OperationSums_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Ping provides a mock function with given fields: ctx
+func (_m *MockDBInterface) Ping(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockDBInterface_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDBInterface_Expecter) Ping(ctx interface{}) *MockDBInterface_Ping_Call {
+	return &MockDBInterface_Ping_Call{Call: _e.mock.On("Ping", ctx)}
+}
+
+func (_c *MockDBInterface_Ping_Call) Run(run func(ctx context.Context)) *MockDBInterface_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_Ping_Call) Return(_a0 error) *MockDBInterface_Ping_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_Ping_Call) RunAndReturn(run func(context.Context) error) *MockDBInterface_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecurringAdvance provides a mock function with given fields: ctx, nextAt, occurrences, ruleID, previousOccurrences
+func (_m *MockDBInterface) RecurringAdvance(ctx context.Context, nextAt sql.NullTime, occurrences int32, ruleID string, previousOccurrences int32) (int64, error) {
+	ret := _m.Called(ctx, nextAt, occurrences, ruleID, previousOccurrences)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecurringAdvance")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullTime, int32, string, int32) (int64, error)); ok {
+		return rf(ctx, nextAt, occurrences, ruleID, previousOccurrences)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullTime, int32, string, int32) int64); ok {
+		r0 = rf(ctx, nextAt, occurrences, ruleID, previousOccurrences)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullTime, int32, string, int32) error); ok {
+		r1 = rf(ctx, nextAt, occurrences, ruleID, previousOccurrences)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_RecurringAdvance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecurringAdvance'
+type MockDBInterface_RecurringAdvance_Call struct {
+	*mock.Call
+}
+
+// RecurringAdvance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nextAt sql.NullTime
+//   - occurrences int32
+//   - ruleID string
+//   - previousOccurrences int32
+func (_e *MockDBInterface_Expecter) RecurringAdvance(ctx interface{}, nextAt interface{}, occurrences interface{}, ruleID interface{}, previousOccurrences interface{}) *MockDBInterface_RecurringAdvance_Call {
+	return &MockDBInterface_RecurringAdvance_Call{Call: _e.mock.On("RecurringAdvance", ctx, nextAt, occurrences, ruleID, previousOccurrences)}
+}
+
+func (_c *MockDBInterface_RecurringAdvance_Call) Run(run func(ctx context.Context, nextAt sql.NullTime, occurrences int32, ruleID string, previousOccurrences int32)) *MockDBInterface_RecurringAdvance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullTime), args[2].(int32), args[3].(string), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_RecurringAdvance_Call) Return(_a0 int64, _a1 error) *MockDBInterface_RecurringAdvance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_RecurringAdvance_Call) RunAndReturn(run func(context.Context, sql.NullTime, int32, string, int32) (int64, error)) *MockDBInterface_RecurringAdvance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecurringDelete provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) RecurringDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecurringDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_RecurringDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecurringDelete'
+type MockDBInterface_RecurringDelete_Call struct {
+	*mock.Call
+}
+
+// RecurringDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) RecurringDelete(ctx interface{}, id interface{}) *MockDBInterface_RecurringDelete_Call {
+	return &MockDBInterface_RecurringDelete_Call{Call: _e.mock.On("RecurringDelete", ctx, id)}
+}
+
+func (_c *MockDBInterface_RecurringDelete_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_RecurringDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_RecurringDelete_Call) Return(_a0 error) *MockDBInterface_RecurringDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_RecurringDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockDBInterface_RecurringDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecurringGetByID provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) RecurringGetByID(ctx context.Context, id string) (*dao.Recurring, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecurringGetByID")
+	}
+
+	var r0 *dao.Recurring
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Recurring, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Recurring); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Recurring)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_RecurringGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecurringGetByID'
+type MockDBInterface_RecurringGetByID_Call struct {
+	*mock.Call
+}
+
+// RecurringGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) RecurringGetByID(ctx interface{}, id interface{}) *MockDBInterface_RecurringGetByID_Call {
+	return &MockDBInterface_RecurringGetByID_Call{Call: _e.mock.On("RecurringGetByID", ctx, id)}
+}
+
+func (_c *MockDBInterface_RecurringGetByID_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_RecurringGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_RecurringGetByID_Call) Return(_a0 *dao.Recurring, _a1 error) *MockDBInterface_RecurringGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_RecurringGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Recurring, error)) *MockDBInterface_RecurringGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecurringInsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) RecurringInsert(ctx context.Context, arg *dao.RecurringInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecurringInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.RecurringInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_RecurringInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecurringInsert'
+type MockDBInterface_RecurringInsert_Call struct {
+	*mock.Call
+}
+
+// RecurringInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.RecurringInsertParams
+func (_e *MockDBInterface_Expecter) RecurringInsert(ctx interface{}, arg interface{}) *MockDBInterface_RecurringInsert_Call {
+	return &MockDBInterface_RecurringInsert_Call{Call: _e.mock.On("RecurringInsert", ctx, arg)}
+}
+
+func (_c *MockDBInterface_RecurringInsert_Call) Run(run func(ctx context.Context, arg *dao.RecurringInsertParams)) *MockDBInterface_RecurringInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.RecurringInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_RecurringInsert_Call) Return(_a0 error) *MockDBInterface_RecurringInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_RecurringInsert_Call) RunAndReturn(run func(context.Context, *dao.RecurringInsertParams) error) *MockDBInterface_RecurringInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecurringListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockDBInterface) RecurringListByUser(ctx context.Context, userID string) ([]*dao.Recurring, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecurringListByUser")
+	}
+
+	var r0 []*dao.Recurring
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Recurring, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Recurring); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Recurring)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_RecurringListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecurringListByUser'
+type MockDBInterface_RecurringListByUser_Call struct {
+	*mock.Call
+}
+
+// RecurringListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockDBInterface_Expecter) RecurringListByUser(ctx interface{}, userID interface{}) *MockDBInterface_RecurringListByUser_Call {
+	return &MockDBInterface_RecurringListByUser_Call{Call: _e.mock.On("RecurringListByUser", ctx, userID)}
+}
+
+func (_c *MockDBInterface_RecurringListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockDBInterface_RecurringListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_RecurringListByUser_Call) Return(_a0 []*dao.Recurring, _a1 error) *MockDBInterface_RecurringListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_RecurringListByUser_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Recurring, error)) *MockDBInterface_RecurringListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecurringListDue provides a mock function with given fields: ctx, until
+func (_m *MockDBInterface) RecurringListDue(ctx context.Context, until sql.NullTime) ([]*dao.Recurring, error) {
+	ret := _m.Called(ctx, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecurringListDue")
+	}
+
+	var r0 []*dao.Recurring
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullTime) ([]*dao.Recurring, error)); ok {
+		return rf(ctx, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullTime) []*dao.Recurring); ok {
+		r0 = rf(ctx, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Recurring)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullTime) error); ok {
+		r1 = rf(ctx, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_RecurringListDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecurringListDue'
+type MockDBInterface_RecurringListDue_Call struct {
+	*mock.Call
+}
+
+// RecurringListDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - until sql.NullTime
+func (_e *MockDBInterface_Expecter) RecurringListDue(ctx interface{}, until interface{}) *MockDBInterface_RecurringListDue_Call {
+	return &MockDBInterface_RecurringListDue_Call{Call: _e.mock.On("RecurringListDue", ctx, until)}
+}
+
+func (_c *MockDBInterface_RecurringListDue_Call) Run(run func(ctx context.Context, until sql.NullTime)) *MockDBInterface_RecurringListDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullTime))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_RecurringListDue_Call) Return(_a0 []*dao.Recurring, _a1 error) *MockDBInterface_RecurringListDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_RecurringListDue_Call) RunAndReturn(run func(context.Context, sql.NullTime) ([]*dao.Recurring, error)) *MockDBInterface_RecurringListDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecurringSetCategory provides a mock function with given fields: ctx, newCategoryID, categoryID
+func (_m *MockDBInterface) RecurringSetCategory(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString) error {
+	ret := _m.Called(ctx, newCategoryID, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecurringSetCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString, sql.NullString) error); ok {
+		r0 = rf(ctx, newCategoryID, categoryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_RecurringSetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecurringSetCategory'
+type MockDBInterface_RecurringSetCategory_Call struct {
+	*mock.Call
+}
+
+// RecurringSetCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newCategoryID sql.NullString
+//   - categoryID sql.NullString
+func (_e *MockDBInterface_Expecter) RecurringSetCategory(ctx interface{}, newCategoryID interface{}, categoryID interface{}) *MockDBInterface_RecurringSetCategory_Call {
+	return &MockDBInterface_RecurringSetCategory_Call{Call: _e.mock.On("RecurringSetCategory", ctx, newCategoryID, categoryID)}
+}
+
+func (_c *MockDBInterface_RecurringSetCategory_Call) Run(run func(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString)) *MockDBInterface_RecurringSetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString), args[2].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_RecurringSetCategory_Call) Return(_a0 error) *MockDBInterface_RecurringSetCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_RecurringSetCategory_Call) RunAndReturn(run func(context.Context, sql.NullString, sql.NullString) error) *MockDBInterface_RecurringSetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SecurityPriceLatest provides a mock function with given fields: ctx, ticker, pricedOn
+func (_m *MockDBInterface) SecurityPriceLatest(ctx context.Context, ticker string, pricedOn time.Time) (*dao.SecurityPrice, error) {
+	ret := _m.Called(ctx, ticker, pricedOn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SecurityPriceLatest")
+	}
+
+	var r0 *dao.SecurityPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (*dao.SecurityPrice, error)); ok {
+		return rf(ctx, ticker, pricedOn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) *dao.SecurityPrice); ok {
+		r0 = rf(ctx, ticker, pricedOn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.SecurityPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, ticker, pricedOn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_SecurityPriceLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecurityPriceLatest'
+type MockDBInterface_SecurityPriceLatest_Call struct {
+	*mock.Call
+}
+
+// SecurityPriceLatest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticker string
+//   - pricedOn time.Time
+func (_e *MockDBInterface_Expecter) SecurityPriceLatest(ctx interface{}, ticker interface{}, pricedOn interface{}) *MockDBInterface_SecurityPriceLatest_Call {
+	return &MockDBInterface_SecurityPriceLatest_Call{Call: _e.mock.On("SecurityPriceLatest", ctx, ticker, pricedOn)}
+}
+
+func (_c *MockDBInterface_SecurityPriceLatest_Call) Run(run func(ctx context.Context, ticker string, pricedOn time.Time)) *MockDBInterface_SecurityPriceLatest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_SecurityPriceLatest_Call) Return(_a0 *dao.SecurityPrice, _a1 error) *MockDBInterface_SecurityPriceLatest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_SecurityPriceLatest_Call) RunAndReturn(run func(context.Context, string, time.Time) (*dao.SecurityPrice, error)) *MockDBInterface_SecurityPriceLatest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SecurityPriceUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) SecurityPriceUpsert(ctx context.Context, arg *dao.SecurityPriceUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SecurityPriceUpsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.SecurityPriceUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_SecurityPriceUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecurityPriceUpsert'
+type MockDBInterface_SecurityPriceUpsert_Call struct {
+	*mock.Call
+}
+
+// SecurityPriceUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.SecurityPriceUpsertParams
+func (_e *MockDBInterface_Expecter) SecurityPriceUpsert(ctx interface{}, arg interface{}) *MockDBInterface_SecurityPriceUpsert_Call {
+	return &MockDBInterface_SecurityPriceUpsert_Call{Call: _e.mock.On("SecurityPriceUpsert", ctx, arg)}
+}
+
+func (_c *MockDBInterface_SecurityPriceUpsert_Call) Run(run func(ctx context.Context, arg *dao.SecurityPriceUpsertParams)) *MockDBInterface_SecurityPriceUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.SecurityPriceUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_SecurityPriceUpsert_Call) Return(_a0 error) *MockDBInterface_SecurityPriceUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_SecurityPriceUpsert_Call) RunAndReturn(run func(context.Context, *dao.SecurityPriceUpsertParams) error) *MockDBInterface_SecurityPriceUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SpendingReport provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) SpendingReport(ctx context.Context, arg *dao.SpendingReportParams) ([]*dao.SpendingGroup, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpendingReport")
+	}
+
+	var r0 []*dao.SpendingGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.SpendingReportParams) ([]*dao.SpendingGroup, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.SpendingReportParams) []*dao.SpendingGroup); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.SpendingGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.SpendingReportParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_SpendingReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpendingReport'
+type MockDBInterface_SpendingReport_Call struct {
+	*mock.Call
+}
+
+// SpendingReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.SpendingReportParams
+func (_e *MockDBInterface_Expecter) SpendingReport(ctx interface{}, arg interface{}) *MockDBInterface_SpendingReport_Call {
+	return &MockDBInterface_SpendingReport_Call{Call: _e.mock.On("SpendingReport", ctx, arg)}
+}
+
+func (_c *MockDBInterface_SpendingReport_Call) Run(run func(ctx context.Context, arg *dao.SpendingReportParams)) *MockDBInterface_SpendingReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.SpendingReportParams))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_SpendingReport_Call) Return(_a0 []*dao.SpendingGroup, _a1 error) *MockDBInterface_SpendingReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_SpendingReport_Call) RunAndReturn(run func(context.Context, *dao.SpendingReportParams) ([]*dao.SpendingGroup, error)) *MockDBInterface_SpendingReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TagGetByName provides a mock function with given fields: ctx, userID, name
+func (_m *MockDBInterface) TagGetByName(ctx context.Context, userID string, name string) (*dao.Tag, error) {
+	ret := _m.Called(ctx, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagGetByName")
+	}
+
+	var r0 *dao.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dao.Tag, error)); ok {
+		return rf(ctx, userID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dao.Tag); ok {
+		r0 = rf(ctx, userID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_TagGetByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagGetByName'
+type MockDBInterface_TagGetByName_Call struct {
+	*mock.Call
+}
+
+// TagGetByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - name string
+func (_e *MockDBInterface_Expecter) TagGetByName(ctx interface{}, userID interface{}, name interface{}) *MockDBInterface_TagGetByName_Call {
+	return &MockDBInterface_TagGetByName_Call{Call: _e.mock.On("TagGetByName", ctx, userID, name)}
+}
+
+func (_c *MockDBInterface_TagGetByName_Call) Run(run func(ctx context.Context, userID string, name string)) *MockDBInterface_TagGetByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_TagGetByName_Call) Return(_a0 *dao.Tag, _a1 error) *MockDBInterface_TagGetByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_TagGetByName_Call) RunAndReturn(run func(context.Context, string, string) (*dao.Tag, error)) *MockDBInterface_TagGetByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TagInsert provides a mock function with given fields: ctx, iD, userID, name, createdAt
+func (_m *MockDBInterface) TagInsert(ctx context.Context, iD string, userID string, name string, createdAt time.Time) error {
+	ret := _m.Called(ctx, iD, userID, name, createdAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time) error); ok {
+		r0 = rf(ctx, iD, userID, name, createdAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_TagInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagInsert'
+type MockDBInterface_TagInsert_Call struct {
+	*mock.Call
+}
+
+// TagInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - iD string
+//   - userID string
+//   - name string
+//   - createdAt time.Time
+func (_e *MockDBInterface_Expecter) TagInsert(ctx interface{}, iD interface{}, userID interface{}, name interface{}, createdAt interface{}) *MockDBInterface_TagInsert_Call {
+	return &MockDBInterface_TagInsert_Call{Call: _e.mock.On("TagInsert", ctx, iD, userID, name, createdAt)}
+}
+
+func (_c *MockDBInterface_TagInsert_Call) Run(run func(ctx context.Context, iD string, userID string, name string, createdAt time.Time)) *MockDBInterface_TagInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_TagInsert_Call) Return(_a0 error) *MockDBInterface_TagInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_TagInsert_Call) RunAndReturn(run func(context.Context, string, string, string, time.Time) error) *MockDBInterface_TagInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TagListByExpense provides a mock function with given fields: ctx, expenseID
+func (_m *MockDBInterface) TagListByExpense(ctx context.Context, expenseID string) ([]*dao.Tag, error) {
+	ret := _m.Called(ctx, expenseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagListByExpense")
+	}
+
+	var r0 []*dao.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Tag, error)); ok {
+		return rf(ctx, expenseID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Tag); ok {
+		r0 = rf(ctx, expenseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, expenseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_TagListByExpense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagListByExpense'
+type MockDBInterface_TagListByExpense_Call struct {
+	*mock.Call
+}
+
+// TagListByExpense is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expenseID string
+func (_e *MockDBInterface_Expecter) TagListByExpense(ctx interface{}, expenseID interface{}) *MockDBInterface_TagListByExpense_Call {
+	return &MockDBInterface_TagListByExpense_Call{Call: _e.mock.On("TagListByExpense", ctx, expenseID)}
+}
+
+func (_c *MockDBInterface_TagListByExpense_Call) Run(run func(ctx context.Context, expenseID string)) *MockDBInterface_TagListByExpense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_TagListByExpense_Call) Return(_a0 []*dao.Tag, _a1 error) *MockDBInterface_TagListByExpense_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_TagListByExpense_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Tag, error)) *MockDBInterface_TagListByExpense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TagListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockDBInterface) TagListByUser(ctx context.Context, userID string) ([]*dao.Tag, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagListByUser")
+	}
+
+	var r0 []*dao.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Tag, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Tag); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_TagListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagListByUser'
+type MockDBInterface_TagListByUser_Call struct {
+	*mock.Call
+}
+
+// TagListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockDBInterface_Expecter) TagListByUser(ctx interface{}, userID interface{}) *MockDBInterface_TagListByUser_Call {
+	return &MockDBInterface_TagListByUser_Call{Call: _e.mock.On("TagListByUser", ctx, userID)}
+}
+
+func (_c *MockDBInterface_TagListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockDBInterface_TagListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_TagListByUser_Call) Return(_a0 []*dao.Tag, _a1 error) *MockDBInterface_TagListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_TagListByUser_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Tag, error)) *MockDBInterface_TagListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TradeDelete provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) TradeDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TradeDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDBInterface_TradeDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TradeDelete'
+type MockDBInterface_TradeDelete_Call struct {
+	*mock.Call
+}
+
+// TradeDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) TradeDelete(ctx interface{}, id interface{}) *MockDBInterface_TradeDelete_Call {
+	return &MockDBInterface_TradeDelete_Call{Call: _e.mock.On("TradeDelete", ctx, id)}
+}
+
+func (_c *MockDBInterface_TradeDelete_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_TradeDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_TradeDelete_Call) Return(_a0 error) *MockDBInterface_TradeDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDBInterface_TradeDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockDBInterface_TradeDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TradeGetByID provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) TradeGetByID(ctx context.Context, id string) (*dao.Trade, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TradeGetByID")
+	}
+
+	var r0 *dao.Trade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Trade, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Trade); ok {
+		r0 = rf(ctx, id)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(func())
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Trade)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
-		r2 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockDBInterface_BeginTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginTx'
-type MockDBInterface_BeginTx_Call struct {
+// MockDBInterface_TradeGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TradeGetByID'
+type MockDBInterface_TradeGetByID_Call struct {
 	*mock.Call
 }
 
-// BeginTx is a helper method to define mock.On call
+// TradeGetByID is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDBInterface_Expecter) BeginTx(ctx interface{}) *MockDBInterface_BeginTx_Call {
-	return &MockDBInterface_BeginTx_Call{Call: _e.mock.On("BeginTx", ctx)}
+//   - id string
+func (_e *MockDBInterface_Expecter) TradeGetByID(ctx interface{}, id interface{}) *MockDBInterface_TradeGetByID_Call {
+	return &MockDBInterface_TradeGetByID_Call{Call: _e.mock.On("TradeGetByID", ctx, id)}
 }
 
-func (_c *MockDBInterface_BeginTx_Call) Run(run func(ctx context.Context)) *MockDBInterface_BeginTx_Call {
+func (_c *MockDBInterface_TradeGetByID_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_TradeGetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_BeginTx_Call) Return(_a0 dao.DBInterface, _a1 func(), _a2 error) *MockDBInterface_BeginTx_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *MockDBInterface_TradeGetByID_Call) Return(_a0 *dao.Trade, _a1 error) *MockDBInterface_TradeGetByID_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDBInterface_BeginTx_Call) RunAndReturn(run func(context.Context) (dao.DBInterface, func(), error)) *MockDBInterface_BeginTx_Call {
+func (_c *MockDBInterface_TradeGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Trade, error)) *MockDBInterface_TradeGetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Commit provides a mock function with given fields: ctx
-func (_m *MockDBInterface) Commit(ctx context.Context) error {
-	ret := _m.Called(ctx)
+// TradeInsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) TradeInsert(ctx context.Context, arg *dao.TradeInsertParams) error {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Commit")
+		panic("no return value specified for TradeInsert")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.TradeInsertParams) error); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -109,91 +5419,104 @@ func (_m *MockDBInterface) Commit(ctx context.Context) error {
 	return r0
 }
 
-// MockDBInterface_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
-type MockDBInterface_Commit_Call struct {
+// MockDBInterface_TradeInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TradeInsert'
+type MockDBInterface_TradeInsert_Call struct {
 	*mock.Call
 }
 
-// Commit is a helper method to define mock.On call
+// TradeInsert is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDBInterface_Expecter) Commit(ctx interface{}) *MockDBInterface_Commit_Call {
-	return &MockDBInterface_Commit_Call{Call: _e.mock.On("Commit", ctx)}
+//   - arg *dao.TradeInsertParams
+func (_e *MockDBInterface_Expecter) TradeInsert(ctx interface{}, arg interface{}) *MockDBInterface_TradeInsert_Call {
+	return &MockDBInterface_TradeInsert_Call{Call: _e.mock.On("TradeInsert", ctx, arg)}
 }
 
-func (_c *MockDBInterface_Commit_Call) Run(run func(ctx context.Context)) *MockDBInterface_Commit_Call {
+func (_c *MockDBInterface_TradeInsert_Call) Run(run func(ctx context.Context, arg *dao.TradeInsertParams)) *MockDBInterface_TradeInsert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*dao.TradeInsertParams))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_Commit_Call) Return(_a0 error) *MockDBInterface_Commit_Call {
+func (_c *MockDBInterface_TradeInsert_Call) Return(_a0 error) *MockDBInterface_TradeInsert_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDBInterface_Commit_Call) RunAndReturn(run func(context.Context) error) *MockDBInterface_Commit_Call {
+func (_c *MockDBInterface_TradeInsert_Call) RunAndReturn(run func(context.Context, *dao.TradeInsertParams) error) *MockDBInterface_TradeInsert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DB provides a mock function with given fields:
-func (_m *MockDBInterface) DB() *sql.DB {
-	ret := _m.Called()
+// TradeListByWallet provides a mock function with given fields: ctx, walletID
+func (_m *MockDBInterface) TradeListByWallet(ctx context.Context, walletID string) ([]*dao.Trade, error) {
+	ret := _m.Called(ctx, walletID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DB")
+		panic("no return value specified for TradeListByWallet")
 	}
 
-	var r0 *sql.DB
-	if rf, ok := ret.Get(0).(func() *sql.DB); ok {
-		r0 = rf()
+	var r0 []*dao.Trade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Trade, error)); ok {
+		return rf(ctx, walletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Trade); ok {
+		r0 = rf(ctx, walletID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sql.DB)
+			r0 = ret.Get(0).([]*dao.Trade)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, walletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDBInterface_DB_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DB'
-type MockDBInterface_DB_Call struct {
+// MockDBInterface_TradeListByWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TradeListByWallet'
+type MockDBInterface_TradeListByWallet_Call struct {
 	*mock.Call
 }
 
-// DB is a helper method to define mock.On call
-func (_e *MockDBInterface_Expecter) DB() *MockDBInterface_DB_Call {
-	return &MockDBInterface_DB_Call{Call: _e.mock.On("DB")}
+// TradeListByWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+func (_e *MockDBInterface_Expecter) TradeListByWallet(ctx interface{}, walletID interface{}) *MockDBInterface_TradeListByWallet_Call {
+	return &MockDBInterface_TradeListByWallet_Call{Call: _e.mock.On("TradeListByWallet", ctx, walletID)}
 }
 
-func (_c *MockDBInterface_DB_Call) Run(run func()) *MockDBInterface_DB_Call {
+func (_c *MockDBInterface_TradeListByWallet_Call) Run(run func(ctx context.Context, walletID string)) *MockDBInterface_TradeListByWallet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_DB_Call) Return(_a0 *sql.DB) *MockDBInterface_DB_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDBInterface_TradeListByWallet_Call) Return(_a0 []*dao.Trade, _a1 error) *MockDBInterface_TradeListByWallet_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDBInterface_DB_Call) RunAndReturn(run func() *sql.DB) *MockDBInterface_DB_Call {
+func (_c *MockDBInterface_TradeListByWallet_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Trade, error)) *MockDBInterface_TradeListByWallet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExpenseInsert provides a mock function with given fields: ctx, arg
-func (_m *MockDBInterface) ExpenseInsert(ctx context.Context, arg *dao.ExpenseInsertParams) error {
+// TransferInsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) TransferInsert(ctx context.Context, arg *dao.TransferInsertParams) error {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExpenseInsert")
+		panic("no return value specified for TransferInsert")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExpenseInsertParams) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.TransferInsertParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
@@ -202,58 +5525,58 @@ func (_m *MockDBInterface) ExpenseInsert(ctx context.Context, arg *dao.ExpenseIn
 	return r0
 }
 
-// MockDBInterface_ExpenseInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseInsert'
-type MockDBInterface_ExpenseInsert_Call struct {
+// MockDBInterface_TransferInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferInsert'
+type MockDBInterface_TransferInsert_Call struct {
 	*mock.Call
 }
 
-// ExpenseInsert is a helper method to define mock.On call
+// TransferInsert is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg *dao.ExpenseInsertParams
-func (_e *MockDBInterface_Expecter) ExpenseInsert(ctx interface{}, arg interface{}) *MockDBInterface_ExpenseInsert_Call {
-	return &MockDBInterface_ExpenseInsert_Call{Call: _e.mock.On("ExpenseInsert", ctx, arg)}
+//   - arg *dao.TransferInsertParams
+func (_e *MockDBInterface_Expecter) TransferInsert(ctx interface{}, arg interface{}) *MockDBInterface_TransferInsert_Call {
+	return &MockDBInterface_TransferInsert_Call{Call: _e.mock.On("TransferInsert", ctx, arg)}
 }
 
-func (_c *MockDBInterface_ExpenseInsert_Call) Run(run func(ctx context.Context, arg *dao.ExpenseInsertParams)) *MockDBInterface_ExpenseInsert_Call {
+func (_c *MockDBInterface_TransferInsert_Call) Run(run func(ctx context.Context, arg *dao.TransferInsertParams)) *MockDBInterface_TransferInsert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*dao.ExpenseInsertParams))
+		run(args[0].(context.Context), args[1].(*dao.TransferInsertParams))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_ExpenseInsert_Call) Return(_a0 error) *MockDBInterface_ExpenseInsert_Call {
+func (_c *MockDBInterface_TransferInsert_Call) Return(_a0 error) *MockDBInterface_TransferInsert_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDBInterface_ExpenseInsert_Call) RunAndReturn(run func(context.Context, *dao.ExpenseInsertParams) error) *MockDBInterface_ExpenseInsert_Call {
+func (_c *MockDBInterface_TransferInsert_Call) RunAndReturn(run func(context.Context, *dao.TransferInsertParams) error) *MockDBInterface_TransferInsert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExpenseListByWallet provides a mock function with given fields: ctx, walletID
-func (_m *MockDBInterface) ExpenseListByWallet(ctx context.Context, walletID string) ([]*dao.Expense, error) {
-	ret := _m.Called(ctx, walletID)
+// TransferListByTransferID provides a mock function with given fields: ctx, transferID
+func (_m *MockDBInterface) TransferListByTransferID(ctx context.Context, transferID string) ([]*dao.Transfer, error) {
+	ret := _m.Called(ctx, transferID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExpenseListByWallet")
+		panic("no return value specified for TransferListByTransferID")
 	}
 
-	var r0 []*dao.Expense
+	var r0 []*dao.Transfer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Expense, error)); ok {
-		return rf(ctx, walletID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Transfer, error)); ok {
+		return rf(ctx, transferID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Expense); ok {
-		r0 = rf(ctx, walletID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Transfer); ok {
+		r0 = rf(ctx, transferID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.Expense)
+			r0 = ret.Get(0).([]*dao.Transfer)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, walletID)
+		r1 = rf(ctx, transferID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -261,58 +5584,58 @@ func (_m *MockDBInterface) ExpenseListByWallet(ctx context.Context, walletID str
 	return r0, r1
 }
 
-// MockDBInterface_ExpenseListByWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseListByWallet'
-type MockDBInterface_ExpenseListByWallet_Call struct {
+// MockDBInterface_TransferListByTransferID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferListByTransferID'
+type MockDBInterface_TransferListByTransferID_Call struct {
 	*mock.Call
 }
 
-// ExpenseListByWallet is a helper method to define mock.On call
+// TransferListByTransferID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - walletID string
-func (_e *MockDBInterface_Expecter) ExpenseListByWallet(ctx interface{}, walletID interface{}) *MockDBInterface_ExpenseListByWallet_Call {
-	return &MockDBInterface_ExpenseListByWallet_Call{Call: _e.mock.On("ExpenseListByWallet", ctx, walletID)}
+//   - transferID string
+func (_e *MockDBInterface_Expecter) TransferListByTransferID(ctx interface{}, transferID interface{}) *MockDBInterface_TransferListByTransferID_Call {
+	return &MockDBInterface_TransferListByTransferID_Call{Call: _e.mock.On("TransferListByTransferID", ctx, transferID)}
 }
 
-func (_c *MockDBInterface_ExpenseListByWallet_Call) Run(run func(ctx context.Context, walletID string)) *MockDBInterface_ExpenseListByWallet_Call {
+func (_c *MockDBInterface_TransferListByTransferID_Call) Run(run func(ctx context.Context, transferID string)) *MockDBInterface_TransferListByTransferID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_ExpenseListByWallet_Call) Return(_a0 []*dao.Expense, _a1 error) *MockDBInterface_ExpenseListByWallet_Call {
+func (_c *MockDBInterface_TransferListByTransferID_Call) Return(_a0 []*dao.Transfer, _a1 error) *MockDBInterface_TransferListByTransferID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDBInterface_ExpenseListByWallet_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Expense, error)) *MockDBInterface_ExpenseListByWallet_Call {
+func (_c *MockDBInterface_TransferListByTransferID_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Transfer, error)) *MockDBInterface_TransferListByTransferID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExpenseListByWalletByUser provides a mock function with given fields: ctx, walletID, userID
-func (_m *MockDBInterface) ExpenseListByWalletByUser(ctx context.Context, walletID string, userID string) ([]*dao.Expense, error) {
-	ret := _m.Called(ctx, walletID, userID)
+// TransferListByWallet provides a mock function with given fields: ctx, walletID
+func (_m *MockDBInterface) TransferListByWallet(ctx context.Context, walletID string) ([]*dao.Transfer, error) {
+	ret := _m.Called(ctx, walletID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExpenseListByWalletByUser")
+		panic("no return value specified for TransferListByWallet")
 	}
 
-	var r0 []*dao.Expense
+	var r0 []*dao.Transfer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*dao.Expense, error)); ok {
-		return rf(ctx, walletID, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Transfer, error)); ok {
+		return rf(ctx, walletID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*dao.Expense); ok {
-		r0 = rf(ctx, walletID, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Transfer); ok {
+		r0 = rf(ctx, walletID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.Expense)
+			r0 = ret.Get(0).([]*dao.Transfer)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, walletID, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, walletID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -320,47 +5643,46 @@ func (_m *MockDBInterface) ExpenseListByWalletByUser(ctx context.Context, wallet
 	return r0, r1
 }
 
-// MockDBInterface_ExpenseListByWalletByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseListByWalletByUser'
-type MockDBInterface_ExpenseListByWalletByUser_Call struct {
+// MockDBInterface_TransferListByWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferListByWallet'
+type MockDBInterface_TransferListByWallet_Call struct {
 	*mock.Call
 }
 
-// ExpenseListByWalletByUser is a helper method to define mock.On call
+// TransferListByWallet is a helper method to define mock.On call
 //   - ctx context.Context
 //   - walletID string
-//   - userID string
-func (_e *MockDBInterface_Expecter) ExpenseListByWalletByUser(ctx interface{}, walletID interface{}, userID interface{}) *MockDBInterface_ExpenseListByWalletByUser_Call {
-	return &MockDBInterface_ExpenseListByWalletByUser_Call{Call: _e.mock.On("ExpenseListByWalletByUser", ctx, walletID, userID)}
+func (_e *MockDBInterface_Expecter) TransferListByWallet(ctx interface{}, walletID interface{}) *MockDBInterface_TransferListByWallet_Call {
+	return &MockDBInterface_TransferListByWallet_Call{Call: _e.mock.On("TransferListByWallet", ctx, walletID)}
 }
 
-func (_c *MockDBInterface_ExpenseListByWalletByUser_Call) Run(run func(ctx context.Context, walletID string, userID string)) *MockDBInterface_ExpenseListByWalletByUser_Call {
+func (_c *MockDBInterface_TransferListByWallet_Call) Run(run func(ctx context.Context, walletID string)) *MockDBInterface_TransferListByWallet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_ExpenseListByWalletByUser_Call) Return(_a0 []*dao.Expense, _a1 error) *MockDBInterface_ExpenseListByWalletByUser_Call {
+func (_c *MockDBInterface_TransferListByWallet_Call) Return(_a0 []*dao.Transfer, _a1 error) *MockDBInterface_TransferListByWallet_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDBInterface_ExpenseListByWalletByUser_Call) RunAndReturn(run func(context.Context, string, string) ([]*dao.Expense, error)) *MockDBInterface_ExpenseListByWalletByUser_Call {
+func (_c *MockDBInterface_TransferListByWallet_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Transfer, error)) *MockDBInterface_TransferListByWallet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// HistoryInsert provides a mock function with given fields: ctx, arg
-func (_m *MockDBInterface) HistoryInsert(ctx context.Context, arg *dao.HistoryInsertParams) error {
-	ret := _m.Called(ctx, arg)
+// UpdateBalance provides a mock function with given fields: ctx, walletID, delta, createdAt
+func (_m *MockDBInterface) UpdateBalance(ctx context.Context, walletID string, delta money.Decimal, createdAt time.Time) error {
+	ret := _m.Called(ctx, walletID, delta, createdAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for HistoryInsert")
+		panic("no return value specified for UpdateBalance")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryInsertParams) error); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, string, money.Decimal, time.Time) error); ok {
+		r0 = rf(ctx, walletID, delta, createdAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -368,116 +5690,224 @@ func (_m *MockDBInterface) HistoryInsert(ctx context.Context, arg *dao.HistoryIn
 	return r0
 }
 
-// MockDBInterface_HistoryInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryInsert'
-type MockDBInterface_HistoryInsert_Call struct {
+// MockDBInterface_UpdateBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBalance'
+type MockDBInterface_UpdateBalance_Call struct {
 	*mock.Call
 }
 
-// HistoryInsert is a helper method to define mock.On call
+// UpdateBalance is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg *dao.HistoryInsertParams
-func (_e *MockDBInterface_Expecter) HistoryInsert(ctx interface{}, arg interface{}) *MockDBInterface_HistoryInsert_Call {
-	return &MockDBInterface_HistoryInsert_Call{Call: _e.mock.On("HistoryInsert", ctx, arg)}
+//   - walletID string
+//   - delta money.Decimal
+//   - createdAt time.Time
+func (_e *MockDBInterface_Expecter) UpdateBalance(ctx interface{}, walletID interface{}, delta interface{}, createdAt interface{}) *MockDBInterface_UpdateBalance_Call {
+	return &MockDBInterface_UpdateBalance_Call{Call: _e.mock.On("UpdateBalance", ctx, walletID, delta, createdAt)}
 }
 
-func (_c *MockDBInterface_HistoryInsert_Call) Run(run func(ctx context.Context, arg *dao.HistoryInsertParams)) *MockDBInterface_HistoryInsert_Call {
+func (_c *MockDBInterface_UpdateBalance_Call) Run(run func(ctx context.Context, walletID string, delta money.Decimal, createdAt time.Time)) *MockDBInterface_UpdateBalance_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*dao.HistoryInsertParams))
+		run(args[0].(context.Context), args[1].(string), args[2].(money.Decimal), args[3].(time.Time))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_HistoryInsert_Call) Return(_a0 error) *MockDBInterface_HistoryInsert_Call {
+func (_c *MockDBInterface_UpdateBalance_Call) Return(_a0 error) *MockDBInterface_UpdateBalance_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDBInterface_HistoryInsert_Call) RunAndReturn(run func(context.Context, *dao.HistoryInsertParams) error) *MockDBInterface_HistoryInsert_Call {
+func (_c *MockDBInterface_UpdateBalance_Call) RunAndReturn(run func(context.Context, string, money.Decimal, time.Time) error) *MockDBInterface_UpdateBalance_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// HistoryList provides a mock function with given fields: ctx
-func (_m *MockDBInterface) HistoryList(ctx context.Context) ([]*dao.History, error) {
-	ret := _m.Called(ctx)
+// WalletCount provides a mock function with given fields: ctx, userID
+func (_m *MockDBInterface) WalletCount(ctx context.Context, userID sql.NullString) (int64, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for HistoryList")
+		panic("no return value specified for WalletCount")
 	}
 
-	var r0 []*dao.History
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*dao.History, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString) (int64, error)); ok {
+		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*dao.History); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullString) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_WalletCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletCount'
+type MockDBInterface_WalletCount_Call struct {
+	*mock.Call
+}
+
+// WalletCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID sql.NullString
+func (_e *MockDBInterface_Expecter) WalletCount(ctx interface{}, userID interface{}) *MockDBInterface_WalletCount_Call {
+	return &MockDBInterface_WalletCount_Call{Call: _e.mock.On("WalletCount", ctx, userID)}
+}
+
+func (_c *MockDBInterface_WalletCount_Call) Run(run func(ctx context.Context, userID sql.NullString)) *MockDBInterface_WalletCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_WalletCount_Call) Return(_a0 int64, _a1 error) *MockDBInterface_WalletCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_WalletCount_Call) RunAndReturn(run func(context.Context, sql.NullString) (int64, error)) *MockDBInterface_WalletCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WalletGetByID provides a mock function with given fields: ctx, id
+func (_m *MockDBInterface) WalletGetByID(ctx context.Context, id string) (*dao.Wallet, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WalletGetByID")
+	}
+
+	var r0 *dao.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Wallet, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Wallet); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.History)
+			r0 = ret.Get(0).(*dao.Wallet)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDBInterface_WalletGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletGetByID'
+type MockDBInterface_WalletGetByID_Call struct {
+	*mock.Call
+}
+
+// WalletGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDBInterface_Expecter) WalletGetByID(ctx interface{}, id interface{}) *MockDBInterface_WalletGetByID_Call {
+	return &MockDBInterface_WalletGetByID_Call{Call: _e.mock.On("WalletGetByID", ctx, id)}
+}
+
+func (_c *MockDBInterface_WalletGetByID_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_WalletGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDBInterface_WalletGetByID_Call) Return(_a0 *dao.Wallet, _a1 error) *MockDBInterface_WalletGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDBInterface_WalletGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Wallet, error)) *MockDBInterface_WalletGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WalletGrantDelete provides a mock function with given fields: ctx, walletID, userID
+func (_m *MockDBInterface) WalletGrantDelete(ctx context.Context, walletID string, userID string) error {
+	ret := _m.Called(ctx, walletID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WalletGrantDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, walletID, userID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDBInterface_HistoryList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryList'
-type MockDBInterface_HistoryList_Call struct {
+// MockDBInterface_WalletGrantDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletGrantDelete'
+type MockDBInterface_WalletGrantDelete_Call struct {
 	*mock.Call
 }
 
-// HistoryList is a helper method to define mock.On call
+// WalletGrantDelete is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDBInterface_Expecter) HistoryList(ctx interface{}) *MockDBInterface_HistoryList_Call {
-	return &MockDBInterface_HistoryList_Call{Call: _e.mock.On("HistoryList", ctx)}
+//   - walletID string
+//   - userID string
+func (_e *MockDBInterface_Expecter) WalletGrantDelete(ctx interface{}, walletID interface{}, userID interface{}) *MockDBInterface_WalletGrantDelete_Call {
+	return &MockDBInterface_WalletGrantDelete_Call{Call: _e.mock.On("WalletGrantDelete", ctx, walletID, userID)}
 }
 
-func (_c *MockDBInterface_HistoryList_Call) Run(run func(ctx context.Context)) *MockDBInterface_HistoryList_Call {
+func (_c *MockDBInterface_WalletGrantDelete_Call) Run(run func(ctx context.Context, walletID string, userID string)) *MockDBInterface_WalletGrantDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_HistoryList_Call) Return(_a0 []*dao.History, _a1 error) *MockDBInterface_HistoryList_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDBInterface_WalletGrantDelete_Call) Return(_a0 error) *MockDBInterface_WalletGrantDelete_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDBInterface_HistoryList_Call) RunAndReturn(run func(context.Context) ([]*dao.History, error)) *MockDBInterface_HistoryList_Call {
+func (_c *MockDBInterface_WalletGrantDelete_Call) RunAndReturn(run func(context.Context, string, string) error) *MockDBInterface_WalletGrantDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LocalUserGetByEmail provides a mock function with given fields: ctx, email
-func (_m *MockDBInterface) LocalUserGetByEmail(ctx context.Context, email string) (*dao.LocalUser, error) {
-	ret := _m.Called(ctx, email)
+// WalletGrantGet provides a mock function with given fields: ctx, walletID, userID
+func (_m *MockDBInterface) WalletGrantGet(ctx context.Context, walletID string, userID string) (*dao.WalletGrant, error) {
+	ret := _m.Called(ctx, walletID, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LocalUserGetByEmail")
+		panic("no return value specified for WalletGrantGet")
 	}
 
-	var r0 *dao.LocalUser
+	var r0 *dao.WalletGrant
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.LocalUser, error)); ok {
-		return rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dao.WalletGrant, error)); ok {
+		return rf(ctx, walletID, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.LocalUser); ok {
-		r0 = rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dao.WalletGrant); ok {
+		r0 = rf(ctx, walletID, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.LocalUser)
+			r0 = ret.Get(0).(*dao.WalletGrant)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, email)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, walletID, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -485,58 +5915,59 @@ func (_m *MockDBInterface) LocalUserGetByEmail(ctx context.Context, email string
 	return r0, r1
 }
 
-// MockDBInterface_LocalUserGetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserGetByEmail'
-type MockDBInterface_LocalUserGetByEmail_Call struct {
+// MockDBInterface_WalletGrantGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletGrantGet'
+type MockDBInterface_WalletGrantGet_Call struct {
 	*mock.Call
 }
 
-// LocalUserGetByEmail is a helper method to define mock.On call
+// WalletGrantGet is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-func (_e *MockDBInterface_Expecter) LocalUserGetByEmail(ctx interface{}, email interface{}) *MockDBInterface_LocalUserGetByEmail_Call {
-	return &MockDBInterface_LocalUserGetByEmail_Call{Call: _e.mock.On("LocalUserGetByEmail", ctx, email)}
+//   - walletID string
+//   - userID string
+func (_e *MockDBInterface_Expecter) WalletGrantGet(ctx interface{}, walletID interface{}, userID interface{}) *MockDBInterface_WalletGrantGet_Call {
+	return &MockDBInterface_WalletGrantGet_Call{Call: _e.mock.On("WalletGrantGet", ctx, walletID, userID)}
 }
 
-func (_c *MockDBInterface_LocalUserGetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockDBInterface_LocalUserGetByEmail_Call {
+func (_c *MockDBInterface_WalletGrantGet_Call) Run(run func(ctx context.Context, walletID string, userID string)) *MockDBInterface_WalletGrantGet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_LocalUserGetByEmail_Call) Return(_a0 *dao.LocalUser, _a1 error) *MockDBInterface_LocalUserGetByEmail_Call {
+func (_c *MockDBInterface_WalletGrantGet_Call) Return(_a0 *dao.WalletGrant, _a1 error) *MockDBInterface_WalletGrantGet_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDBInterface_LocalUserGetByEmail_Call) RunAndReturn(run func(context.Context, string) (*dao.LocalUser, error)) *MockDBInterface_LocalUserGetByEmail_Call {
+func (_c *MockDBInterface_WalletGrantGet_Call) RunAndReturn(run func(context.Context, string, string) (*dao.WalletGrant, error)) *MockDBInterface_WalletGrantGet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LocalUserGetByID provides a mock function with given fields: ctx, id
-func (_m *MockDBInterface) LocalUserGetByID(ctx context.Context, id string) (*dao.LocalUser, error) {
-	ret := _m.Called(ctx, id)
+// WalletGrantListByWallet provides a mock function with given fields: ctx, walletID
+func (_m *MockDBInterface) WalletGrantListByWallet(ctx context.Context, walletID string) ([]*dao.WalletGrant, error) {
+	ret := _m.Called(ctx, walletID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LocalUserGetByID")
+		panic("no return value specified for WalletGrantListByWallet")
 	}
 
-	var r0 *dao.LocalUser
+	var r0 []*dao.WalletGrant
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.LocalUser, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.WalletGrant, error)); ok {
+		return rf(ctx, walletID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.LocalUser); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.WalletGrant); ok {
+		r0 = rf(ctx, walletID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.LocalUser)
+			r0 = ret.Get(0).([]*dao.WalletGrant)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+		r1 = rf(ctx, walletID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -544,45 +5975,45 @@ func (_m *MockDBInterface) LocalUserGetByID(ctx context.Context, id string) (*da
 	return r0, r1
 }
 
-// MockDBInterface_LocalUserGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserGetByID'
-type MockDBInterface_LocalUserGetByID_Call struct {
+// MockDBInterface_WalletGrantListByWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletGrantListByWallet'
+type MockDBInterface_WalletGrantListByWallet_Call struct {
 	*mock.Call
 }
 
-// LocalUserGetByID is a helper method to define mock.On call
+// WalletGrantListByWallet is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockDBInterface_Expecter) LocalUserGetByID(ctx interface{}, id interface{}) *MockDBInterface_LocalUserGetByID_Call {
-	return &MockDBInterface_LocalUserGetByID_Call{Call: _e.mock.On("LocalUserGetByID", ctx, id)}
+//   - walletID string
+func (_e *MockDBInterface_Expecter) WalletGrantListByWallet(ctx interface{}, walletID interface{}) *MockDBInterface_WalletGrantListByWallet_Call {
+	return &MockDBInterface_WalletGrantListByWallet_Call{Call: _e.mock.On("WalletGrantListByWallet", ctx, walletID)}
 }
 
-func (_c *MockDBInterface_LocalUserGetByID_Call) Run(run func(ctx context.Context, id string)) *MockDBInterface_LocalUserGetByID_Call {
+func (_c *MockDBInterface_WalletGrantListByWallet_Call) Run(run func(ctx context.Context, walletID string)) *MockDBInterface_WalletGrantListByWallet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_LocalUserGetByID_Call) Return(_a0 *dao.LocalUser, _a1 error) *MockDBInterface_LocalUserGetByID_Call {
+func (_c *MockDBInterface_WalletGrantListByWallet_Call) Return(_a0 []*dao.WalletGrant, _a1 error) *MockDBInterface_WalletGrantListByWallet_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDBInterface_LocalUserGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.LocalUser, error)) *MockDBInterface_LocalUserGetByID_Call {
+func (_c *MockDBInterface_WalletGrantListByWallet_Call) RunAndReturn(run func(context.Context, string) ([]*dao.WalletGrant, error)) *MockDBInterface_WalletGrantListByWallet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LocalUserInsert provides a mock function with given fields: ctx, arg
-func (_m *MockDBInterface) LocalUserInsert(ctx context.Context, arg *dao.LocalUserInsertParams) error {
+// WalletGrantUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) WalletGrantUpsert(ctx context.Context, arg *dao.WalletGrantUpsertParams) error {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LocalUserInsert")
+		panic("no return value specified for WalletGrantUpsert")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dao.LocalUserInsertParams) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.WalletGrantUpsertParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
@@ -591,104 +6022,93 @@ func (_m *MockDBInterface) LocalUserInsert(ctx context.Context, arg *dao.LocalUs
 	return r0
 }
 
-// MockDBInterface_LocalUserInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserInsert'
-type MockDBInterface_LocalUserInsert_Call struct {
+// MockDBInterface_WalletGrantUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletGrantUpsert'
+type MockDBInterface_WalletGrantUpsert_Call struct {
 	*mock.Call
 }
 
-// LocalUserInsert is a helper method to define mock.On call
+// WalletGrantUpsert is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg *dao.LocalUserInsertParams
-func (_e *MockDBInterface_Expecter) LocalUserInsert(ctx interface{}, arg interface{}) *MockDBInterface_LocalUserInsert_Call {
-	return &MockDBInterface_LocalUserInsert_Call{Call: _e.mock.On("LocalUserInsert", ctx, arg)}
+//   - arg *dao.WalletGrantUpsertParams
+func (_e *MockDBInterface_Expecter) WalletGrantUpsert(ctx interface{}, arg interface{}) *MockDBInterface_WalletGrantUpsert_Call {
+	return &MockDBInterface_WalletGrantUpsert_Call{Call: _e.mock.On("WalletGrantUpsert", ctx, arg)}
 }
 
-func (_c *MockDBInterface_LocalUserInsert_Call) Run(run func(ctx context.Context, arg *dao.LocalUserInsertParams)) *MockDBInterface_LocalUserInsert_Call {
+func (_c *MockDBInterface_WalletGrantUpsert_Call) Run(run func(ctx context.Context, arg *dao.WalletGrantUpsertParams)) *MockDBInterface_WalletGrantUpsert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*dao.LocalUserInsertParams))
+		run(args[0].(context.Context), args[1].(*dao.WalletGrantUpsertParams))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_LocalUserInsert_Call) Return(_a0 error) *MockDBInterface_LocalUserInsert_Call {
+func (_c *MockDBInterface_WalletGrantUpsert_Call) Return(_a0 error) *MockDBInterface_WalletGrantUpsert_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDBInterface_LocalUserInsert_Call) RunAndReturn(run func(context.Context, *dao.LocalUserInsertParams) error) *MockDBInterface_LocalUserInsert_Call {
+func (_c *MockDBInterface_WalletGrantUpsert_Call) RunAndReturn(run func(context.Context, *dao.WalletGrantUpsertParams) error) *MockDBInterface_WalletGrantUpsert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LocalUserList provides a mock function with given fields: ctx
-func (_m *MockDBInterface) LocalUserList(ctx context.Context) ([]*dao.LocalUser, error) {
-	ret := _m.Called(ctx)
+// WalletInsert provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) WalletInsert(ctx context.Context, arg *dao.WalletInsertParams) error {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LocalUserList")
-	}
-
-	var r0 []*dao.LocalUser
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*dao.LocalUser, error)); ok {
-		return rf(ctx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*dao.LocalUser); ok {
-		r0 = rf(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.LocalUser)
-		}
+		panic("no return value specified for WalletInsert")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.WalletInsertParams) error); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDBInterface_LocalUserList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserList'
-type MockDBInterface_LocalUserList_Call struct {
+// MockDBInterface_WalletInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletInsert'
+type MockDBInterface_WalletInsert_Call struct {
 	*mock.Call
 }
 
-// LocalUserList is a helper method to define mock.On call
+// WalletInsert is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDBInterface_Expecter) LocalUserList(ctx interface{}) *MockDBInterface_LocalUserList_Call {
-	return &MockDBInterface_LocalUserList_Call{Call: _e.mock.On("LocalUserList", ctx)}
+//   - arg *dao.WalletInsertParams
+func (_e *MockDBInterface_Expecter) WalletInsert(ctx interface{}, arg interface{}) *MockDBInterface_WalletInsert_Call {
+	return &MockDBInterface_WalletInsert_Call{Call: _e.mock.On("WalletInsert", ctx, arg)}
 }
 
-func (_c *MockDBInterface_LocalUserList_Call) Run(run func(ctx context.Context)) *MockDBInterface_LocalUserList_Call {
+func (_c *MockDBInterface_WalletInsert_Call) Run(run func(ctx context.Context, arg *dao.WalletInsertParams)) *MockDBInterface_WalletInsert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*dao.WalletInsertParams))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_LocalUserList_Call) Return(_a0 []*dao.LocalUser, _a1 error) *MockDBInterface_LocalUserList_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDBInterface_WalletInsert_Call) Return(_a0 error) *MockDBInterface_WalletInsert_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDBInterface_LocalUserList_Call) RunAndReturn(run func(context.Context) ([]*dao.LocalUser, error)) *MockDBInterface_LocalUserList_Call {
+func (_c *MockDBInterface_WalletInsert_Call) RunAndReturn(run func(context.Context, *dao.WalletInsertParams) error) *MockDBInterface_WalletInsert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LocalUserSetPass provides a mock function with given fields: ctx, pwdhash, email
-func (_m *MockDBInterface) LocalUserSetPass(ctx context.Context, pwdhash string, email string) error {
-	ret := _m.Called(ctx, pwdhash, email)
+// WalletInsertKind provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) WalletInsertKind(ctx context.Context, arg *dao.WalletInsertKindParams) error {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LocalUserSetPass")
+		panic("no return value specified for WalletInsertKind")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, pwdhash, email)
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.WalletInsertKindParams) error); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -696,188 +6116,224 @@ func (_m *MockDBInterface) LocalUserSetPass(ctx context.Context, pwdhash string,
 	return r0
 }
 
-// MockDBInterface_LocalUserSetPass_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserSetPass'
-type MockDBInterface_LocalUserSetPass_Call struct {
+// MockDBInterface_WalletInsertKind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletInsertKind'
+type MockDBInterface_WalletInsertKind_Call struct {
 	*mock.Call
 }
 
-// LocalUserSetPass is a helper method to define mock.On call
+// WalletInsertKind is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pwdhash string
-//   - email string
-func (_e *MockDBInterface_Expecter) LocalUserSetPass(ctx interface{}, pwdhash interface{}, email interface{}) *MockDBInterface_LocalUserSetPass_Call {
-	return &MockDBInterface_LocalUserSetPass_Call{Call: _e.mock.On("LocalUserSetPass", ctx, pwdhash, email)}
+//   - arg *dao.WalletInsertKindParams
+func (_e *MockDBInterface_Expecter) WalletInsertKind(ctx interface{}, arg interface{}) *MockDBInterface_WalletInsertKind_Call {
+	return &MockDBInterface_WalletInsertKind_Call{Call: _e.mock.On("WalletInsertKind", ctx, arg)}
 }
 
-func (_c *MockDBInterface_LocalUserSetPass_Call) Run(run func(ctx context.Context, pwdhash string, email string)) *MockDBInterface_LocalUserSetPass_Call {
+func (_c *MockDBInterface_WalletInsertKind_Call) Run(run func(ctx context.Context, arg *dao.WalletInsertKindParams)) *MockDBInterface_WalletInsertKind_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(*dao.WalletInsertKindParams))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_LocalUserSetPass_Call) Return(_a0 error) *MockDBInterface_LocalUserSetPass_Call {
+func (_c *MockDBInterface_WalletInsertKind_Call) Return(_a0 error) *MockDBInterface_WalletInsertKind_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDBInterface_LocalUserSetPass_Call) RunAndReturn(run func(context.Context, string, string) error) *MockDBInterface_LocalUserSetPass_Call {
+func (_c *MockDBInterface_WalletInsertKind_Call) RunAndReturn(run func(context.Context, *dao.WalletInsertKindParams) error) *MockDBInterface_WalletInsertKind_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LocalUserUpdate provides a mock function with given fields: ctx, roles, email
-func (_m *MockDBInterface) LocalUserUpdate(ctx context.Context, roles string, email string) error {
-	ret := _m.Called(ctx, roles, email)
+// WalletList provides a mock function with given fields: ctx, userID, page
+func (_m *MockDBInterface) WalletList(ctx context.Context, userID sql.NullString, page *dao.Page) ([]*dao.Wallet, error) {
+	ret := _m.Called(ctx, userID, page)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LocalUserUpdate")
+		panic("no return value specified for WalletList")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, roles, email)
+	var r0 []*dao.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString, *dao.Page) ([]*dao.Wallet, error)); ok {
+		return rf(ctx, userID, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString, *dao.Page) []*dao.Wallet); ok {
+		r0 = rf(ctx, userID, page)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Wallet)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullString, *dao.Page) error); ok {
+		r1 = rf(ctx, userID, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDBInterface_LocalUserUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserUpdate'
-type MockDBInterface_LocalUserUpdate_Call struct {
+// MockDBInterface_WalletList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletList'
+type MockDBInterface_WalletList_Call struct {
 	*mock.Call
 }
 
-// LocalUserUpdate is a helper method to define mock.On call
+// WalletList is a helper method to define mock.On call
 //   - ctx context.Context
-//   - roles string
-//   - email string
-func (_e *MockDBInterface_Expecter) LocalUserUpdate(ctx interface{}, roles interface{}, email interface{}) *MockDBInterface_LocalUserUpdate_Call {
-	return &MockDBInterface_LocalUserUpdate_Call{Call: _e.mock.On("LocalUserUpdate", ctx, roles, email)}
+//   - userID sql.NullString
+//   - page *dao.Page
+func (_e *MockDBInterface_Expecter) WalletList(ctx interface{}, userID interface{}, page interface{}) *MockDBInterface_WalletList_Call {
+	return &MockDBInterface_WalletList_Call{Call: _e.mock.On("WalletList", ctx, userID, page)}
 }
 
-func (_c *MockDBInterface_LocalUserUpdate_Call) Run(run func(ctx context.Context, roles string, email string)) *MockDBInterface_LocalUserUpdate_Call {
+func (_c *MockDBInterface_WalletList_Call) Run(run func(ctx context.Context, userID sql.NullString, page *dao.Page)) *MockDBInterface_WalletList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(sql.NullString), args[2].(*dao.Page))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_LocalUserUpdate_Call) Return(_a0 error) *MockDBInterface_LocalUserUpdate_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDBInterface_WalletList_Call) Return(_a0 []*dao.Wallet, _a1 error) *MockDBInterface_WalletList_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDBInterface_LocalUserUpdate_Call) RunAndReturn(run func(context.Context, string, string) error) *MockDBInterface_LocalUserUpdate_Call {
+func (_c *MockDBInterface_WalletList_Call) RunAndReturn(run func(context.Context, sql.NullString, *dao.Page) ([]*dao.Wallet, error)) *MockDBInterface_WalletList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Ping provides a mock function with given fields: ctx
-func (_m *MockDBInterface) Ping(ctx context.Context) error {
-	ret := _m.Called(ctx)
+// WalletPage provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) WalletPage(ctx context.Context, arg *dao.WalletPageParams) ([]*dao.Wallet, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Ping")
+		panic("no return value specified for WalletPage")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = rf(ctx)
+	var r0 []*dao.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.WalletPageParams) ([]*dao.Wallet, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.WalletPageParams) []*dao.Wallet); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Wallet)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.WalletPageParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDBInterface_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
-type MockDBInterface_Ping_Call struct {
+// MockDBInterface_WalletPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletPage'
+type MockDBInterface_WalletPage_Call struct {
 	*mock.Call
 }
 
-// Ping is a helper method to define mock.On call
+// WalletPage is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDBInterface_Expecter) Ping(ctx interface{}) *MockDBInterface_Ping_Call {
-	return &MockDBInterface_Ping_Call{Call: _e.mock.On("Ping", ctx)}
+//   - arg *dao.WalletPageParams
+func (_e *MockDBInterface_Expecter) WalletPage(ctx interface{}, arg interface{}) *MockDBInterface_WalletPage_Call {
+	return &MockDBInterface_WalletPage_Call{Call: _e.mock.On("WalletPage", ctx, arg)}
 }
 
-func (_c *MockDBInterface_Ping_Call) Run(run func(ctx context.Context)) *MockDBInterface_Ping_Call {
+func (_c *MockDBInterface_WalletPage_Call) Run(run func(ctx context.Context, arg *dao.WalletPageParams)) *MockDBInterface_WalletPage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*dao.WalletPageParams))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_Ping_Call) Return(_a0 error) *MockDBInterface_Ping_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDBInterface_WalletPage_Call) Return(_a0 []*dao.Wallet, _a1 error) *MockDBInterface_WalletPage_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDBInterface_Ping_Call) RunAndReturn(run func(context.Context) error) *MockDBInterface_Ping_Call {
+func (_c *MockDBInterface_WalletPage_Call) RunAndReturn(run func(context.Context, *dao.WalletPageParams) ([]*dao.Wallet, error)) *MockDBInterface_WalletPage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// WalletInsert provides a mock function with given fields: ctx, arg
-func (_m *MockDBInterface) WalletInsert(ctx context.Context, arg *dao.WalletInsertParams) error {
+// WalletPageFromEnd provides a mock function with given fields: ctx, arg
+func (_m *MockDBInterface) WalletPageFromEnd(ctx context.Context, arg *dao.WalletPageFromEndParams) ([]*dao.Wallet, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for WalletInsert")
+		panic("no return value specified for WalletPageFromEnd")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dao.WalletInsertParams) error); ok {
+	var r0 []*dao.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.WalletPageFromEndParams) ([]*dao.Wallet, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.WalletPageFromEndParams) []*dao.Wallet); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Wallet)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.WalletPageFromEndParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDBInterface_WalletInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletInsert'
-type MockDBInterface_WalletInsert_Call struct {
+// MockDBInterface_WalletPageFromEnd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletPageFromEnd'
+type MockDBInterface_WalletPageFromEnd_Call struct {
 	*mock.Call
 }
 
-// WalletInsert is a helper method to define mock.On call
+// WalletPageFromEnd is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg *dao.WalletInsertParams
-func (_e *MockDBInterface_Expecter) WalletInsert(ctx interface{}, arg interface{}) *MockDBInterface_WalletInsert_Call {
-	return &MockDBInterface_WalletInsert_Call{Call: _e.mock.On("WalletInsert", ctx, arg)}
+//   - arg *dao.WalletPageFromEndParams
+func (_e *MockDBInterface_Expecter) WalletPageFromEnd(ctx interface{}, arg interface{}) *MockDBInterface_WalletPageFromEnd_Call {
+	return &MockDBInterface_WalletPageFromEnd_Call{Call: _e.mock.On("WalletPageFromEnd", ctx, arg)}
 }
 
-func (_c *MockDBInterface_WalletInsert_Call) Run(run func(ctx context.Context, arg *dao.WalletInsertParams)) *MockDBInterface_WalletInsert_Call {
+func (_c *MockDBInterface_WalletPageFromEnd_Call) Run(run func(ctx context.Context, arg *dao.WalletPageFromEndParams)) *MockDBInterface_WalletPageFromEnd_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*dao.WalletInsertParams))
+		run(args[0].(context.Context), args[1].(*dao.WalletPageFromEndParams))
 	})
 	return _c
 }
 
-func (_c *MockDBInterface_WalletInsert_Call) Return(_a0 error) *MockDBInterface_WalletInsert_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDBInterface_WalletPageFromEnd_Call) Return(_a0 []*dao.Wallet, _a1 error) *MockDBInterface_WalletPageFromEnd_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDBInterface_WalletInsert_Call) RunAndReturn(run func(context.Context, *dao.WalletInsertParams) error) *MockDBInterface_WalletInsert_Call {
+func (_c *MockDBInterface_WalletPageFromEnd_Call) RunAndReturn(run func(context.Context, *dao.WalletPageFromEndParams) ([]*dao.Wallet, error)) *MockDBInterface_WalletPageFromEnd_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// WalletUpdateBalance provides a mock function with given fields: ctx, balance, iD
-func (_m *MockDBInterface) WalletUpdateBalance(ctx context.Context, balance float64, iD string) error {
-	ret := _m.Called(ctx, balance, iD)
+// WalletUpdateBalance provides a mock function with given fields: ctx, delta, iD
+func (_m *MockDBInterface) WalletUpdateBalance(ctx context.Context, delta money.Decimal, iD string) error {
+	ret := _m.Called(ctx, delta, iD)
 
 	if len(ret) == 0 {
 		panic("no return value specified for WalletUpdateBalance")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, float64, string) error); ok {
-		r0 = rf(ctx, balance, iD)
+	if rf, ok := ret.Get(0).(func(context.Context, money.Decimal, string) error); ok {
+		r0 = rf(ctx, delta, iD)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -892,15 +6348,15 @@ type MockDBInterface_WalletUpdateBalance_Call struct {
 
 // WalletUpdateBalance is a helper method to define mock.On call
 //   - ctx context.Context
-//   - balance float64
+//   - delta money.Decimal
 //   - iD string
-func (_e *MockDBInterface_Expecter) WalletUpdateBalance(ctx interface{}, balance interface{}, iD interface{}) *MockDBInterface_WalletUpdateBalance_Call {
-	return &MockDBInterface_WalletUpdateBalance_Call{Call: _e.mock.On("WalletUpdateBalance", ctx, balance, iD)}
+func (_e *MockDBInterface_Expecter) WalletUpdateBalance(ctx interface{}, delta interface{}, iD interface{}) *MockDBInterface_WalletUpdateBalance_Call {
+	return &MockDBInterface_WalletUpdateBalance_Call{Call: _e.mock.On("WalletUpdateBalance", ctx, delta, iD)}
 }
 
-func (_c *MockDBInterface_WalletUpdateBalance_Call) Run(run func(ctx context.Context, balance float64, iD string)) *MockDBInterface_WalletUpdateBalance_Call {
+func (_c *MockDBInterface_WalletUpdateBalance_Call) Run(run func(ctx context.Context, delta money.Decimal, iD string)) *MockDBInterface_WalletUpdateBalance_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(float64), args[2].(string))
+		run(args[0].(context.Context), args[1].(money.Decimal), args[2].(string))
 	})
 	return _c
 }
@@ -910,7 +6366,7 @@ func (_c *MockDBInterface_WalletUpdateBalance_Call) Return(_a0 error) *MockDBInt
 	return _c
 }
 
-func (_c *MockDBInterface_WalletUpdateBalance_Call) RunAndReturn(run func(context.Context, float64, string) error) *MockDBInterface_WalletUpdateBalance_Call {
+func (_c *MockDBInterface_WalletUpdateBalance_Call) RunAndReturn(run func(context.Context, money.Decimal, string) error) *MockDBInterface_WalletUpdateBalance_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -7,6 +7,12 @@ import (
 
 	dao "github.com/piotrekmonko/portfello/pkg/dao"
 	mock "github.com/stretchr/testify/mock"
+
+	money "github.com/piotrekmonko/portfello/pkg/money"
+
+	sql "database/sql"
+
+	time "time"
 )
 
 // MockQuerier is an autogenerated mock type for the Querier type
@@ -22,17 +28,17 @@ func (_m *MockQuerier) EXPECT() *MockQuerier_Expecter {
 	return &MockQuerier_Expecter{mock: &_m.Mock}
 }
 
-// ExpenseInsert provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) ExpenseInsert(ctx context.Context, arg *dao.ExpenseInsertParams) error {
-	ret := _m.Called(ctx, arg)
+// BalanceSnapshotDeleteAfter provides a mock function with given fields: ctx, walletID, createdAt
+func (_m *MockQuerier) BalanceSnapshotDeleteAfter(ctx context.Context, walletID string, createdAt time.Time) error {
+	ret := _m.Called(ctx, walletID, createdAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExpenseInsert")
+		panic("no return value specified for BalanceSnapshotDeleteAfter")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExpenseInsertParams) error); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, walletID, createdAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -40,58 +46,59 @@ func (_m *MockQuerier) ExpenseInsert(ctx context.Context, arg *dao.ExpenseInsert
 	return r0
 }
 
-// MockQuerier_ExpenseInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseInsert'
-type MockQuerier_ExpenseInsert_Call struct {
+// MockQuerier_BalanceSnapshotDeleteAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BalanceSnapshotDeleteAfter'
+type MockQuerier_BalanceSnapshotDeleteAfter_Call struct {
 	*mock.Call
 }
 
-// ExpenseInsert is a helper method to define mock.On call
+// BalanceSnapshotDeleteAfter is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg *dao.ExpenseInsertParams
-func (_e *MockQuerier_Expecter) ExpenseInsert(ctx interface{}, arg interface{}) *MockQuerier_ExpenseInsert_Call {
-	return &MockQuerier_ExpenseInsert_Call{Call: _e.mock.On("ExpenseInsert", ctx, arg)}
+//   - walletID string
+//   - createdAt time.Time
+func (_e *MockQuerier_Expecter) BalanceSnapshotDeleteAfter(ctx interface{}, walletID interface{}, createdAt interface{}) *MockQuerier_BalanceSnapshotDeleteAfter_Call {
+	return &MockQuerier_BalanceSnapshotDeleteAfter_Call{Call: _e.mock.On("BalanceSnapshotDeleteAfter", ctx, walletID, createdAt)}
 }
 
-func (_c *MockQuerier_ExpenseInsert_Call) Run(run func(ctx context.Context, arg *dao.ExpenseInsertParams)) *MockQuerier_ExpenseInsert_Call {
+func (_c *MockQuerier_BalanceSnapshotDeleteAfter_Call) Run(run func(ctx context.Context, walletID string, createdAt time.Time)) *MockQuerier_BalanceSnapshotDeleteAfter_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*dao.ExpenseInsertParams))
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_ExpenseInsert_Call) Return(_a0 error) *MockQuerier_ExpenseInsert_Call {
+func (_c *MockQuerier_BalanceSnapshotDeleteAfter_Call) Return(_a0 error) *MockQuerier_BalanceSnapshotDeleteAfter_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_ExpenseInsert_Call) RunAndReturn(run func(context.Context, *dao.ExpenseInsertParams) error) *MockQuerier_ExpenseInsert_Call {
+func (_c *MockQuerier_BalanceSnapshotDeleteAfter_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *MockQuerier_BalanceSnapshotDeleteAfter_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExpenseListByWallet provides a mock function with given fields: ctx, walletID
-func (_m *MockQuerier) ExpenseListByWallet(ctx context.Context, walletID string) ([]*dao.Expense, error) {
-	ret := _m.Called(ctx, walletID)
+// BalanceSnapshotLatest provides a mock function with given fields: ctx, walletID, takenAt
+func (_m *MockQuerier) BalanceSnapshotLatest(ctx context.Context, walletID string, takenAt time.Time) (*dao.BalanceSnapshot, error) {
+	ret := _m.Called(ctx, walletID, takenAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExpenseListByWallet")
+		panic("no return value specified for BalanceSnapshotLatest")
 	}
 
-	var r0 []*dao.Expense
+	var r0 *dao.BalanceSnapshot
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Expense, error)); ok {
-		return rf(ctx, walletID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (*dao.BalanceSnapshot, error)); ok {
+		return rf(ctx, walletID, takenAt)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Expense); ok {
-		r0 = rf(ctx, walletID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) *dao.BalanceSnapshot); ok {
+		r0 = rf(ctx, walletID, takenAt)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.Expense)
+			r0 = ret.Get(0).(*dao.BalanceSnapshot)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, walletID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, walletID, takenAt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -99,106 +106,96 @@ func (_m *MockQuerier) ExpenseListByWallet(ctx context.Context, walletID string)
 	return r0, r1
 }
 
-// MockQuerier_ExpenseListByWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseListByWallet'
-type MockQuerier_ExpenseListByWallet_Call struct {
+// MockQuerier_BalanceSnapshotLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BalanceSnapshotLatest'
+type MockQuerier_BalanceSnapshotLatest_Call struct {
 	*mock.Call
 }
 
-// ExpenseListByWallet is a helper method to define mock.On call
+// BalanceSnapshotLatest is a helper method to define mock.On call
 //   - ctx context.Context
 //   - walletID string
-func (_e *MockQuerier_Expecter) ExpenseListByWallet(ctx interface{}, walletID interface{}) *MockQuerier_ExpenseListByWallet_Call {
-	return &MockQuerier_ExpenseListByWallet_Call{Call: _e.mock.On("ExpenseListByWallet", ctx, walletID)}
+//   - takenAt time.Time
+func (_e *MockQuerier_Expecter) BalanceSnapshotLatest(ctx interface{}, walletID interface{}, takenAt interface{}) *MockQuerier_BalanceSnapshotLatest_Call {
+	return &MockQuerier_BalanceSnapshotLatest_Call{Call: _e.mock.On("BalanceSnapshotLatest", ctx, walletID, takenAt)}
 }
 
-func (_c *MockQuerier_ExpenseListByWallet_Call) Run(run func(ctx context.Context, walletID string)) *MockQuerier_ExpenseListByWallet_Call {
+func (_c *MockQuerier_BalanceSnapshotLatest_Call) Run(run func(ctx context.Context, walletID string, takenAt time.Time)) *MockQuerier_BalanceSnapshotLatest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_ExpenseListByWallet_Call) Return(_a0 []*dao.Expense, _a1 error) *MockQuerier_ExpenseListByWallet_Call {
+func (_c *MockQuerier_BalanceSnapshotLatest_Call) Return(_a0 *dao.BalanceSnapshot, _a1 error) *MockQuerier_BalanceSnapshotLatest_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_ExpenseListByWallet_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Expense, error)) *MockQuerier_ExpenseListByWallet_Call {
+func (_c *MockQuerier_BalanceSnapshotLatest_Call) RunAndReturn(run func(context.Context, string, time.Time) (*dao.BalanceSnapshot, error)) *MockQuerier_BalanceSnapshotLatest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExpenseListByWalletByUser provides a mock function with given fields: ctx, walletID, userID
-func (_m *MockQuerier) ExpenseListByWalletByUser(ctx context.Context, walletID string, userID string) ([]*dao.Expense, error) {
-	ret := _m.Called(ctx, walletID, userID)
+// BalanceSnapshotUpsert provides a mock function with given fields: ctx, walletID, takenAt, balance
+func (_m *MockQuerier) BalanceSnapshotUpsert(ctx context.Context, walletID string, takenAt time.Time, balance money.Decimal) error {
+	ret := _m.Called(ctx, walletID, takenAt, balance)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExpenseListByWalletByUser")
-	}
-
-	var r0 []*dao.Expense
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*dao.Expense, error)); ok {
-		return rf(ctx, walletID, userID)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*dao.Expense); ok {
-		r0 = rf(ctx, walletID, userID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.Expense)
-		}
+		panic("no return value specified for BalanceSnapshotUpsert")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, walletID, userID)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, money.Decimal) error); ok {
+		r0 = rf(ctx, walletID, takenAt, balance)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockQuerier_ExpenseListByWalletByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseListByWalletByUser'
-type MockQuerier_ExpenseListByWalletByUser_Call struct {
+// MockQuerier_BalanceSnapshotUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BalanceSnapshotUpsert'
+type MockQuerier_BalanceSnapshotUpsert_Call struct {
 	*mock.Call
 }
 
-// ExpenseListByWalletByUser is a helper method to define mock.On call
+// BalanceSnapshotUpsert is a helper method to define mock.On call
 //   - ctx context.Context
 //   - walletID string
-//   - userID string
-func (_e *MockQuerier_Expecter) ExpenseListByWalletByUser(ctx interface{}, walletID interface{}, userID interface{}) *MockQuerier_ExpenseListByWalletByUser_Call {
-	return &MockQuerier_ExpenseListByWalletByUser_Call{Call: _e.mock.On("ExpenseListByWalletByUser", ctx, walletID, userID)}
+//   - takenAt time.Time
+//   - balance money.Decimal
+func (_e *MockQuerier_Expecter) BalanceSnapshotUpsert(ctx interface{}, walletID interface{}, takenAt interface{}, balance interface{}) *MockQuerier_BalanceSnapshotUpsert_Call {
+	return &MockQuerier_BalanceSnapshotUpsert_Call{Call: _e.mock.On("BalanceSnapshotUpsert", ctx, walletID, takenAt, balance)}
 }
 
-func (_c *MockQuerier_ExpenseListByWalletByUser_Call) Run(run func(ctx context.Context, walletID string, userID string)) *MockQuerier_ExpenseListByWalletByUser_Call {
+func (_c *MockQuerier_BalanceSnapshotUpsert_Call) Run(run func(ctx context.Context, walletID string, takenAt time.Time, balance money.Decimal)) *MockQuerier_BalanceSnapshotUpsert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(money.Decimal))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_ExpenseListByWalletByUser_Call) Return(_a0 []*dao.Expense, _a1 error) *MockQuerier_ExpenseListByWalletByUser_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockQuerier_BalanceSnapshotUpsert_Call) Return(_a0 error) *MockQuerier_BalanceSnapshotUpsert_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_ExpenseListByWalletByUser_Call) RunAndReturn(run func(context.Context, string, string) ([]*dao.Expense, error)) *MockQuerier_ExpenseListByWalletByUser_Call {
+func (_c *MockQuerier_BalanceSnapshotUpsert_Call) RunAndReturn(run func(context.Context, string, time.Time, money.Decimal) error) *MockQuerier_BalanceSnapshotUpsert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// HistoryInsert provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) HistoryInsert(ctx context.Context, arg *dao.HistoryInsertParams) error {
-	ret := _m.Called(ctx, arg)
+// BudgetDelete provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) BudgetDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for HistoryInsert")
+		panic("no return value specified for BudgetDelete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryInsertParams) error); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -206,170 +203,100 @@ func (_m *MockQuerier) HistoryInsert(ctx context.Context, arg *dao.HistoryInsert
 	return r0
 }
 
-// MockQuerier_HistoryInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryInsert'
-type MockQuerier_HistoryInsert_Call struct {
+// MockQuerier_BudgetDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BudgetDelete'
+type MockQuerier_BudgetDelete_Call struct {
 	*mock.Call
 }
 
-// HistoryInsert is a helper method to define mock.On call
+// BudgetDelete is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg *dao.HistoryInsertParams
-func (_e *MockQuerier_Expecter) HistoryInsert(ctx interface{}, arg interface{}) *MockQuerier_HistoryInsert_Call {
-	return &MockQuerier_HistoryInsert_Call{Call: _e.mock.On("HistoryInsert", ctx, arg)}
+//   - id string
+func (_e *MockQuerier_Expecter) BudgetDelete(ctx interface{}, id interface{}) *MockQuerier_BudgetDelete_Call {
+	return &MockQuerier_BudgetDelete_Call{Call: _e.mock.On("BudgetDelete", ctx, id)}
 }
 
-func (_c *MockQuerier_HistoryInsert_Call) Run(run func(ctx context.Context, arg *dao.HistoryInsertParams)) *MockQuerier_HistoryInsert_Call {
+func (_c *MockQuerier_BudgetDelete_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_BudgetDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*dao.HistoryInsertParams))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_HistoryInsert_Call) Return(_a0 error) *MockQuerier_HistoryInsert_Call {
+func (_c *MockQuerier_BudgetDelete_Call) Return(_a0 error) *MockQuerier_BudgetDelete_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_HistoryInsert_Call) RunAndReturn(run func(context.Context, *dao.HistoryInsertParams) error) *MockQuerier_HistoryInsert_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// HistoryList provides a mock function with given fields: ctx
-func (_m *MockQuerier) HistoryList(ctx context.Context) ([]*dao.History, error) {
-	ret := _m.Called(ctx)
-
-	if len(ret) == 0 {
-		panic("no return value specified for HistoryList")
-	}
-
-	var r0 []*dao.History
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*dao.History, error)); ok {
-		return rf(ctx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*dao.History); ok {
-		r0 = rf(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.History)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// MockQuerier_HistoryList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryList'
-type MockQuerier_HistoryList_Call struct {
-	*mock.Call
-}
-
-// HistoryList is a helper method to define mock.On call
-//   - ctx context.Context
-func (_e *MockQuerier_Expecter) HistoryList(ctx interface{}) *MockQuerier_HistoryList_Call {
-	return &MockQuerier_HistoryList_Call{Call: _e.mock.On("HistoryList", ctx)}
-}
-
-func (_c *MockQuerier_HistoryList_Call) Run(run func(ctx context.Context)) *MockQuerier_HistoryList_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
-	})
-	return _c
-}
-
-func (_c *MockQuerier_HistoryList_Call) Return(_a0 []*dao.History, _a1 error) *MockQuerier_HistoryList_Call {
-	_c.Call.Return(_a0, _a1)
-	return _c
-}
-
-func (_c *MockQuerier_HistoryList_Call) RunAndReturn(run func(context.Context) ([]*dao.History, error)) *MockQuerier_HistoryList_Call {
+func (_c *MockQuerier_BudgetDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockQuerier_BudgetDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LocalUserGetByEmail provides a mock function with given fields: ctx, email
-func (_m *MockQuerier) LocalUserGetByEmail(ctx context.Context, email string) (*dao.LocalUser, error) {
-	ret := _m.Called(ctx, email)
+// BudgetDeleteByCategory provides a mock function with given fields: ctx, categoryID
+func (_m *MockQuerier) BudgetDeleteByCategory(ctx context.Context, categoryID sql.NullString) error {
+	ret := _m.Called(ctx, categoryID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LocalUserGetByEmail")
-	}
-
-	var r0 *dao.LocalUser
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.LocalUser, error)); ok {
-		return rf(ctx, email)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.LocalUser); ok {
-		r0 = rf(ctx, email)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.LocalUser)
-		}
+		panic("no return value specified for BudgetDeleteByCategory")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, email)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString) error); ok {
+		r0 = rf(ctx, categoryID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockQuerier_LocalUserGetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserGetByEmail'
-type MockQuerier_LocalUserGetByEmail_Call struct {
+// MockQuerier_BudgetDeleteByCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BudgetDeleteByCategory'
+type MockQuerier_BudgetDeleteByCategory_Call struct {
 	*mock.Call
 }
 
-// LocalUserGetByEmail is a helper method to define mock.On call
+// BudgetDeleteByCategory is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-func (_e *MockQuerier_Expecter) LocalUserGetByEmail(ctx interface{}, email interface{}) *MockQuerier_LocalUserGetByEmail_Call {
-	return &MockQuerier_LocalUserGetByEmail_Call{Call: _e.mock.On("LocalUserGetByEmail", ctx, email)}
+//   - categoryID sql.NullString
+func (_e *MockQuerier_Expecter) BudgetDeleteByCategory(ctx interface{}, categoryID interface{}) *MockQuerier_BudgetDeleteByCategory_Call {
+	return &MockQuerier_BudgetDeleteByCategory_Call{Call: _e.mock.On("BudgetDeleteByCategory", ctx, categoryID)}
 }
 
-func (_c *MockQuerier_LocalUserGetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockQuerier_LocalUserGetByEmail_Call {
+func (_c *MockQuerier_BudgetDeleteByCategory_Call) Run(run func(ctx context.Context, categoryID sql.NullString)) *MockQuerier_BudgetDeleteByCategory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(sql.NullString))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_LocalUserGetByEmail_Call) Return(_a0 *dao.LocalUser, _a1 error) *MockQuerier_LocalUserGetByEmail_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockQuerier_BudgetDeleteByCategory_Call) Return(_a0 error) *MockQuerier_BudgetDeleteByCategory_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_LocalUserGetByEmail_Call) RunAndReturn(run func(context.Context, string) (*dao.LocalUser, error)) *MockQuerier_LocalUserGetByEmail_Call {
+func (_c *MockQuerier_BudgetDeleteByCategory_Call) RunAndReturn(run func(context.Context, sql.NullString) error) *MockQuerier_BudgetDeleteByCategory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LocalUserGetByID provides a mock function with given fields: ctx, id
-func (_m *MockQuerier) LocalUserGetByID(ctx context.Context, id string) (*dao.LocalUser, error) {
+// BudgetGetByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) BudgetGetByID(ctx context.Context, id string) (*dao.Budget, error) {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LocalUserGetByID")
+		panic("no return value specified for BudgetGetByID")
 	}
 
-	var r0 *dao.LocalUser
+	var r0 *dao.Budget
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.LocalUser, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Budget, error)); ok {
 		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.LocalUser); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Budget); ok {
 		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.LocalUser)
+			r0 = ret.Get(0).(*dao.Budget)
 		}
 	}
 
@@ -382,45 +309,45 @@ func (_m *MockQuerier) LocalUserGetByID(ctx context.Context, id string) (*dao.Lo
 	return r0, r1
 }
 
-// MockQuerier_LocalUserGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserGetByID'
-type MockQuerier_LocalUserGetByID_Call struct {
+// MockQuerier_BudgetGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BudgetGetByID'
+type MockQuerier_BudgetGetByID_Call struct {
 	*mock.Call
 }
 
-// LocalUserGetByID is a helper method to define mock.On call
+// BudgetGetByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockQuerier_Expecter) LocalUserGetByID(ctx interface{}, id interface{}) *MockQuerier_LocalUserGetByID_Call {
-	return &MockQuerier_LocalUserGetByID_Call{Call: _e.mock.On("LocalUserGetByID", ctx, id)}
+func (_e *MockQuerier_Expecter) BudgetGetByID(ctx interface{}, id interface{}) *MockQuerier_BudgetGetByID_Call {
+	return &MockQuerier_BudgetGetByID_Call{Call: _e.mock.On("BudgetGetByID", ctx, id)}
 }
 
-func (_c *MockQuerier_LocalUserGetByID_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_LocalUserGetByID_Call {
+func (_c *MockQuerier_BudgetGetByID_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_BudgetGetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_LocalUserGetByID_Call) Return(_a0 *dao.LocalUser, _a1 error) *MockQuerier_LocalUserGetByID_Call {
+func (_c *MockQuerier_BudgetGetByID_Call) Return(_a0 *dao.Budget, _a1 error) *MockQuerier_BudgetGetByID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_LocalUserGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.LocalUser, error)) *MockQuerier_LocalUserGetByID_Call {
+func (_c *MockQuerier_BudgetGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Budget, error)) *MockQuerier_BudgetGetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LocalUserInsert provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) LocalUserInsert(ctx context.Context, arg *dao.LocalUserInsertParams) error {
+// BudgetInsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) BudgetInsert(ctx context.Context, arg *dao.BudgetInsertParams) error {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LocalUserInsert")
+		panic("no return value specified for BudgetInsert")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dao.LocalUserInsertParams) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.BudgetInsertParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
@@ -429,58 +356,58 @@ func (_m *MockQuerier) LocalUserInsert(ctx context.Context, arg *dao.LocalUserIn
 	return r0
 }
 
-// MockQuerier_LocalUserInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserInsert'
-type MockQuerier_LocalUserInsert_Call struct {
+// MockQuerier_BudgetInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BudgetInsert'
+type MockQuerier_BudgetInsert_Call struct {
 	*mock.Call
 }
 
-// LocalUserInsert is a helper method to define mock.On call
+// BudgetInsert is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg *dao.LocalUserInsertParams
-func (_e *MockQuerier_Expecter) LocalUserInsert(ctx interface{}, arg interface{}) *MockQuerier_LocalUserInsert_Call {
-	return &MockQuerier_LocalUserInsert_Call{Call: _e.mock.On("LocalUserInsert", ctx, arg)}
+//   - arg *dao.BudgetInsertParams
+func (_e *MockQuerier_Expecter) BudgetInsert(ctx interface{}, arg interface{}) *MockQuerier_BudgetInsert_Call {
+	return &MockQuerier_BudgetInsert_Call{Call: _e.mock.On("BudgetInsert", ctx, arg)}
 }
 
-func (_c *MockQuerier_LocalUserInsert_Call) Run(run func(ctx context.Context, arg *dao.LocalUserInsertParams)) *MockQuerier_LocalUserInsert_Call {
+func (_c *MockQuerier_BudgetInsert_Call) Run(run func(ctx context.Context, arg *dao.BudgetInsertParams)) *MockQuerier_BudgetInsert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*dao.LocalUserInsertParams))
+		run(args[0].(context.Context), args[1].(*dao.BudgetInsertParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_LocalUserInsert_Call) Return(_a0 error) *MockQuerier_LocalUserInsert_Call {
+func (_c *MockQuerier_BudgetInsert_Call) Return(_a0 error) *MockQuerier_BudgetInsert_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_LocalUserInsert_Call) RunAndReturn(run func(context.Context, *dao.LocalUserInsertParams) error) *MockQuerier_LocalUserInsert_Call {
+func (_c *MockQuerier_BudgetInsert_Call) RunAndReturn(run func(context.Context, *dao.BudgetInsertParams) error) *MockQuerier_BudgetInsert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LocalUserList provides a mock function with given fields: ctx
-func (_m *MockQuerier) LocalUserList(ctx context.Context) ([]*dao.LocalUser, error) {
-	ret := _m.Called(ctx)
+// BudgetListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) BudgetListByUser(ctx context.Context, userID string) ([]*dao.Budget, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LocalUserList")
+		panic("no return value specified for BudgetListByUser")
 	}
 
-	var r0 []*dao.LocalUser
+	var r0 []*dao.Budget
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*dao.LocalUser, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Budget, error)); ok {
+		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*dao.LocalUser); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Budget); ok {
+		r0 = rf(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.LocalUser)
+			r0 = ret.Get(0).([]*dao.Budget)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -488,45 +415,46 @@ func (_m *MockQuerier) LocalUserList(ctx context.Context) ([]*dao.LocalUser, err
 	return r0, r1
 }
 
-// MockQuerier_LocalUserList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserList'
-type MockQuerier_LocalUserList_Call struct {
+// MockQuerier_BudgetListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BudgetListByUser'
+type MockQuerier_BudgetListByUser_Call struct {
 	*mock.Call
 }
 
-// LocalUserList is a helper method to define mock.On call
+// BudgetListByUser is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockQuerier_Expecter) LocalUserList(ctx interface{}) *MockQuerier_LocalUserList_Call {
-	return &MockQuerier_LocalUserList_Call{Call: _e.mock.On("LocalUserList", ctx)}
+//   - userID string
+func (_e *MockQuerier_Expecter) BudgetListByUser(ctx interface{}, userID interface{}) *MockQuerier_BudgetListByUser_Call {
+	return &MockQuerier_BudgetListByUser_Call{Call: _e.mock.On("BudgetListByUser", ctx, userID)}
 }
 
-func (_c *MockQuerier_LocalUserList_Call) Run(run func(ctx context.Context)) *MockQuerier_LocalUserList_Call {
+func (_c *MockQuerier_BudgetListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockQuerier_BudgetListByUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_LocalUserList_Call) Return(_a0 []*dao.LocalUser, _a1 error) *MockQuerier_LocalUserList_Call {
+func (_c *MockQuerier_BudgetListByUser_Call) Return(_a0 []*dao.Budget, _a1 error) *MockQuerier_BudgetListByUser_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_LocalUserList_Call) RunAndReturn(run func(context.Context) ([]*dao.LocalUser, error)) *MockQuerier_LocalUserList_Call {
+func (_c *MockQuerier_BudgetListByUser_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Budget, error)) *MockQuerier_BudgetListByUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LocalUserSetPass provides a mock function with given fields: ctx, pwdhash, email
-func (_m *MockQuerier) LocalUserSetPass(ctx context.Context, pwdhash string, email string) error {
-	ret := _m.Called(ctx, pwdhash, email)
+// BudgetSetCategory provides a mock function with given fields: ctx, newCategoryID, categoryID
+func (_m *MockQuerier) BudgetSetCategory(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString) error {
+	ret := _m.Called(ctx, newCategoryID, categoryID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LocalUserSetPass")
+		panic("no return value specified for BudgetSetCategory")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, pwdhash, email)
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString, sql.NullString) error); ok {
+		r0 = rf(ctx, newCategoryID, categoryID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -534,47 +462,4735 @@ func (_m *MockQuerier) LocalUserSetPass(ctx context.Context, pwdhash string, ema
 	return r0
 }
 
-// MockQuerier_LocalUserSetPass_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserSetPass'
-type MockQuerier_LocalUserSetPass_Call struct {
+// MockQuerier_BudgetSetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BudgetSetCategory'
+type MockQuerier_BudgetSetCategory_Call struct {
+	*mock.Call
+}
+
+// BudgetSetCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newCategoryID sql.NullString
+//   - categoryID sql.NullString
+func (_e *MockQuerier_Expecter) BudgetSetCategory(ctx interface{}, newCategoryID interface{}, categoryID interface{}) *MockQuerier_BudgetSetCategory_Call {
+	return &MockQuerier_BudgetSetCategory_Call{Call: _e.mock.On("BudgetSetCategory", ctx, newCategoryID, categoryID)}
+}
+
+func (_c *MockQuerier_BudgetSetCategory_Call) Run(run func(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString)) *MockQuerier_BudgetSetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString), args[2].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_BudgetSetCategory_Call) Return(_a0 error) *MockQuerier_BudgetSetCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_BudgetSetCategory_Call) RunAndReturn(run func(context.Context, sql.NullString, sql.NullString) error) *MockQuerier_BudgetSetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BudgetUpdate provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) BudgetUpdate(ctx context.Context, arg *dao.BudgetUpdateParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BudgetUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.BudgetUpdateParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_BudgetUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BudgetUpdate'
+type MockQuerier_BudgetUpdate_Call struct {
+	*mock.Call
+}
+
+// BudgetUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.BudgetUpdateParams
+func (_e *MockQuerier_Expecter) BudgetUpdate(ctx interface{}, arg interface{}) *MockQuerier_BudgetUpdate_Call {
+	return &MockQuerier_BudgetUpdate_Call{Call: _e.mock.On("BudgetUpdate", ctx, arg)}
+}
+
+func (_c *MockQuerier_BudgetUpdate_Call) Run(run func(ctx context.Context, arg *dao.BudgetUpdateParams)) *MockQuerier_BudgetUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.BudgetUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_BudgetUpdate_Call) Return(_a0 error) *MockQuerier_BudgetUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_BudgetUpdate_Call) RunAndReturn(run func(context.Context, *dao.BudgetUpdateParams) error) *MockQuerier_BudgetUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryCountByUser provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) CategoryCountByUser(ctx context.Context, userID string) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryCountByUser")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CategoryCountByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryCountByUser'
+type MockQuerier_CategoryCountByUser_Call struct {
+	*mock.Call
+}
+
+// CategoryCountByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockQuerier_Expecter) CategoryCountByUser(ctx interface{}, userID interface{}) *MockQuerier_CategoryCountByUser_Call {
+	return &MockQuerier_CategoryCountByUser_Call{Call: _e.mock.On("CategoryCountByUser", ctx, userID)}
+}
+
+func (_c *MockQuerier_CategoryCountByUser_Call) Run(run func(ctx context.Context, userID string)) *MockQuerier_CategoryCountByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CategoryCountByUser_Call) Return(_a0 int64, _a1 error) *MockQuerier_CategoryCountByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CategoryCountByUser_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *MockQuerier_CategoryCountByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryDelete provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) CategoryDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CategoryDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryDelete'
+type MockQuerier_CategoryDelete_Call struct {
+	*mock.Call
+}
+
+// CategoryDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) CategoryDelete(ctx interface{}, id interface{}) *MockQuerier_CategoryDelete_Call {
+	return &MockQuerier_CategoryDelete_Call{Call: _e.mock.On("CategoryDelete", ctx, id)}
+}
+
+func (_c *MockQuerier_CategoryDelete_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_CategoryDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CategoryDelete_Call) Return(_a0 error) *MockQuerier_CategoryDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CategoryDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockQuerier_CategoryDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryGetByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) CategoryGetByID(ctx context.Context, id string) (*dao.Category, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryGetByID")
+	}
+
+	var r0 *dao.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Category, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Category); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CategoryGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryGetByID'
+type MockQuerier_CategoryGetByID_Call struct {
+	*mock.Call
+}
+
+// CategoryGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) CategoryGetByID(ctx interface{}, id interface{}) *MockQuerier_CategoryGetByID_Call {
+	return &MockQuerier_CategoryGetByID_Call{Call: _e.mock.On("CategoryGetByID", ctx, id)}
+}
+
+func (_c *MockQuerier_CategoryGetByID_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_CategoryGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CategoryGetByID_Call) Return(_a0 *dao.Category, _a1 error) *MockQuerier_CategoryGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CategoryGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Category, error)) *MockQuerier_CategoryGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryInsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CategoryInsert(ctx context.Context, arg *dao.CategoryInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CategoryInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CategoryInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryInsert'
+type MockQuerier_CategoryInsert_Call struct {
+	*mock.Call
+}
+
+// CategoryInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.CategoryInsertParams
+func (_e *MockQuerier_Expecter) CategoryInsert(ctx interface{}, arg interface{}) *MockQuerier_CategoryInsert_Call {
+	return &MockQuerier_CategoryInsert_Call{Call: _e.mock.On("CategoryInsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_CategoryInsert_Call) Run(run func(ctx context.Context, arg *dao.CategoryInsertParams)) *MockQuerier_CategoryInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.CategoryInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CategoryInsert_Call) Return(_a0 error) *MockQuerier_CategoryInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CategoryInsert_Call) RunAndReturn(run func(context.Context, *dao.CategoryInsertParams) error) *MockQuerier_CategoryInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) CategoryListByUser(ctx context.Context, userID string) ([]*dao.Category, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryListByUser")
+	}
+
+	var r0 []*dao.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Category, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Category); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CategoryListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryListByUser'
+type MockQuerier_CategoryListByUser_Call struct {
+	*mock.Call
+}
+
+// CategoryListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockQuerier_Expecter) CategoryListByUser(ctx interface{}, userID interface{}) *MockQuerier_CategoryListByUser_Call {
+	return &MockQuerier_CategoryListByUser_Call{Call: _e.mock.On("CategoryListByUser", ctx, userID)}
+}
+
+func (_c *MockQuerier_CategoryListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockQuerier_CategoryListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CategoryListByUser_Call) Return(_a0 []*dao.Category, _a1 error) *MockQuerier_CategoryListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CategoryListByUser_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Category, error)) *MockQuerier_CategoryListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryListByWallet provides a mock function with given fields: ctx, walletID
+func (_m *MockQuerier) CategoryListByWallet(ctx context.Context, walletID string) ([]*dao.Category, error) {
+	ret := _m.Called(ctx, walletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryListByWallet")
+	}
+
+	var r0 []*dao.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Category, error)); ok {
+		return rf(ctx, walletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Category); ok {
+		r0 = rf(ctx, walletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, walletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CategoryListByWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryListByWallet'
+type MockQuerier_CategoryListByWallet_Call struct {
+	*mock.Call
+}
+
+// CategoryListByWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+func (_e *MockQuerier_Expecter) CategoryListByWallet(ctx interface{}, walletID interface{}) *MockQuerier_CategoryListByWallet_Call {
+	return &MockQuerier_CategoryListByWallet_Call{Call: _e.mock.On("CategoryListByWallet", ctx, walletID)}
+}
+
+func (_c *MockQuerier_CategoryListByWallet_Call) Run(run func(ctx context.Context, walletID string)) *MockQuerier_CategoryListByWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CategoryListByWallet_Call) Return(_a0 []*dao.Category, _a1 error) *MockQuerier_CategoryListByWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CategoryListByWallet_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Category, error)) *MockQuerier_CategoryListByWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategorySetParent provides a mock function with given fields: ctx, newParentID, parentID
+func (_m *MockQuerier) CategorySetParent(ctx context.Context, newParentID sql.NullString, parentID sql.NullString) error {
+	ret := _m.Called(ctx, newParentID, parentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategorySetParent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString, sql.NullString) error); ok {
+		r0 = rf(ctx, newParentID, parentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CategorySetParent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategorySetParent'
+type MockQuerier_CategorySetParent_Call struct {
+	*mock.Call
+}
+
+// CategorySetParent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newParentID sql.NullString
+//   - parentID sql.NullString
+func (_e *MockQuerier_Expecter) CategorySetParent(ctx interface{}, newParentID interface{}, parentID interface{}) *MockQuerier_CategorySetParent_Call {
+	return &MockQuerier_CategorySetParent_Call{Call: _e.mock.On("CategorySetParent", ctx, newParentID, parentID)}
+}
+
+func (_c *MockQuerier_CategorySetParent_Call) Run(run func(ctx context.Context, newParentID sql.NullString, parentID sql.NullString)) *MockQuerier_CategorySetParent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString), args[2].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CategorySetParent_Call) Return(_a0 error) *MockQuerier_CategorySetParent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CategorySetParent_Call) RunAndReturn(run func(context.Context, sql.NullString, sql.NullString) error) *MockQuerier_CategorySetParent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryUpdate provides a mock function with given fields: ctx, name, parentID, iD
+func (_m *MockQuerier) CategoryUpdate(ctx context.Context, name string, parentID sql.NullString, iD string) error {
+	ret := _m.Called(ctx, name, parentID, iD)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, sql.NullString, string) error); ok {
+		r0 = rf(ctx, name, parentID, iD)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CategoryUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryUpdate'
+type MockQuerier_CategoryUpdate_Call struct {
+	*mock.Call
+}
+
+// CategoryUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - parentID sql.NullString
+//   - iD string
+func (_e *MockQuerier_Expecter) CategoryUpdate(ctx interface{}, name interface{}, parentID interface{}, iD interface{}) *MockQuerier_CategoryUpdate_Call {
+	return &MockQuerier_CategoryUpdate_Call{Call: _e.mock.On("CategoryUpdate", ctx, name, parentID, iD)}
+}
+
+func (_c *MockQuerier_CategoryUpdate_Call) Run(run func(ctx context.Context, name string, parentID sql.NullString, iD string)) *MockQuerier_CategoryUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(sql.NullString), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CategoryUpdate_Call) Return(_a0 error) *MockQuerier_CategoryUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CategoryUpdate_Call) RunAndReturn(run func(context.Context, string, sql.NullString, string) error) *MockQuerier_CategoryUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DebtDelete provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) DebtDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DebtDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DebtDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DebtDelete'
+type MockQuerier_DebtDelete_Call struct {
+	*mock.Call
+}
+
+// DebtDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) DebtDelete(ctx interface{}, id interface{}) *MockQuerier_DebtDelete_Call {
+	return &MockQuerier_DebtDelete_Call{Call: _e.mock.On("DebtDelete", ctx, id)}
+}
+
+func (_c *MockQuerier_DebtDelete_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_DebtDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DebtDelete_Call) Return(_a0 error) *MockQuerier_DebtDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DebtDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockQuerier_DebtDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DebtGetByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) DebtGetByID(ctx context.Context, id string) (*dao.Debt, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DebtGetByID")
+	}
+
+	var r0 *dao.Debt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Debt, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Debt); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Debt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DebtGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DebtGetByID'
+type MockQuerier_DebtGetByID_Call struct {
+	*mock.Call
+}
+
+// DebtGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) DebtGetByID(ctx interface{}, id interface{}) *MockQuerier_DebtGetByID_Call {
+	return &MockQuerier_DebtGetByID_Call{Call: _e.mock.On("DebtGetByID", ctx, id)}
+}
+
+func (_c *MockQuerier_DebtGetByID_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_DebtGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DebtGetByID_Call) Return(_a0 *dao.Debt, _a1 error) *MockQuerier_DebtGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DebtGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Debt, error)) *MockQuerier_DebtGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DebtInsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DebtInsert(ctx context.Context, arg *dao.DebtInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DebtInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.DebtInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DebtInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DebtInsert'
+type MockQuerier_DebtInsert_Call struct {
+	*mock.Call
+}
+
+// DebtInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.DebtInsertParams
+func (_e *MockQuerier_Expecter) DebtInsert(ctx interface{}, arg interface{}) *MockQuerier_DebtInsert_Call {
+	return &MockQuerier_DebtInsert_Call{Call: _e.mock.On("DebtInsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_DebtInsert_Call) Run(run func(ctx context.Context, arg *dao.DebtInsertParams)) *MockQuerier_DebtInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.DebtInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DebtInsert_Call) Return(_a0 error) *MockQuerier_DebtInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DebtInsert_Call) RunAndReturn(run func(context.Context, *dao.DebtInsertParams) error) *MockQuerier_DebtInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DebtListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) DebtListByUser(ctx context.Context, userID string) ([]*dao.Debt, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DebtListByUser")
+	}
+
+	var r0 []*dao.Debt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Debt, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Debt); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Debt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DebtListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DebtListByUser'
+type MockQuerier_DebtListByUser_Call struct {
+	*mock.Call
+}
+
+// DebtListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockQuerier_Expecter) DebtListByUser(ctx interface{}, userID interface{}) *MockQuerier_DebtListByUser_Call {
+	return &MockQuerier_DebtListByUser_Call{Call: _e.mock.On("DebtListByUser", ctx, userID)}
+}
+
+func (_c *MockQuerier_DebtListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockQuerier_DebtListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DebtListByUser_Call) Return(_a0 []*dao.Debt, _a1 error) *MockQuerier_DebtListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DebtListByUser_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Debt, error)) *MockQuerier_DebtListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExchangeRateBases provides a mock function with given fields: ctx, currency
+func (_m *MockQuerier) ExchangeRateBases(ctx context.Context, currency string) ([]string, error) {
+	ret := _m.Called(ctx, currency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExchangeRateBases")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, currency)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, currency)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ExchangeRateBases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeRateBases'
+type MockQuerier_ExchangeRateBases_Call struct {
+	*mock.Call
+}
+
+// ExchangeRateBases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - currency string
+func (_e *MockQuerier_Expecter) ExchangeRateBases(ctx interface{}, currency interface{}) *MockQuerier_ExchangeRateBases_Call {
+	return &MockQuerier_ExchangeRateBases_Call{Call: _e.mock.On("ExchangeRateBases", ctx, currency)}
+}
+
+func (_c *MockQuerier_ExchangeRateBases_Call) Run(run func(ctx context.Context, currency string)) *MockQuerier_ExchangeRateBases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExchangeRateBases_Call) Return(_a0 []string, _a1 error) *MockQuerier_ExchangeRateBases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ExchangeRateBases_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *MockQuerier_ExchangeRateBases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExchangeRateLatest provides a mock function with given fields: ctx, base, currency, validOn
+func (_m *MockQuerier) ExchangeRateLatest(ctx context.Context, base string, currency string, validOn time.Time) (*dao.ExchangeRate, error) {
+	ret := _m.Called(ctx, base, currency, validOn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExchangeRateLatest")
+	}
+
+	var r0 *dao.ExchangeRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (*dao.ExchangeRate, error)); ok {
+		return rf(ctx, base, currency, validOn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) *dao.ExchangeRate); ok {
+		r0 = rf(ctx, base, currency, validOn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ExchangeRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, base, currency, validOn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ExchangeRateLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeRateLatest'
+type MockQuerier_ExchangeRateLatest_Call struct {
+	*mock.Call
+}
+
+// ExchangeRateLatest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - base string
+//   - currency string
+//   - validOn time.Time
+func (_e *MockQuerier_Expecter) ExchangeRateLatest(ctx interface{}, base interface{}, currency interface{}, validOn interface{}) *MockQuerier_ExchangeRateLatest_Call {
+	return &MockQuerier_ExchangeRateLatest_Call{Call: _e.mock.On("ExchangeRateLatest", ctx, base, currency, validOn)}
+}
+
+func (_c *MockQuerier_ExchangeRateLatest_Call) Run(run func(ctx context.Context, base string, currency string, validOn time.Time)) *MockQuerier_ExchangeRateLatest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExchangeRateLatest_Call) Return(_a0 *dao.ExchangeRate, _a1 error) *MockQuerier_ExchangeRateLatest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ExchangeRateLatest_Call) RunAndReturn(run func(context.Context, string, string, time.Time) (*dao.ExchangeRate, error)) *MockQuerier_ExchangeRateLatest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExchangeRateUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ExchangeRateUpsert(ctx context.Context, arg *dao.ExchangeRateUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExchangeRateUpsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExchangeRateUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_ExchangeRateUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeRateUpsert'
+type MockQuerier_ExchangeRateUpsert_Call struct {
+	*mock.Call
+}
+
+// ExchangeRateUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.ExchangeRateUpsertParams
+func (_e *MockQuerier_Expecter) ExchangeRateUpsert(ctx interface{}, arg interface{}) *MockQuerier_ExchangeRateUpsert_Call {
+	return &MockQuerier_ExchangeRateUpsert_Call{Call: _e.mock.On("ExchangeRateUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_ExchangeRateUpsert_Call) Run(run func(ctx context.Context, arg *dao.ExchangeRateUpsertParams)) *MockQuerier_ExchangeRateUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.ExchangeRateUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExchangeRateUpsert_Call) Return(_a0 error) *MockQuerier_ExchangeRateUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ExchangeRateUpsert_Call) RunAndReturn(run func(context.Context, *dao.ExchangeRateUpsertParams) error) *MockQuerier_ExchangeRateUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseClearDebt provides a mock function with given fields: ctx, debtID
+func (_m *MockQuerier) ExpenseClearDebt(ctx context.Context, debtID sql.NullString) error {
+	ret := _m.Called(ctx, debtID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseClearDebt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString) error); ok {
+		r0 = rf(ctx, debtID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_ExpenseClearDebt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseClearDebt'
+type MockQuerier_ExpenseClearDebt_Call struct {
+	*mock.Call
+}
+
+// ExpenseClearDebt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - debtID sql.NullString
+func (_e *MockQuerier_Expecter) ExpenseClearDebt(ctx interface{}, debtID interface{}) *MockQuerier_ExpenseClearDebt_Call {
+	return &MockQuerier_ExpenseClearDebt_Call{Call: _e.mock.On("ExpenseClearDebt", ctx, debtID)}
+}
+
+func (_c *MockQuerier_ExpenseClearDebt_Call) Run(run func(ctx context.Context, debtID sql.NullString)) *MockQuerier_ExpenseClearDebt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseClearDebt_Call) Return(_a0 error) *MockQuerier_ExpenseClearDebt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseClearDebt_Call) RunAndReturn(run func(context.Context, sql.NullString) error) *MockQuerier_ExpenseClearDebt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseDelete provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) ExpenseDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_ExpenseDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseDelete'
+type MockQuerier_ExpenseDelete_Call struct {
+	*mock.Call
+}
+
+// ExpenseDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) ExpenseDelete(ctx interface{}, id interface{}) *MockQuerier_ExpenseDelete_Call {
+	return &MockQuerier_ExpenseDelete_Call{Call: _e.mock.On("ExpenseDelete", ctx, id)}
+}
+
+func (_c *MockQuerier_ExpenseDelete_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_ExpenseDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseDelete_Call) Return(_a0 error) *MockQuerier_ExpenseDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockQuerier_ExpenseDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseExternalIDCount provides a mock function with given fields: ctx, walletID, externalID
+func (_m *MockQuerier) ExpenseExternalIDCount(ctx context.Context, walletID string, externalID sql.NullString) (int64, error) {
+	ret := _m.Called(ctx, walletID, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseExternalIDCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, sql.NullString) (int64, error)); ok {
+		return rf(ctx, walletID, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, sql.NullString) int64); ok {
+		r0 = rf(ctx, walletID, externalID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, sql.NullString) error); ok {
+		r1 = rf(ctx, walletID, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ExpenseExternalIDCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseExternalIDCount'
+type MockQuerier_ExpenseExternalIDCount_Call struct {
+	*mock.Call
+}
+
+// ExpenseExternalIDCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - externalID sql.NullString
+func (_e *MockQuerier_Expecter) ExpenseExternalIDCount(ctx interface{}, walletID interface{}, externalID interface{}) *MockQuerier_ExpenseExternalIDCount_Call {
+	return &MockQuerier_ExpenseExternalIDCount_Call{Call: _e.mock.On("ExpenseExternalIDCount", ctx, walletID, externalID)}
+}
+
+func (_c *MockQuerier_ExpenseExternalIDCount_Call) Run(run func(ctx context.Context, walletID string, externalID sql.NullString)) *MockQuerier_ExpenseExternalIDCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseExternalIDCount_Call) Return(_a0 int64, _a1 error) *MockQuerier_ExpenseExternalIDCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseExternalIDCount_Call) RunAndReturn(run func(context.Context, string, sql.NullString) (int64, error)) *MockQuerier_ExpenseExternalIDCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseGetByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) ExpenseGetByID(ctx context.Context, id string) (*dao.Expense, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseGetByID")
+	}
+
+	var r0 *dao.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Expense, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Expense); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ExpenseGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseGetByID'
+type MockQuerier_ExpenseGetByID_Call struct {
+	*mock.Call
+}
+
+// ExpenseGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) ExpenseGetByID(ctx interface{}, id interface{}) *MockQuerier_ExpenseGetByID_Call {
+	return &MockQuerier_ExpenseGetByID_Call{Call: _e.mock.On("ExpenseGetByID", ctx, id)}
+}
+
+func (_c *MockQuerier_ExpenseGetByID_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_ExpenseGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseGetByID_Call) Return(_a0 *dao.Expense, _a1 error) *MockQuerier_ExpenseGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Expense, error)) *MockQuerier_ExpenseGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseInsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ExpenseInsert(ctx context.Context, arg *dao.ExpenseInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExpenseInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_ExpenseInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseInsert'
+type MockQuerier_ExpenseInsert_Call struct {
+	*mock.Call
+}
+
+// ExpenseInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.ExpenseInsertParams
+func (_e *MockQuerier_Expecter) ExpenseInsert(ctx interface{}, arg interface{}) *MockQuerier_ExpenseInsert_Call {
+	return &MockQuerier_ExpenseInsert_Call{Call: _e.mock.On("ExpenseInsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_ExpenseInsert_Call) Run(run func(ctx context.Context, arg *dao.ExpenseInsertParams)) *MockQuerier_ExpenseInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.ExpenseInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseInsert_Call) Return(_a0 error) *MockQuerier_ExpenseInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseInsert_Call) RunAndReturn(run func(context.Context, *dao.ExpenseInsertParams) error) *MockQuerier_ExpenseInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseListByAmount provides a mock function with given fields: ctx, walletID, amount, createdFrom, createdTo
+func (_m *MockQuerier) ExpenseListByAmount(ctx context.Context, walletID string, amount money.Decimal, createdFrom time.Time, createdTo time.Time) ([]*dao.Expense, error) {
+	ret := _m.Called(ctx, walletID, amount, createdFrom, createdTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseListByAmount")
+	}
+
+	var r0 []*dao.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, money.Decimal, time.Time, time.Time) ([]*dao.Expense, error)); ok {
+		return rf(ctx, walletID, amount, createdFrom, createdTo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, money.Decimal, time.Time, time.Time) []*dao.Expense); ok {
+		r0 = rf(ctx, walletID, amount, createdFrom, createdTo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, money.Decimal, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, walletID, amount, createdFrom, createdTo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ExpenseListByAmount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseListByAmount'
+type MockQuerier_ExpenseListByAmount_Call struct {
+	*mock.Call
+}
+
+// ExpenseListByAmount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - amount money.Decimal
+//   - createdFrom time.Time
+//   - createdTo time.Time
+func (_e *MockQuerier_Expecter) ExpenseListByAmount(ctx interface{}, walletID interface{}, amount interface{}, createdFrom interface{}, createdTo interface{}) *MockQuerier_ExpenseListByAmount_Call {
+	return &MockQuerier_ExpenseListByAmount_Call{Call: _e.mock.On("ExpenseListByAmount", ctx, walletID, amount, createdFrom, createdTo)}
+}
+
+func (_c *MockQuerier_ExpenseListByAmount_Call) Run(run func(ctx context.Context, walletID string, amount money.Decimal, createdFrom time.Time, createdTo time.Time)) *MockQuerier_ExpenseListByAmount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(money.Decimal), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseListByAmount_Call) Return(_a0 []*dao.Expense, _a1 error) *MockQuerier_ExpenseListByAmount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseListByAmount_Call) RunAndReturn(run func(context.Context, string, money.Decimal, time.Time, time.Time) ([]*dao.Expense, error)) *MockQuerier_ExpenseListByAmount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseListByDebt provides a mock function with given fields: ctx, debtID
+func (_m *MockQuerier) ExpenseListByDebt(ctx context.Context, debtID sql.NullString) ([]*dao.Expense, error) {
+	ret := _m.Called(ctx, debtID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseListByDebt")
+	}
+
+	var r0 []*dao.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString) ([]*dao.Expense, error)); ok {
+		return rf(ctx, debtID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString) []*dao.Expense); ok {
+		r0 = rf(ctx, debtID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullString) error); ok {
+		r1 = rf(ctx, debtID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ExpenseListByDebt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseListByDebt'
+type MockQuerier_ExpenseListByDebt_Call struct {
+	*mock.Call
+}
+
+// ExpenseListByDebt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - debtID sql.NullString
+func (_e *MockQuerier_Expecter) ExpenseListByDebt(ctx interface{}, debtID interface{}) *MockQuerier_ExpenseListByDebt_Call {
+	return &MockQuerier_ExpenseListByDebt_Call{Call: _e.mock.On("ExpenseListByDebt", ctx, debtID)}
+}
+
+func (_c *MockQuerier_ExpenseListByDebt_Call) Run(run func(ctx context.Context, debtID sql.NullString)) *MockQuerier_ExpenseListByDebt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseListByDebt_Call) Return(_a0 []*dao.Expense, _a1 error) *MockQuerier_ExpenseListByDebt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseListByDebt_Call) RunAndReturn(run func(context.Context, sql.NullString) ([]*dao.Expense, error)) *MockQuerier_ExpenseListByDebt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseListByWallet provides a mock function with given fields: ctx, walletID
+func (_m *MockQuerier) ExpenseListByWallet(ctx context.Context, walletID string) ([]*dao.Expense, error) {
+	ret := _m.Called(ctx, walletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseListByWallet")
+	}
+
+	var r0 []*dao.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Expense, error)); ok {
+		return rf(ctx, walletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Expense); ok {
+		r0 = rf(ctx, walletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, walletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ExpenseListByWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseListByWallet'
+type MockQuerier_ExpenseListByWallet_Call struct {
+	*mock.Call
+}
+
+// ExpenseListByWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+func (_e *MockQuerier_Expecter) ExpenseListByWallet(ctx interface{}, walletID interface{}) *MockQuerier_ExpenseListByWallet_Call {
+	return &MockQuerier_ExpenseListByWallet_Call{Call: _e.mock.On("ExpenseListByWallet", ctx, walletID)}
+}
+
+func (_c *MockQuerier_ExpenseListByWallet_Call) Run(run func(ctx context.Context, walletID string)) *MockQuerier_ExpenseListByWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseListByWallet_Call) Return(_a0 []*dao.Expense, _a1 error) *MockQuerier_ExpenseListByWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseListByWallet_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Expense, error)) *MockQuerier_ExpenseListByWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseListByWalletByUser provides a mock function with given fields: ctx, walletID, userID
+func (_m *MockQuerier) ExpenseListByWalletByUser(ctx context.Context, walletID string, userID string) ([]*dao.Expense, error) {
+	ret := _m.Called(ctx, walletID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseListByWalletByUser")
+	}
+
+	var r0 []*dao.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*dao.Expense, error)); ok {
+		return rf(ctx, walletID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*dao.Expense); ok {
+		r0 = rf(ctx, walletID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, walletID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ExpenseListByWalletByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseListByWalletByUser'
+type MockQuerier_ExpenseListByWalletByUser_Call struct {
+	*mock.Call
+}
+
+// ExpenseListByWalletByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - userID string
+func (_e *MockQuerier_Expecter) ExpenseListByWalletByUser(ctx interface{}, walletID interface{}, userID interface{}) *MockQuerier_ExpenseListByWalletByUser_Call {
+	return &MockQuerier_ExpenseListByWalletByUser_Call{Call: _e.mock.On("ExpenseListByWalletByUser", ctx, walletID, userID)}
+}
+
+func (_c *MockQuerier_ExpenseListByWalletByUser_Call) Run(run func(ctx context.Context, walletID string, userID string)) *MockQuerier_ExpenseListByWalletByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseListByWalletByUser_Call) Return(_a0 []*dao.Expense, _a1 error) *MockQuerier_ExpenseListByWalletByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseListByWalletByUser_Call) RunAndReturn(run func(context.Context, string, string) ([]*dao.Expense, error)) *MockQuerier_ExpenseListByWalletByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseSetCategory provides a mock function with given fields: ctx, newCategoryID, categoryID
+func (_m *MockQuerier) ExpenseSetCategory(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString) error {
+	ret := _m.Called(ctx, newCategoryID, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseSetCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString, sql.NullString) error); ok {
+		r0 = rf(ctx, newCategoryID, categoryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_ExpenseSetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseSetCategory'
+type MockQuerier_ExpenseSetCategory_Call struct {
+	*mock.Call
+}
+
+// ExpenseSetCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newCategoryID sql.NullString
+//   - categoryID sql.NullString
+func (_e *MockQuerier_Expecter) ExpenseSetCategory(ctx interface{}, newCategoryID interface{}, categoryID interface{}) *MockQuerier_ExpenseSetCategory_Call {
+	return &MockQuerier_ExpenseSetCategory_Call{Call: _e.mock.On("ExpenseSetCategory", ctx, newCategoryID, categoryID)}
+}
+
+func (_c *MockQuerier_ExpenseSetCategory_Call) Run(run func(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString)) *MockQuerier_ExpenseSetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString), args[2].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseSetCategory_Call) Return(_a0 error) *MockQuerier_ExpenseSetCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseSetCategory_Call) RunAndReturn(run func(context.Context, sql.NullString, sql.NullString) error) *MockQuerier_ExpenseSetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseSetDebt provides a mock function with given fields: ctx, debtID, expenseID
+func (_m *MockQuerier) ExpenseSetDebt(ctx context.Context, debtID sql.NullString, expenseID string) error {
+	ret := _m.Called(ctx, debtID, expenseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseSetDebt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString, string) error); ok {
+		r0 = rf(ctx, debtID, expenseID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_ExpenseSetDebt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseSetDebt'
+type MockQuerier_ExpenseSetDebt_Call struct {
+	*mock.Call
+}
+
+// ExpenseSetDebt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - debtID sql.NullString
+//   - expenseID string
+func (_e *MockQuerier_Expecter) ExpenseSetDebt(ctx interface{}, debtID interface{}, expenseID interface{}) *MockQuerier_ExpenseSetDebt_Call {
+	return &MockQuerier_ExpenseSetDebt_Call{Call: _e.mock.On("ExpenseSetDebt", ctx, debtID, expenseID)}
+}
+
+func (_c *MockQuerier_ExpenseSetDebt_Call) Run(run func(ctx context.Context, debtID sql.NullString, expenseID string)) *MockQuerier_ExpenseSetDebt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseSetDebt_Call) Return(_a0 error) *MockQuerier_ExpenseSetDebt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseSetDebt_Call) RunAndReturn(run func(context.Context, sql.NullString, string) error) *MockQuerier_ExpenseSetDebt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseSetExternalID provides a mock function with given fields: ctx, externalID, expenseID
+func (_m *MockQuerier) ExpenseSetExternalID(ctx context.Context, externalID sql.NullString, expenseID string) error {
+	ret := _m.Called(ctx, externalID, expenseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseSetExternalID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString, string) error); ok {
+		r0 = rf(ctx, externalID, expenseID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_ExpenseSetExternalID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseSetExternalID'
+type MockQuerier_ExpenseSetExternalID_Call struct {
+	*mock.Call
+}
+
+// ExpenseSetExternalID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalID sql.NullString
+//   - expenseID string
+func (_e *MockQuerier_Expecter) ExpenseSetExternalID(ctx interface{}, externalID interface{}, expenseID interface{}) *MockQuerier_ExpenseSetExternalID_Call {
+	return &MockQuerier_ExpenseSetExternalID_Call{Call: _e.mock.On("ExpenseSetExternalID", ctx, externalID, expenseID)}
+}
+
+func (_c *MockQuerier_ExpenseSetExternalID_Call) Run(run func(ctx context.Context, externalID sql.NullString, expenseID string)) *MockQuerier_ExpenseSetExternalID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseSetExternalID_Call) Return(_a0 error) *MockQuerier_ExpenseSetExternalID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseSetExternalID_Call) RunAndReturn(run func(context.Context, sql.NullString, string) error) *MockQuerier_ExpenseSetExternalID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseTagDeleteByExpense provides a mock function with given fields: ctx, expenseID
+func (_m *MockQuerier) ExpenseTagDeleteByExpense(ctx context.Context, expenseID string) error {
+	ret := _m.Called(ctx, expenseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseTagDeleteByExpense")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, expenseID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_ExpenseTagDeleteByExpense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseTagDeleteByExpense'
+type MockQuerier_ExpenseTagDeleteByExpense_Call struct {
+	*mock.Call
+}
+
+// ExpenseTagDeleteByExpense is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expenseID string
+func (_e *MockQuerier_Expecter) ExpenseTagDeleteByExpense(ctx interface{}, expenseID interface{}) *MockQuerier_ExpenseTagDeleteByExpense_Call {
+	return &MockQuerier_ExpenseTagDeleteByExpense_Call{Call: _e.mock.On("ExpenseTagDeleteByExpense", ctx, expenseID)}
+}
+
+func (_c *MockQuerier_ExpenseTagDeleteByExpense_Call) Run(run func(ctx context.Context, expenseID string)) *MockQuerier_ExpenseTagDeleteByExpense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseTagDeleteByExpense_Call) Return(_a0 error) *MockQuerier_ExpenseTagDeleteByExpense_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseTagDeleteByExpense_Call) RunAndReturn(run func(context.Context, string) error) *MockQuerier_ExpenseTagDeleteByExpense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseTagDeleteByName provides a mock function with given fields: ctx, expenseID, userID, name
+func (_m *MockQuerier) ExpenseTagDeleteByName(ctx context.Context, expenseID string, userID string, name string) error {
+	ret := _m.Called(ctx, expenseID, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseTagDeleteByName")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, expenseID, userID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_ExpenseTagDeleteByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseTagDeleteByName'
+type MockQuerier_ExpenseTagDeleteByName_Call struct {
+	*mock.Call
+}
+
+// ExpenseTagDeleteByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expenseID string
+//   - userID string
+//   - name string
+func (_e *MockQuerier_Expecter) ExpenseTagDeleteByName(ctx interface{}, expenseID interface{}, userID interface{}, name interface{}) *MockQuerier_ExpenseTagDeleteByName_Call {
+	return &MockQuerier_ExpenseTagDeleteByName_Call{Call: _e.mock.On("ExpenseTagDeleteByName", ctx, expenseID, userID, name)}
+}
+
+func (_c *MockQuerier_ExpenseTagDeleteByName_Call) Run(run func(ctx context.Context, expenseID string, userID string, name string)) *MockQuerier_ExpenseTagDeleteByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseTagDeleteByName_Call) Return(_a0 error) *MockQuerier_ExpenseTagDeleteByName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseTagDeleteByName_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockQuerier_ExpenseTagDeleteByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseTagInsert provides a mock function with given fields: ctx, expenseID, tagID
+func (_m *MockQuerier) ExpenseTagInsert(ctx context.Context, expenseID string, tagID string) error {
+	ret := _m.Called(ctx, expenseID, tagID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseTagInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, expenseID, tagID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_ExpenseTagInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseTagInsert'
+type MockQuerier_ExpenseTagInsert_Call struct {
+	*mock.Call
+}
+
+// ExpenseTagInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expenseID string
+//   - tagID string
+func (_e *MockQuerier_Expecter) ExpenseTagInsert(ctx interface{}, expenseID interface{}, tagID interface{}) *MockQuerier_ExpenseTagInsert_Call {
+	return &MockQuerier_ExpenseTagInsert_Call{Call: _e.mock.On("ExpenseTagInsert", ctx, expenseID, tagID)}
+}
+
+func (_c *MockQuerier_ExpenseTagInsert_Call) Run(run func(ctx context.Context, expenseID string, tagID string)) *MockQuerier_ExpenseTagInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseTagInsert_Call) Return(_a0 error) *MockQuerier_ExpenseTagInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseTagInsert_Call) RunAndReturn(run func(context.Context, string, string) error) *MockQuerier_ExpenseTagInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpenseUpdate provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ExpenseUpdate(ctx context.Context, arg *dao.ExpenseUpdateParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpenseUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ExpenseUpdateParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_ExpenseUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpenseUpdate'
+type MockQuerier_ExpenseUpdate_Call struct {
+	*mock.Call
+}
+
+// ExpenseUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.ExpenseUpdateParams
+func (_e *MockQuerier_Expecter) ExpenseUpdate(ctx interface{}, arg interface{}) *MockQuerier_ExpenseUpdate_Call {
+	return &MockQuerier_ExpenseUpdate_Call{Call: _e.mock.On("ExpenseUpdate", ctx, arg)}
+}
+
+func (_c *MockQuerier_ExpenseUpdate_Call) Run(run func(ctx context.Context, arg *dao.ExpenseUpdateParams)) *MockQuerier_ExpenseUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.ExpenseUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseUpdate_Call) Return(_a0 error) *MockQuerier_ExpenseUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ExpenseUpdate_Call) RunAndReturn(run func(context.Context, *dao.ExpenseUpdateParams) error) *MockQuerier_ExpenseUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HistoryCount provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) HistoryCount(ctx context.Context, arg *dao.HistoryCountParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistoryCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryCountParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryCountParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.HistoryCountParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_HistoryCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryCount'
+type MockQuerier_HistoryCount_Call struct {
+	*mock.Call
+}
+
+// HistoryCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.HistoryCountParams
+func (_e *MockQuerier_Expecter) HistoryCount(ctx interface{}, arg interface{}) *MockQuerier_HistoryCount_Call {
+	return &MockQuerier_HistoryCount_Call{Call: _e.mock.On("HistoryCount", ctx, arg)}
+}
+
+func (_c *MockQuerier_HistoryCount_Call) Run(run func(ctx context.Context, arg *dao.HistoryCountParams)) *MockQuerier_HistoryCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.HistoryCountParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HistoryCount_Call) Return(_a0 int64, _a1 error) *MockQuerier_HistoryCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_HistoryCount_Call) RunAndReturn(run func(context.Context, *dao.HistoryCountParams) (int64, error)) *MockQuerier_HistoryCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HistoryInsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) HistoryInsert(ctx context.Context, arg *dao.HistoryInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistoryInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_HistoryInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryInsert'
+type MockQuerier_HistoryInsert_Call struct {
+	*mock.Call
+}
+
+// HistoryInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.HistoryInsertParams
+func (_e *MockQuerier_Expecter) HistoryInsert(ctx interface{}, arg interface{}) *MockQuerier_HistoryInsert_Call {
+	return &MockQuerier_HistoryInsert_Call{Call: _e.mock.On("HistoryInsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_HistoryInsert_Call) Run(run func(ctx context.Context, arg *dao.HistoryInsertParams)) *MockQuerier_HistoryInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.HistoryInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HistoryInsert_Call) Return(_a0 error) *MockQuerier_HistoryInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_HistoryInsert_Call) RunAndReturn(run func(context.Context, *dao.HistoryInsertParams) error) *MockQuerier_HistoryInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HistoryList provides a mock function with given fields: ctx
+func (_m *MockQuerier) HistoryList(ctx context.Context) ([]*dao.History, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistoryList")
+	}
+
+	var r0 []*dao.History
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*dao.History, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*dao.History); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.History)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_HistoryList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryList'
+type MockQuerier_HistoryList_Call struct {
+	*mock.Call
+}
+
+// HistoryList is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) HistoryList(ctx interface{}) *MockQuerier_HistoryList_Call {
+	return &MockQuerier_HistoryList_Call{Call: _e.mock.On("HistoryList", ctx)}
+}
+
+func (_c *MockQuerier_HistoryList_Call) Run(run func(ctx context.Context)) *MockQuerier_HistoryList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HistoryList_Call) Return(_a0 []*dao.History, _a1 error) *MockQuerier_HistoryList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_HistoryList_Call) RunAndReturn(run func(context.Context) ([]*dao.History, error)) *MockQuerier_HistoryList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HistoryPage provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) HistoryPage(ctx context.Context, arg *dao.HistoryPageParams) ([]*dao.History, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistoryPage")
+	}
+
+	var r0 []*dao.History
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryPageParams) ([]*dao.History, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryPageParams) []*dao.History); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.History)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.HistoryPageParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_HistoryPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryPage'
+type MockQuerier_HistoryPage_Call struct {
+	*mock.Call
+}
+
+// HistoryPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.HistoryPageParams
+func (_e *MockQuerier_Expecter) HistoryPage(ctx interface{}, arg interface{}) *MockQuerier_HistoryPage_Call {
+	return &MockQuerier_HistoryPage_Call{Call: _e.mock.On("HistoryPage", ctx, arg)}
+}
+
+func (_c *MockQuerier_HistoryPage_Call) Run(run func(ctx context.Context, arg *dao.HistoryPageParams)) *MockQuerier_HistoryPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.HistoryPageParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HistoryPage_Call) Return(_a0 []*dao.History, _a1 error) *MockQuerier_HistoryPage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_HistoryPage_Call) RunAndReturn(run func(context.Context, *dao.HistoryPageParams) ([]*dao.History, error)) *MockQuerier_HistoryPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HistoryPageFromEnd provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) HistoryPageFromEnd(ctx context.Context, arg *dao.HistoryPageFromEndParams) ([]*dao.History, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistoryPageFromEnd")
+	}
+
+	var r0 []*dao.History
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryPageFromEndParams) ([]*dao.History, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HistoryPageFromEndParams) []*dao.History); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.History)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.HistoryPageFromEndParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_HistoryPageFromEnd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryPageFromEnd'
+type MockQuerier_HistoryPageFromEnd_Call struct {
+	*mock.Call
+}
+
+// HistoryPageFromEnd is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.HistoryPageFromEndParams
+func (_e *MockQuerier_Expecter) HistoryPageFromEnd(ctx interface{}, arg interface{}) *MockQuerier_HistoryPageFromEnd_Call {
+	return &MockQuerier_HistoryPageFromEnd_Call{Call: _e.mock.On("HistoryPageFromEnd", ctx, arg)}
+}
+
+func (_c *MockQuerier_HistoryPageFromEnd_Call) Run(run func(ctx context.Context, arg *dao.HistoryPageFromEndParams)) *MockQuerier_HistoryPageFromEnd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.HistoryPageFromEndParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HistoryPageFromEnd_Call) Return(_a0 []*dao.History, _a1 error) *MockQuerier_HistoryPageFromEnd_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_HistoryPageFromEnd_Call) RunAndReturn(run func(context.Context, *dao.HistoryPageFromEndParams) ([]*dao.History, error)) *MockQuerier_HistoryPageFromEnd_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdGetByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) HouseholdGetByID(ctx context.Context, id string) (*dao.Household, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdGetByID")
+	}
+
+	var r0 *dao.Household
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Household, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Household); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Household)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_HouseholdGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdGetByID'
+type MockQuerier_HouseholdGetByID_Call struct {
+	*mock.Call
+}
+
+// HouseholdGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) HouseholdGetByID(ctx interface{}, id interface{}) *MockQuerier_HouseholdGetByID_Call {
+	return &MockQuerier_HouseholdGetByID_Call{Call: _e.mock.On("HouseholdGetByID", ctx, id)}
+}
+
+func (_c *MockQuerier_HouseholdGetByID_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_HouseholdGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdGetByID_Call) Return(_a0 *dao.Household, _a1 error) *MockQuerier_HouseholdGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Household, error)) *MockQuerier_HouseholdGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdInsert provides a mock function with given fields: ctx, iD, name, createdAt
+func (_m *MockQuerier) HouseholdInsert(ctx context.Context, iD string, name string, createdAt time.Time) error {
+	ret := _m.Called(ctx, iD, name, createdAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, iD, name, createdAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_HouseholdInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdInsert'
+type MockQuerier_HouseholdInsert_Call struct {
+	*mock.Call
+}
+
+// HouseholdInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - iD string
+//   - name string
+//   - createdAt time.Time
+func (_e *MockQuerier_Expecter) HouseholdInsert(ctx interface{}, iD interface{}, name interface{}, createdAt interface{}) *MockQuerier_HouseholdInsert_Call {
+	return &MockQuerier_HouseholdInsert_Call{Call: _e.mock.On("HouseholdInsert", ctx, iD, name, createdAt)}
+}
+
+func (_c *MockQuerier_HouseholdInsert_Call) Run(run func(ctx context.Context, iD string, name string, createdAt time.Time)) *MockQuerier_HouseholdInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdInsert_Call) Return(_a0 error) *MockQuerier_HouseholdInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdInsert_Call) RunAndReturn(run func(context.Context, string, string, time.Time) error) *MockQuerier_HouseholdInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdInvitationDelete provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) HouseholdInvitationDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdInvitationDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_HouseholdInvitationDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdInvitationDelete'
+type MockQuerier_HouseholdInvitationDelete_Call struct {
+	*mock.Call
+}
+
+// HouseholdInvitationDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) HouseholdInvitationDelete(ctx interface{}, id interface{}) *MockQuerier_HouseholdInvitationDelete_Call {
+	return &MockQuerier_HouseholdInvitationDelete_Call{Call: _e.mock.On("HouseholdInvitationDelete", ctx, id)}
+}
+
+func (_c *MockQuerier_HouseholdInvitationDelete_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_HouseholdInvitationDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdInvitationDelete_Call) Return(_a0 error) *MockQuerier_HouseholdInvitationDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdInvitationDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockQuerier_HouseholdInvitationDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdInvitationGetByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) HouseholdInvitationGetByID(ctx context.Context, id string) (*dao.HouseholdInvitation, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdInvitationGetByID")
+	}
+
+	var r0 *dao.HouseholdInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.HouseholdInvitation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.HouseholdInvitation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.HouseholdInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_HouseholdInvitationGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdInvitationGetByID'
+type MockQuerier_HouseholdInvitationGetByID_Call struct {
+	*mock.Call
+}
+
+// HouseholdInvitationGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) HouseholdInvitationGetByID(ctx interface{}, id interface{}) *MockQuerier_HouseholdInvitationGetByID_Call {
+	return &MockQuerier_HouseholdInvitationGetByID_Call{Call: _e.mock.On("HouseholdInvitationGetByID", ctx, id)}
+}
+
+func (_c *MockQuerier_HouseholdInvitationGetByID_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_HouseholdInvitationGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdInvitationGetByID_Call) Return(_a0 *dao.HouseholdInvitation, _a1 error) *MockQuerier_HouseholdInvitationGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdInvitationGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.HouseholdInvitation, error)) *MockQuerier_HouseholdInvitationGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdInvitationGetByUser provides a mock function with given fields: ctx, householdID, userID
+func (_m *MockQuerier) HouseholdInvitationGetByUser(ctx context.Context, householdID string, userID string) (*dao.HouseholdInvitation, error) {
+	ret := _m.Called(ctx, householdID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdInvitationGetByUser")
+	}
+
+	var r0 *dao.HouseholdInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dao.HouseholdInvitation, error)); ok {
+		return rf(ctx, householdID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dao.HouseholdInvitation); ok {
+		r0 = rf(ctx, householdID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.HouseholdInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, householdID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_HouseholdInvitationGetByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdInvitationGetByUser'
+type MockQuerier_HouseholdInvitationGetByUser_Call struct {
+	*mock.Call
+}
+
+// HouseholdInvitationGetByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - householdID string
+//   - userID string
+func (_e *MockQuerier_Expecter) HouseholdInvitationGetByUser(ctx interface{}, householdID interface{}, userID interface{}) *MockQuerier_HouseholdInvitationGetByUser_Call {
+	return &MockQuerier_HouseholdInvitationGetByUser_Call{Call: _e.mock.On("HouseholdInvitationGetByUser", ctx, householdID, userID)}
+}
+
+func (_c *MockQuerier_HouseholdInvitationGetByUser_Call) Run(run func(ctx context.Context, householdID string, userID string)) *MockQuerier_HouseholdInvitationGetByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdInvitationGetByUser_Call) Return(_a0 *dao.HouseholdInvitation, _a1 error) *MockQuerier_HouseholdInvitationGetByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdInvitationGetByUser_Call) RunAndReturn(run func(context.Context, string, string) (*dao.HouseholdInvitation, error)) *MockQuerier_HouseholdInvitationGetByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdInvitationListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) HouseholdInvitationListByUser(ctx context.Context, userID string) ([]*dao.HouseholdInvitation, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdInvitationListByUser")
+	}
+
+	var r0 []*dao.HouseholdInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.HouseholdInvitation, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.HouseholdInvitation); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.HouseholdInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_HouseholdInvitationListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdInvitationListByUser'
+type MockQuerier_HouseholdInvitationListByUser_Call struct {
+	*mock.Call
+}
+
+// HouseholdInvitationListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockQuerier_Expecter) HouseholdInvitationListByUser(ctx interface{}, userID interface{}) *MockQuerier_HouseholdInvitationListByUser_Call {
+	return &MockQuerier_HouseholdInvitationListByUser_Call{Call: _e.mock.On("HouseholdInvitationListByUser", ctx, userID)}
+}
+
+func (_c *MockQuerier_HouseholdInvitationListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockQuerier_HouseholdInvitationListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdInvitationListByUser_Call) Return(_a0 []*dao.HouseholdInvitation, _a1 error) *MockQuerier_HouseholdInvitationListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdInvitationListByUser_Call) RunAndReturn(run func(context.Context, string) ([]*dao.HouseholdInvitation, error)) *MockQuerier_HouseholdInvitationListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdInvitationUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) HouseholdInvitationUpsert(ctx context.Context, arg *dao.HouseholdInvitationUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdInvitationUpsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HouseholdInvitationUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_HouseholdInvitationUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdInvitationUpsert'
+type MockQuerier_HouseholdInvitationUpsert_Call struct {
+	*mock.Call
+}
+
+// HouseholdInvitationUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.HouseholdInvitationUpsertParams
+func (_e *MockQuerier_Expecter) HouseholdInvitationUpsert(ctx interface{}, arg interface{}) *MockQuerier_HouseholdInvitationUpsert_Call {
+	return &MockQuerier_HouseholdInvitationUpsert_Call{Call: _e.mock.On("HouseholdInvitationUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_HouseholdInvitationUpsert_Call) Run(run func(ctx context.Context, arg *dao.HouseholdInvitationUpsertParams)) *MockQuerier_HouseholdInvitationUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.HouseholdInvitationUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdInvitationUpsert_Call) Return(_a0 error) *MockQuerier_HouseholdInvitationUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdInvitationUpsert_Call) RunAndReturn(run func(context.Context, *dao.HouseholdInvitationUpsertParams) error) *MockQuerier_HouseholdInvitationUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) HouseholdListByUser(ctx context.Context, userID string) ([]*dao.Household, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdListByUser")
+	}
+
+	var r0 []*dao.Household
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Household, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Household); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Household)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_HouseholdListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdListByUser'
+type MockQuerier_HouseholdListByUser_Call struct {
+	*mock.Call
+}
+
+// HouseholdListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockQuerier_Expecter) HouseholdListByUser(ctx interface{}, userID interface{}) *MockQuerier_HouseholdListByUser_Call {
+	return &MockQuerier_HouseholdListByUser_Call{Call: _e.mock.On("HouseholdListByUser", ctx, userID)}
+}
+
+func (_c *MockQuerier_HouseholdListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockQuerier_HouseholdListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdListByUser_Call) Return(_a0 []*dao.Household, _a1 error) *MockQuerier_HouseholdListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdListByUser_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Household, error)) *MockQuerier_HouseholdListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdMemberCountByRole provides a mock function with given fields: ctx, householdID, role
+func (_m *MockQuerier) HouseholdMemberCountByRole(ctx context.Context, householdID string, role string) (int64, error) {
+	ret := _m.Called(ctx, householdID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdMemberCountByRole")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int64, error)); ok {
+		return rf(ctx, householdID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, householdID, role)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, householdID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_HouseholdMemberCountByRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdMemberCountByRole'
+type MockQuerier_HouseholdMemberCountByRole_Call struct {
+	*mock.Call
+}
+
+// HouseholdMemberCountByRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - householdID string
+//   - role string
+func (_e *MockQuerier_Expecter) HouseholdMemberCountByRole(ctx interface{}, householdID interface{}, role interface{}) *MockQuerier_HouseholdMemberCountByRole_Call {
+	return &MockQuerier_HouseholdMemberCountByRole_Call{Call: _e.mock.On("HouseholdMemberCountByRole", ctx, householdID, role)}
+}
+
+func (_c *MockQuerier_HouseholdMemberCountByRole_Call) Run(run func(ctx context.Context, householdID string, role string)) *MockQuerier_HouseholdMemberCountByRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdMemberCountByRole_Call) Return(_a0 int64, _a1 error) *MockQuerier_HouseholdMemberCountByRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdMemberCountByRole_Call) RunAndReturn(run func(context.Context, string, string) (int64, error)) *MockQuerier_HouseholdMemberCountByRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdMemberDelete provides a mock function with given fields: ctx, householdID, userID
+func (_m *MockQuerier) HouseholdMemberDelete(ctx context.Context, householdID string, userID string) error {
+	ret := _m.Called(ctx, householdID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdMemberDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, householdID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_HouseholdMemberDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdMemberDelete'
+type MockQuerier_HouseholdMemberDelete_Call struct {
+	*mock.Call
+}
+
+// HouseholdMemberDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - householdID string
+//   - userID string
+func (_e *MockQuerier_Expecter) HouseholdMemberDelete(ctx interface{}, householdID interface{}, userID interface{}) *MockQuerier_HouseholdMemberDelete_Call {
+	return &MockQuerier_HouseholdMemberDelete_Call{Call: _e.mock.On("HouseholdMemberDelete", ctx, householdID, userID)}
+}
+
+func (_c *MockQuerier_HouseholdMemberDelete_Call) Run(run func(ctx context.Context, householdID string, userID string)) *MockQuerier_HouseholdMemberDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdMemberDelete_Call) Return(_a0 error) *MockQuerier_HouseholdMemberDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdMemberDelete_Call) RunAndReturn(run func(context.Context, string, string) error) *MockQuerier_HouseholdMemberDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdMemberGet provides a mock function with given fields: ctx, householdID, userID
+func (_m *MockQuerier) HouseholdMemberGet(ctx context.Context, householdID string, userID string) (*dao.HouseholdMember, error) {
+	ret := _m.Called(ctx, householdID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdMemberGet")
+	}
+
+	var r0 *dao.HouseholdMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dao.HouseholdMember, error)); ok {
+		return rf(ctx, householdID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dao.HouseholdMember); ok {
+		r0 = rf(ctx, householdID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.HouseholdMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, householdID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_HouseholdMemberGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdMemberGet'
+type MockQuerier_HouseholdMemberGet_Call struct {
+	*mock.Call
+}
+
+// HouseholdMemberGet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - householdID string
+//   - userID string
+func (_e *MockQuerier_Expecter) HouseholdMemberGet(ctx interface{}, householdID interface{}, userID interface{}) *MockQuerier_HouseholdMemberGet_Call {
+	return &MockQuerier_HouseholdMemberGet_Call{Call: _e.mock.On("HouseholdMemberGet", ctx, householdID, userID)}
+}
+
+func (_c *MockQuerier_HouseholdMemberGet_Call) Run(run func(ctx context.Context, householdID string, userID string)) *MockQuerier_HouseholdMemberGet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdMemberGet_Call) Return(_a0 *dao.HouseholdMember, _a1 error) *MockQuerier_HouseholdMemberGet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdMemberGet_Call) RunAndReturn(run func(context.Context, string, string) (*dao.HouseholdMember, error)) *MockQuerier_HouseholdMemberGet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdMemberListByHousehold provides a mock function with given fields: ctx, householdID
+func (_m *MockQuerier) HouseholdMemberListByHousehold(ctx context.Context, householdID string) ([]*dao.HouseholdMember, error) {
+	ret := _m.Called(ctx, householdID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdMemberListByHousehold")
+	}
+
+	var r0 []*dao.HouseholdMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.HouseholdMember, error)); ok {
+		return rf(ctx, householdID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.HouseholdMember); ok {
+		r0 = rf(ctx, householdID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.HouseholdMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, householdID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_HouseholdMemberListByHousehold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdMemberListByHousehold'
+type MockQuerier_HouseholdMemberListByHousehold_Call struct {
+	*mock.Call
+}
+
+// HouseholdMemberListByHousehold is a helper method to define mock.On call
+//   - ctx context.Context
+//   - householdID string
+func (_e *MockQuerier_Expecter) HouseholdMemberListByHousehold(ctx interface{}, householdID interface{}) *MockQuerier_HouseholdMemberListByHousehold_Call {
+	return &MockQuerier_HouseholdMemberListByHousehold_Call{Call: _e.mock.On("HouseholdMemberListByHousehold", ctx, householdID)}
+}
+
+func (_c *MockQuerier_HouseholdMemberListByHousehold_Call) Run(run func(ctx context.Context, householdID string)) *MockQuerier_HouseholdMemberListByHousehold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdMemberListByHousehold_Call) Return(_a0 []*dao.HouseholdMember, _a1 error) *MockQuerier_HouseholdMemberListByHousehold_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdMemberListByHousehold_Call) RunAndReturn(run func(context.Context, string) ([]*dao.HouseholdMember, error)) *MockQuerier_HouseholdMemberListByHousehold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HouseholdMemberUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) HouseholdMemberUpsert(ctx context.Context, arg *dao.HouseholdMemberUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HouseholdMemberUpsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.HouseholdMemberUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_HouseholdMemberUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HouseholdMemberUpsert'
+type MockQuerier_HouseholdMemberUpsert_Call struct {
+	*mock.Call
+}
+
+// HouseholdMemberUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.HouseholdMemberUpsertParams
+func (_e *MockQuerier_Expecter) HouseholdMemberUpsert(ctx interface{}, arg interface{}) *MockQuerier_HouseholdMemberUpsert_Call {
+	return &MockQuerier_HouseholdMemberUpsert_Call{Call: _e.mock.On("HouseholdMemberUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_HouseholdMemberUpsert_Call) Run(run func(ctx context.Context, arg *dao.HouseholdMemberUpsertParams)) *MockQuerier_HouseholdMemberUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.HouseholdMemberUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdMemberUpsert_Call) Return(_a0 error) *MockQuerier_HouseholdMemberUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_HouseholdMemberUpsert_Call) RunAndReturn(run func(context.Context, *dao.HouseholdMemberUpsertParams) error) *MockQuerier_HouseholdMemberUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncomeDelete provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) IncomeDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncomeDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_IncomeDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncomeDelete'
+type MockQuerier_IncomeDelete_Call struct {
+	*mock.Call
+}
+
+// IncomeDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) IncomeDelete(ctx interface{}, id interface{}) *MockQuerier_IncomeDelete_Call {
+	return &MockQuerier_IncomeDelete_Call{Call: _e.mock.On("IncomeDelete", ctx, id)}
+}
+
+func (_c *MockQuerier_IncomeDelete_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_IncomeDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IncomeDelete_Call) Return(_a0 error) *MockQuerier_IncomeDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_IncomeDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockQuerier_IncomeDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncomeExternalIDCount provides a mock function with given fields: ctx, walletID, externalID
+func (_m *MockQuerier) IncomeExternalIDCount(ctx context.Context, walletID string, externalID sql.NullString) (int64, error) {
+	ret := _m.Called(ctx, walletID, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncomeExternalIDCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, sql.NullString) (int64, error)); ok {
+		return rf(ctx, walletID, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, sql.NullString) int64); ok {
+		r0 = rf(ctx, walletID, externalID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, sql.NullString) error); ok {
+		r1 = rf(ctx, walletID, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IncomeExternalIDCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncomeExternalIDCount'
+type MockQuerier_IncomeExternalIDCount_Call struct {
+	*mock.Call
+}
+
+// IncomeExternalIDCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - externalID sql.NullString
+func (_e *MockQuerier_Expecter) IncomeExternalIDCount(ctx interface{}, walletID interface{}, externalID interface{}) *MockQuerier_IncomeExternalIDCount_Call {
+	return &MockQuerier_IncomeExternalIDCount_Call{Call: _e.mock.On("IncomeExternalIDCount", ctx, walletID, externalID)}
+}
+
+func (_c *MockQuerier_IncomeExternalIDCount_Call) Run(run func(ctx context.Context, walletID string, externalID sql.NullString)) *MockQuerier_IncomeExternalIDCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IncomeExternalIDCount_Call) Return(_a0 int64, _a1 error) *MockQuerier_IncomeExternalIDCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IncomeExternalIDCount_Call) RunAndReturn(run func(context.Context, string, sql.NullString) (int64, error)) *MockQuerier_IncomeExternalIDCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncomeGetByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) IncomeGetByID(ctx context.Context, id string) (*dao.Income, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncomeGetByID")
+	}
+
+	var r0 *dao.Income
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Income, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Income); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Income)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IncomeGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncomeGetByID'
+type MockQuerier_IncomeGetByID_Call struct {
+	*mock.Call
+}
+
+// IncomeGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) IncomeGetByID(ctx interface{}, id interface{}) *MockQuerier_IncomeGetByID_Call {
+	return &MockQuerier_IncomeGetByID_Call{Call: _e.mock.On("IncomeGetByID", ctx, id)}
+}
+
+func (_c *MockQuerier_IncomeGetByID_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_IncomeGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IncomeGetByID_Call) Return(_a0 *dao.Income, _a1 error) *MockQuerier_IncomeGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IncomeGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Income, error)) *MockQuerier_IncomeGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncomeInsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) IncomeInsert(ctx context.Context, arg *dao.IncomeInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncomeInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.IncomeInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_IncomeInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncomeInsert'
+type MockQuerier_IncomeInsert_Call struct {
+	*mock.Call
+}
+
+// IncomeInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.IncomeInsertParams
+func (_e *MockQuerier_Expecter) IncomeInsert(ctx interface{}, arg interface{}) *MockQuerier_IncomeInsert_Call {
+	return &MockQuerier_IncomeInsert_Call{Call: _e.mock.On("IncomeInsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_IncomeInsert_Call) Run(run func(ctx context.Context, arg *dao.IncomeInsertParams)) *MockQuerier_IncomeInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.IncomeInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IncomeInsert_Call) Return(_a0 error) *MockQuerier_IncomeInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_IncomeInsert_Call) RunAndReturn(run func(context.Context, *dao.IncomeInsertParams) error) *MockQuerier_IncomeInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncomeListByWallet provides a mock function with given fields: ctx, walletID
+func (_m *MockQuerier) IncomeListByWallet(ctx context.Context, walletID string) ([]*dao.Income, error) {
+	ret := _m.Called(ctx, walletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncomeListByWallet")
+	}
+
+	var r0 []*dao.Income
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Income, error)); ok {
+		return rf(ctx, walletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Income); ok {
+		r0 = rf(ctx, walletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Income)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, walletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IncomeListByWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncomeListByWallet'
+type MockQuerier_IncomeListByWallet_Call struct {
+	*mock.Call
+}
+
+// IncomeListByWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+func (_e *MockQuerier_Expecter) IncomeListByWallet(ctx interface{}, walletID interface{}) *MockQuerier_IncomeListByWallet_Call {
+	return &MockQuerier_IncomeListByWallet_Call{Call: _e.mock.On("IncomeListByWallet", ctx, walletID)}
+}
+
+func (_c *MockQuerier_IncomeListByWallet_Call) Run(run func(ctx context.Context, walletID string)) *MockQuerier_IncomeListByWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IncomeListByWallet_Call) Return(_a0 []*dao.Income, _a1 error) *MockQuerier_IncomeListByWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IncomeListByWallet_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Income, error)) *MockQuerier_IncomeListByWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncomeUpdate provides a mock function with given fields: ctx, amount, description, createdAt, iD
+func (_m *MockQuerier) IncomeUpdate(ctx context.Context, amount money.Decimal, description sql.NullString, createdAt time.Time, iD string) error {
+	ret := _m.Called(ctx, amount, description, createdAt, iD)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncomeUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, money.Decimal, sql.NullString, time.Time, string) error); ok {
+		r0 = rf(ctx, amount, description, createdAt, iD)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_IncomeUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncomeUpdate'
+type MockQuerier_IncomeUpdate_Call struct {
+	*mock.Call
+}
+
+// IncomeUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - amount money.Decimal
+//   - description sql.NullString
+//   - createdAt time.Time
+//   - iD string
+func (_e *MockQuerier_Expecter) IncomeUpdate(ctx interface{}, amount interface{}, description interface{}, createdAt interface{}, iD interface{}) *MockQuerier_IncomeUpdate_Call {
+	return &MockQuerier_IncomeUpdate_Call{Call: _e.mock.On("IncomeUpdate", ctx, amount, description, createdAt, iD)}
+}
+
+func (_c *MockQuerier_IncomeUpdate_Call) Run(run func(ctx context.Context, amount money.Decimal, description sql.NullString, createdAt time.Time, iD string)) *MockQuerier_IncomeUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(money.Decimal), args[2].(sql.NullString), args[3].(time.Time), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IncomeUpdate_Call) Return(_a0 error) *MockQuerier_IncomeUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_IncomeUpdate_Call) RunAndReturn(run func(context.Context, money.Decimal, sql.NullString, time.Time, string) error) *MockQuerier_IncomeUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LocalUserGetByEmail provides a mock function with given fields: ctx, email
+func (_m *MockQuerier) LocalUserGetByEmail(ctx context.Context, email string) (*dao.LocalUser, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LocalUserGetByEmail")
+	}
+
+	var r0 *dao.LocalUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.LocalUser, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.LocalUser); ok {
+		r0 = rf(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LocalUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_LocalUserGetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserGetByEmail'
+type MockQuerier_LocalUserGetByEmail_Call struct {
+	*mock.Call
+}
+
+// LocalUserGetByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockQuerier_Expecter) LocalUserGetByEmail(ctx interface{}, email interface{}) *MockQuerier_LocalUserGetByEmail_Call {
+	return &MockQuerier_LocalUserGetByEmail_Call{Call: _e.mock.On("LocalUserGetByEmail", ctx, email)}
+}
+
+func (_c *MockQuerier_LocalUserGetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockQuerier_LocalUserGetByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LocalUserGetByEmail_Call) Return(_a0 *dao.LocalUser, _a1 error) *MockQuerier_LocalUserGetByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_LocalUserGetByEmail_Call) RunAndReturn(run func(context.Context, string) (*dao.LocalUser, error)) *MockQuerier_LocalUserGetByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LocalUserGetByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) LocalUserGetByID(ctx context.Context, id string) (*dao.LocalUser, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LocalUserGetByID")
+	}
+
+	var r0 *dao.LocalUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.LocalUser, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.LocalUser); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LocalUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_LocalUserGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserGetByID'
+type MockQuerier_LocalUserGetByID_Call struct {
+	*mock.Call
+}
+
+// LocalUserGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) LocalUserGetByID(ctx interface{}, id interface{}) *MockQuerier_LocalUserGetByID_Call {
+	return &MockQuerier_LocalUserGetByID_Call{Call: _e.mock.On("LocalUserGetByID", ctx, id)}
+}
+
+func (_c *MockQuerier_LocalUserGetByID_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_LocalUserGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LocalUserGetByID_Call) Return(_a0 *dao.LocalUser, _a1 error) *MockQuerier_LocalUserGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_LocalUserGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.LocalUser, error)) *MockQuerier_LocalUserGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LocalUserInsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) LocalUserInsert(ctx context.Context, arg *dao.LocalUserInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LocalUserInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.LocalUserInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_LocalUserInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserInsert'
+type MockQuerier_LocalUserInsert_Call struct {
+	*mock.Call
+}
+
+// LocalUserInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.LocalUserInsertParams
+func (_e *MockQuerier_Expecter) LocalUserInsert(ctx interface{}, arg interface{}) *MockQuerier_LocalUserInsert_Call {
+	return &MockQuerier_LocalUserInsert_Call{Call: _e.mock.On("LocalUserInsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_LocalUserInsert_Call) Run(run func(ctx context.Context, arg *dao.LocalUserInsertParams)) *MockQuerier_LocalUserInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.LocalUserInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LocalUserInsert_Call) Return(_a0 error) *MockQuerier_LocalUserInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_LocalUserInsert_Call) RunAndReturn(run func(context.Context, *dao.LocalUserInsertParams) error) *MockQuerier_LocalUserInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LocalUserList provides a mock function with given fields: ctx
+func (_m *MockQuerier) LocalUserList(ctx context.Context) ([]*dao.LocalUser, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LocalUserList")
+	}
+
+	var r0 []*dao.LocalUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*dao.LocalUser, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*dao.LocalUser); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.LocalUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_LocalUserList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserList'
+type MockQuerier_LocalUserList_Call struct {
+	*mock.Call
+}
+
+// LocalUserList is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) LocalUserList(ctx interface{}) *MockQuerier_LocalUserList_Call {
+	return &MockQuerier_LocalUserList_Call{Call: _e.mock.On("LocalUserList", ctx)}
+}
+
+func (_c *MockQuerier_LocalUserList_Call) Run(run func(ctx context.Context)) *MockQuerier_LocalUserList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LocalUserList_Call) Return(_a0 []*dao.LocalUser, _a1 error) *MockQuerier_LocalUserList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_LocalUserList_Call) RunAndReturn(run func(context.Context) ([]*dao.LocalUser, error)) *MockQuerier_LocalUserList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LocalUserSetPass provides a mock function with given fields: ctx, pwdhash, email
+func (_m *MockQuerier) LocalUserSetPass(ctx context.Context, pwdhash string, email string) error {
+	ret := _m.Called(ctx, pwdhash, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LocalUserSetPass")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, pwdhash, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_LocalUserSetPass_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserSetPass'
+type MockQuerier_LocalUserSetPass_Call struct {
 	*mock.Call
 }
 
 // LocalUserSetPass is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pwdhash string
-//   - email string
-func (_e *MockQuerier_Expecter) LocalUserSetPass(ctx interface{}, pwdhash interface{}, email interface{}) *MockQuerier_LocalUserSetPass_Call {
-	return &MockQuerier_LocalUserSetPass_Call{Call: _e.mock.On("LocalUserSetPass", ctx, pwdhash, email)}
+//   - pwdhash string
+//   - email string
+func (_e *MockQuerier_Expecter) LocalUserSetPass(ctx interface{}, pwdhash interface{}, email interface{}) *MockQuerier_LocalUserSetPass_Call {
+	return &MockQuerier_LocalUserSetPass_Call{Call: _e.mock.On("LocalUserSetPass", ctx, pwdhash, email)}
+}
+
+func (_c *MockQuerier_LocalUserSetPass_Call) Run(run func(ctx context.Context, pwdhash string, email string)) *MockQuerier_LocalUserSetPass_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LocalUserSetPass_Call) Return(_a0 error) *MockQuerier_LocalUserSetPass_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_LocalUserSetPass_Call) RunAndReturn(run func(context.Context, string, string) error) *MockQuerier_LocalUserSetPass_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LocalUserUpdate provides a mock function with given fields: ctx, roles, email
+func (_m *MockQuerier) LocalUserUpdate(ctx context.Context, roles string, email string) error {
+	ret := _m.Called(ctx, roles, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LocalUserUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, roles, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_LocalUserUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserUpdate'
+type MockQuerier_LocalUserUpdate_Call struct {
+	*mock.Call
+}
+
+// LocalUserUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roles string
+//   - email string
+func (_e *MockQuerier_Expecter) LocalUserUpdate(ctx interface{}, roles interface{}, email interface{}) *MockQuerier_LocalUserUpdate_Call {
+	return &MockQuerier_LocalUserUpdate_Call{Call: _e.mock.On("LocalUserUpdate", ctx, roles, email)}
+}
+
+func (_c *MockQuerier_LocalUserUpdate_Call) Run(run func(ctx context.Context, roles string, email string)) *MockQuerier_LocalUserUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LocalUserUpdate_Call) Return(_a0 error) *MockQuerier_LocalUserUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_LocalUserUpdate_Call) RunAndReturn(run func(context.Context, string, string) error) *MockQuerier_LocalUserUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecurringAdvance provides a mock function with given fields: ctx, nextAt, occurrences, ruleID, previousOccurrences
+func (_m *MockQuerier) RecurringAdvance(ctx context.Context, nextAt sql.NullTime, occurrences int32, ruleID string, previousOccurrences int32) (int64, error) {
+	ret := _m.Called(ctx, nextAt, occurrences, ruleID, previousOccurrences)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecurringAdvance")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullTime, int32, string, int32) (int64, error)); ok {
+		return rf(ctx, nextAt, occurrences, ruleID, previousOccurrences)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullTime, int32, string, int32) int64); ok {
+		r0 = rf(ctx, nextAt, occurrences, ruleID, previousOccurrences)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullTime, int32, string, int32) error); ok {
+		r1 = rf(ctx, nextAt, occurrences, ruleID, previousOccurrences)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_RecurringAdvance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecurringAdvance'
+type MockQuerier_RecurringAdvance_Call struct {
+	*mock.Call
+}
+
+// RecurringAdvance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nextAt sql.NullTime
+//   - occurrences int32
+//   - ruleID string
+//   - previousOccurrences int32
+func (_e *MockQuerier_Expecter) RecurringAdvance(ctx interface{}, nextAt interface{}, occurrences interface{}, ruleID interface{}, previousOccurrences interface{}) *MockQuerier_RecurringAdvance_Call {
+	return &MockQuerier_RecurringAdvance_Call{Call: _e.mock.On("RecurringAdvance", ctx, nextAt, occurrences, ruleID, previousOccurrences)}
+}
+
+func (_c *MockQuerier_RecurringAdvance_Call) Run(run func(ctx context.Context, nextAt sql.NullTime, occurrences int32, ruleID string, previousOccurrences int32)) *MockQuerier_RecurringAdvance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullTime), args[2].(int32), args[3].(string), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RecurringAdvance_Call) Return(_a0 int64, _a1 error) *MockQuerier_RecurringAdvance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_RecurringAdvance_Call) RunAndReturn(run func(context.Context, sql.NullTime, int32, string, int32) (int64, error)) *MockQuerier_RecurringAdvance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecurringDelete provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) RecurringDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecurringDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RecurringDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecurringDelete'
+type MockQuerier_RecurringDelete_Call struct {
+	*mock.Call
+}
+
+// RecurringDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) RecurringDelete(ctx interface{}, id interface{}) *MockQuerier_RecurringDelete_Call {
+	return &MockQuerier_RecurringDelete_Call{Call: _e.mock.On("RecurringDelete", ctx, id)}
+}
+
+func (_c *MockQuerier_RecurringDelete_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_RecurringDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RecurringDelete_Call) Return(_a0 error) *MockQuerier_RecurringDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RecurringDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockQuerier_RecurringDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecurringGetByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) RecurringGetByID(ctx context.Context, id string) (*dao.Recurring, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecurringGetByID")
+	}
+
+	var r0 *dao.Recurring
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Recurring, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Recurring); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Recurring)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_RecurringGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecurringGetByID'
+type MockQuerier_RecurringGetByID_Call struct {
+	*mock.Call
+}
+
+// RecurringGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) RecurringGetByID(ctx interface{}, id interface{}) *MockQuerier_RecurringGetByID_Call {
+	return &MockQuerier_RecurringGetByID_Call{Call: _e.mock.On("RecurringGetByID", ctx, id)}
+}
+
+func (_c *MockQuerier_RecurringGetByID_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_RecurringGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RecurringGetByID_Call) Return(_a0 *dao.Recurring, _a1 error) *MockQuerier_RecurringGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_RecurringGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Recurring, error)) *MockQuerier_RecurringGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecurringInsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) RecurringInsert(ctx context.Context, arg *dao.RecurringInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecurringInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.RecurringInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RecurringInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecurringInsert'
+type MockQuerier_RecurringInsert_Call struct {
+	*mock.Call
+}
+
+// RecurringInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.RecurringInsertParams
+func (_e *MockQuerier_Expecter) RecurringInsert(ctx interface{}, arg interface{}) *MockQuerier_RecurringInsert_Call {
+	return &MockQuerier_RecurringInsert_Call{Call: _e.mock.On("RecurringInsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_RecurringInsert_Call) Run(run func(ctx context.Context, arg *dao.RecurringInsertParams)) *MockQuerier_RecurringInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.RecurringInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RecurringInsert_Call) Return(_a0 error) *MockQuerier_RecurringInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RecurringInsert_Call) RunAndReturn(run func(context.Context, *dao.RecurringInsertParams) error) *MockQuerier_RecurringInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecurringListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) RecurringListByUser(ctx context.Context, userID string) ([]*dao.Recurring, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecurringListByUser")
+	}
+
+	var r0 []*dao.Recurring
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Recurring, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Recurring); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Recurring)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_RecurringListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecurringListByUser'
+type MockQuerier_RecurringListByUser_Call struct {
+	*mock.Call
+}
+
+// RecurringListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockQuerier_Expecter) RecurringListByUser(ctx interface{}, userID interface{}) *MockQuerier_RecurringListByUser_Call {
+	return &MockQuerier_RecurringListByUser_Call{Call: _e.mock.On("RecurringListByUser", ctx, userID)}
+}
+
+func (_c *MockQuerier_RecurringListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockQuerier_RecurringListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RecurringListByUser_Call) Return(_a0 []*dao.Recurring, _a1 error) *MockQuerier_RecurringListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_RecurringListByUser_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Recurring, error)) *MockQuerier_RecurringListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecurringListDue provides a mock function with given fields: ctx, until
+func (_m *MockQuerier) RecurringListDue(ctx context.Context, until sql.NullTime) ([]*dao.Recurring, error) {
+	ret := _m.Called(ctx, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecurringListDue")
+	}
+
+	var r0 []*dao.Recurring
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullTime) ([]*dao.Recurring, error)); ok {
+		return rf(ctx, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullTime) []*dao.Recurring); ok {
+		r0 = rf(ctx, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Recurring)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullTime) error); ok {
+		r1 = rf(ctx, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_RecurringListDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecurringListDue'
+type MockQuerier_RecurringListDue_Call struct {
+	*mock.Call
+}
+
+// RecurringListDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - until sql.NullTime
+func (_e *MockQuerier_Expecter) RecurringListDue(ctx interface{}, until interface{}) *MockQuerier_RecurringListDue_Call {
+	return &MockQuerier_RecurringListDue_Call{Call: _e.mock.On("RecurringListDue", ctx, until)}
+}
+
+func (_c *MockQuerier_RecurringListDue_Call) Run(run func(ctx context.Context, until sql.NullTime)) *MockQuerier_RecurringListDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullTime))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RecurringListDue_Call) Return(_a0 []*dao.Recurring, _a1 error) *MockQuerier_RecurringListDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_RecurringListDue_Call) RunAndReturn(run func(context.Context, sql.NullTime) ([]*dao.Recurring, error)) *MockQuerier_RecurringListDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecurringSetCategory provides a mock function with given fields: ctx, newCategoryID, categoryID
+func (_m *MockQuerier) RecurringSetCategory(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString) error {
+	ret := _m.Called(ctx, newCategoryID, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecurringSetCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString, sql.NullString) error); ok {
+		r0 = rf(ctx, newCategoryID, categoryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RecurringSetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecurringSetCategory'
+type MockQuerier_RecurringSetCategory_Call struct {
+	*mock.Call
+}
+
+// RecurringSetCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newCategoryID sql.NullString
+//   - categoryID sql.NullString
+func (_e *MockQuerier_Expecter) RecurringSetCategory(ctx interface{}, newCategoryID interface{}, categoryID interface{}) *MockQuerier_RecurringSetCategory_Call {
+	return &MockQuerier_RecurringSetCategory_Call{Call: _e.mock.On("RecurringSetCategory", ctx, newCategoryID, categoryID)}
+}
+
+func (_c *MockQuerier_RecurringSetCategory_Call) Run(run func(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString)) *MockQuerier_RecurringSetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString), args[2].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RecurringSetCategory_Call) Return(_a0 error) *MockQuerier_RecurringSetCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RecurringSetCategory_Call) RunAndReturn(run func(context.Context, sql.NullString, sql.NullString) error) *MockQuerier_RecurringSetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SecurityPriceLatest provides a mock function with given fields: ctx, ticker, pricedOn
+func (_m *MockQuerier) SecurityPriceLatest(ctx context.Context, ticker string, pricedOn time.Time) (*dao.SecurityPrice, error) {
+	ret := _m.Called(ctx, ticker, pricedOn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SecurityPriceLatest")
+	}
+
+	var r0 *dao.SecurityPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (*dao.SecurityPrice, error)); ok {
+		return rf(ctx, ticker, pricedOn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) *dao.SecurityPrice); ok {
+		r0 = rf(ctx, ticker, pricedOn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.SecurityPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, ticker, pricedOn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_SecurityPriceLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecurityPriceLatest'
+type MockQuerier_SecurityPriceLatest_Call struct {
+	*mock.Call
+}
+
+// SecurityPriceLatest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticker string
+//   - pricedOn time.Time
+func (_e *MockQuerier_Expecter) SecurityPriceLatest(ctx interface{}, ticker interface{}, pricedOn interface{}) *MockQuerier_SecurityPriceLatest_Call {
+	return &MockQuerier_SecurityPriceLatest_Call{Call: _e.mock.On("SecurityPriceLatest", ctx, ticker, pricedOn)}
+}
+
+func (_c *MockQuerier_SecurityPriceLatest_Call) Run(run func(ctx context.Context, ticker string, pricedOn time.Time)) *MockQuerier_SecurityPriceLatest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SecurityPriceLatest_Call) Return(_a0 *dao.SecurityPrice, _a1 error) *MockQuerier_SecurityPriceLatest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_SecurityPriceLatest_Call) RunAndReturn(run func(context.Context, string, time.Time) (*dao.SecurityPrice, error)) *MockQuerier_SecurityPriceLatest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SecurityPriceUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SecurityPriceUpsert(ctx context.Context, arg *dao.SecurityPriceUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SecurityPriceUpsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.SecurityPriceUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_SecurityPriceUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecurityPriceUpsert'
+type MockQuerier_SecurityPriceUpsert_Call struct {
+	*mock.Call
+}
+
+// SecurityPriceUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.SecurityPriceUpsertParams
+func (_e *MockQuerier_Expecter) SecurityPriceUpsert(ctx interface{}, arg interface{}) *MockQuerier_SecurityPriceUpsert_Call {
+	return &MockQuerier_SecurityPriceUpsert_Call{Call: _e.mock.On("SecurityPriceUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_SecurityPriceUpsert_Call) Run(run func(ctx context.Context, arg *dao.SecurityPriceUpsertParams)) *MockQuerier_SecurityPriceUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.SecurityPriceUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SecurityPriceUpsert_Call) Return(_a0 error) *MockQuerier_SecurityPriceUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_SecurityPriceUpsert_Call) RunAndReturn(run func(context.Context, *dao.SecurityPriceUpsertParams) error) *MockQuerier_SecurityPriceUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TagGetByName provides a mock function with given fields: ctx, userID, name
+func (_m *MockQuerier) TagGetByName(ctx context.Context, userID string, name string) (*dao.Tag, error) {
+	ret := _m.Called(ctx, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagGetByName")
+	}
+
+	var r0 *dao.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dao.Tag, error)); ok {
+		return rf(ctx, userID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dao.Tag); ok {
+		r0 = rf(ctx, userID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TagGetByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagGetByName'
+type MockQuerier_TagGetByName_Call struct {
+	*mock.Call
+}
+
+// TagGetByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - name string
+func (_e *MockQuerier_Expecter) TagGetByName(ctx interface{}, userID interface{}, name interface{}) *MockQuerier_TagGetByName_Call {
+	return &MockQuerier_TagGetByName_Call{Call: _e.mock.On("TagGetByName", ctx, userID, name)}
+}
+
+func (_c *MockQuerier_TagGetByName_Call) Run(run func(ctx context.Context, userID string, name string)) *MockQuerier_TagGetByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TagGetByName_Call) Return(_a0 *dao.Tag, _a1 error) *MockQuerier_TagGetByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TagGetByName_Call) RunAndReturn(run func(context.Context, string, string) (*dao.Tag, error)) *MockQuerier_TagGetByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TagInsert provides a mock function with given fields: ctx, iD, userID, name, createdAt
+func (_m *MockQuerier) TagInsert(ctx context.Context, iD string, userID string, name string, createdAt time.Time) error {
+	ret := _m.Called(ctx, iD, userID, name, createdAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time) error); ok {
+		r0 = rf(ctx, iD, userID, name, createdAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_TagInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagInsert'
+type MockQuerier_TagInsert_Call struct {
+	*mock.Call
+}
+
+// TagInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - iD string
+//   - userID string
+//   - name string
+//   - createdAt time.Time
+func (_e *MockQuerier_Expecter) TagInsert(ctx interface{}, iD interface{}, userID interface{}, name interface{}, createdAt interface{}) *MockQuerier_TagInsert_Call {
+	return &MockQuerier_TagInsert_Call{Call: _e.mock.On("TagInsert", ctx, iD, userID, name, createdAt)}
+}
+
+func (_c *MockQuerier_TagInsert_Call) Run(run func(ctx context.Context, iD string, userID string, name string, createdAt time.Time)) *MockQuerier_TagInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TagInsert_Call) Return(_a0 error) *MockQuerier_TagInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_TagInsert_Call) RunAndReturn(run func(context.Context, string, string, string, time.Time) error) *MockQuerier_TagInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TagListByExpense provides a mock function with given fields: ctx, expenseID
+func (_m *MockQuerier) TagListByExpense(ctx context.Context, expenseID string) ([]*dao.Tag, error) {
+	ret := _m.Called(ctx, expenseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagListByExpense")
+	}
+
+	var r0 []*dao.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Tag, error)); ok {
+		return rf(ctx, expenseID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Tag); ok {
+		r0 = rf(ctx, expenseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, expenseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TagListByExpense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagListByExpense'
+type MockQuerier_TagListByExpense_Call struct {
+	*mock.Call
+}
+
+// TagListByExpense is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expenseID string
+func (_e *MockQuerier_Expecter) TagListByExpense(ctx interface{}, expenseID interface{}) *MockQuerier_TagListByExpense_Call {
+	return &MockQuerier_TagListByExpense_Call{Call: _e.mock.On("TagListByExpense", ctx, expenseID)}
+}
+
+func (_c *MockQuerier_TagListByExpense_Call) Run(run func(ctx context.Context, expenseID string)) *MockQuerier_TagListByExpense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TagListByExpense_Call) Return(_a0 []*dao.Tag, _a1 error) *MockQuerier_TagListByExpense_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TagListByExpense_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Tag, error)) *MockQuerier_TagListByExpense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TagListByUser provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) TagListByUser(ctx context.Context, userID string) ([]*dao.Tag, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagListByUser")
+	}
+
+	var r0 []*dao.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Tag, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Tag); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TagListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagListByUser'
+type MockQuerier_TagListByUser_Call struct {
+	*mock.Call
+}
+
+// TagListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockQuerier_Expecter) TagListByUser(ctx interface{}, userID interface{}) *MockQuerier_TagListByUser_Call {
+	return &MockQuerier_TagListByUser_Call{Call: _e.mock.On("TagListByUser", ctx, userID)}
+}
+
+func (_c *MockQuerier_TagListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockQuerier_TagListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TagListByUser_Call) Return(_a0 []*dao.Tag, _a1 error) *MockQuerier_TagListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TagListByUser_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Tag, error)) *MockQuerier_TagListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TradeDelete provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) TradeDelete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TradeDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_TradeDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TradeDelete'
+type MockQuerier_TradeDelete_Call struct {
+	*mock.Call
+}
+
+// TradeDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) TradeDelete(ctx interface{}, id interface{}) *MockQuerier_TradeDelete_Call {
+	return &MockQuerier_TradeDelete_Call{Call: _e.mock.On("TradeDelete", ctx, id)}
+}
+
+func (_c *MockQuerier_TradeDelete_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_TradeDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TradeDelete_Call) Return(_a0 error) *MockQuerier_TradeDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_TradeDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockQuerier_TradeDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TradeGetByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) TradeGetByID(ctx context.Context, id string) (*dao.Trade, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TradeGetByID")
+	}
+
+	var r0 *dao.Trade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Trade, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Trade); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Trade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TradeGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TradeGetByID'
+type MockQuerier_TradeGetByID_Call struct {
+	*mock.Call
+}
+
+// TradeGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) TradeGetByID(ctx interface{}, id interface{}) *MockQuerier_TradeGetByID_Call {
+	return &MockQuerier_TradeGetByID_Call{Call: _e.mock.On("TradeGetByID", ctx, id)}
+}
+
+func (_c *MockQuerier_TradeGetByID_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_TradeGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TradeGetByID_Call) Return(_a0 *dao.Trade, _a1 error) *MockQuerier_TradeGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TradeGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Trade, error)) *MockQuerier_TradeGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TradeInsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) TradeInsert(ctx context.Context, arg *dao.TradeInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TradeInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.TradeInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_TradeInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TradeInsert'
+type MockQuerier_TradeInsert_Call struct {
+	*mock.Call
+}
+
+// TradeInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.TradeInsertParams
+func (_e *MockQuerier_Expecter) TradeInsert(ctx interface{}, arg interface{}) *MockQuerier_TradeInsert_Call {
+	return &MockQuerier_TradeInsert_Call{Call: _e.mock.On("TradeInsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_TradeInsert_Call) Run(run func(ctx context.Context, arg *dao.TradeInsertParams)) *MockQuerier_TradeInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.TradeInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TradeInsert_Call) Return(_a0 error) *MockQuerier_TradeInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_TradeInsert_Call) RunAndReturn(run func(context.Context, *dao.TradeInsertParams) error) *MockQuerier_TradeInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TradeListByWallet provides a mock function with given fields: ctx, walletID
+func (_m *MockQuerier) TradeListByWallet(ctx context.Context, walletID string) ([]*dao.Trade, error) {
+	ret := _m.Called(ctx, walletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TradeListByWallet")
+	}
+
+	var r0 []*dao.Trade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Trade, error)); ok {
+		return rf(ctx, walletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Trade); ok {
+		r0 = rf(ctx, walletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Trade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, walletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TradeListByWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TradeListByWallet'
+type MockQuerier_TradeListByWallet_Call struct {
+	*mock.Call
+}
+
+// TradeListByWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+func (_e *MockQuerier_Expecter) TradeListByWallet(ctx interface{}, walletID interface{}) *MockQuerier_TradeListByWallet_Call {
+	return &MockQuerier_TradeListByWallet_Call{Call: _e.mock.On("TradeListByWallet", ctx, walletID)}
+}
+
+func (_c *MockQuerier_TradeListByWallet_Call) Run(run func(ctx context.Context, walletID string)) *MockQuerier_TradeListByWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TradeListByWallet_Call) Return(_a0 []*dao.Trade, _a1 error) *MockQuerier_TradeListByWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TradeListByWallet_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Trade, error)) *MockQuerier_TradeListByWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferInsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) TransferInsert(ctx context.Context, arg *dao.TransferInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferInsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.TransferInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_TransferInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferInsert'
+type MockQuerier_TransferInsert_Call struct {
+	*mock.Call
+}
+
+// TransferInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.TransferInsertParams
+func (_e *MockQuerier_Expecter) TransferInsert(ctx interface{}, arg interface{}) *MockQuerier_TransferInsert_Call {
+	return &MockQuerier_TransferInsert_Call{Call: _e.mock.On("TransferInsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_TransferInsert_Call) Run(run func(ctx context.Context, arg *dao.TransferInsertParams)) *MockQuerier_TransferInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.TransferInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TransferInsert_Call) Return(_a0 error) *MockQuerier_TransferInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_TransferInsert_Call) RunAndReturn(run func(context.Context, *dao.TransferInsertParams) error) *MockQuerier_TransferInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferListByTransferID provides a mock function with given fields: ctx, transferID
+func (_m *MockQuerier) TransferListByTransferID(ctx context.Context, transferID string) ([]*dao.Transfer, error) {
+	ret := _m.Called(ctx, transferID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferListByTransferID")
+	}
+
+	var r0 []*dao.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Transfer, error)); ok {
+		return rf(ctx, transferID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Transfer); ok {
+		r0 = rf(ctx, transferID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Transfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transferID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TransferListByTransferID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferListByTransferID'
+type MockQuerier_TransferListByTransferID_Call struct {
+	*mock.Call
+}
+
+// TransferListByTransferID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transferID string
+func (_e *MockQuerier_Expecter) TransferListByTransferID(ctx interface{}, transferID interface{}) *MockQuerier_TransferListByTransferID_Call {
+	return &MockQuerier_TransferListByTransferID_Call{Call: _e.mock.On("TransferListByTransferID", ctx, transferID)}
+}
+
+func (_c *MockQuerier_TransferListByTransferID_Call) Run(run func(ctx context.Context, transferID string)) *MockQuerier_TransferListByTransferID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TransferListByTransferID_Call) Return(_a0 []*dao.Transfer, _a1 error) *MockQuerier_TransferListByTransferID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TransferListByTransferID_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Transfer, error)) *MockQuerier_TransferListByTransferID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferListByWallet provides a mock function with given fields: ctx, walletID
+func (_m *MockQuerier) TransferListByWallet(ctx context.Context, walletID string) ([]*dao.Transfer, error) {
+	ret := _m.Called(ctx, walletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferListByWallet")
+	}
+
+	var r0 []*dao.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.Transfer, error)); ok {
+		return rf(ctx, walletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.Transfer); ok {
+		r0 = rf(ctx, walletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Transfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, walletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TransferListByWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferListByWallet'
+type MockQuerier_TransferListByWallet_Call struct {
+	*mock.Call
+}
+
+// TransferListByWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+func (_e *MockQuerier_Expecter) TransferListByWallet(ctx interface{}, walletID interface{}) *MockQuerier_TransferListByWallet_Call {
+	return &MockQuerier_TransferListByWallet_Call{Call: _e.mock.On("TransferListByWallet", ctx, walletID)}
+}
+
+func (_c *MockQuerier_TransferListByWallet_Call) Run(run func(ctx context.Context, walletID string)) *MockQuerier_TransferListByWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TransferListByWallet_Call) Return(_a0 []*dao.Transfer, _a1 error) *MockQuerier_TransferListByWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TransferListByWallet_Call) RunAndReturn(run func(context.Context, string) ([]*dao.Transfer, error)) *MockQuerier_TransferListByWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WalletCount provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) WalletCount(ctx context.Context, userID sql.NullString) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WalletCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullString) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_WalletCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletCount'
+type MockQuerier_WalletCount_Call struct {
+	*mock.Call
+}
+
+// WalletCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID sql.NullString
+func (_e *MockQuerier_Expecter) WalletCount(ctx interface{}, userID interface{}) *MockQuerier_WalletCount_Call {
+	return &MockQuerier_WalletCount_Call{Call: _e.mock.On("WalletCount", ctx, userID)}
+}
+
+func (_c *MockQuerier_WalletCount_Call) Run(run func(ctx context.Context, userID sql.NullString)) *MockQuerier_WalletCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullString))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_WalletCount_Call) Return(_a0 int64, _a1 error) *MockQuerier_WalletCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_WalletCount_Call) RunAndReturn(run func(context.Context, sql.NullString) (int64, error)) *MockQuerier_WalletCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WalletGetByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) WalletGetByID(ctx context.Context, id string) (*dao.Wallet, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WalletGetByID")
+	}
+
+	var r0 *dao.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dao.Wallet, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dao.Wallet); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_WalletGetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletGetByID'
+type MockQuerier_WalletGetByID_Call struct {
+	*mock.Call
+}
+
+// WalletGetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockQuerier_Expecter) WalletGetByID(ctx interface{}, id interface{}) *MockQuerier_WalletGetByID_Call {
+	return &MockQuerier_WalletGetByID_Call{Call: _e.mock.On("WalletGetByID", ctx, id)}
+}
+
+func (_c *MockQuerier_WalletGetByID_Call) Run(run func(ctx context.Context, id string)) *MockQuerier_WalletGetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_WalletGetByID_Call) Return(_a0 *dao.Wallet, _a1 error) *MockQuerier_WalletGetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_WalletGetByID_Call) RunAndReturn(run func(context.Context, string) (*dao.Wallet, error)) *MockQuerier_WalletGetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WalletGrantDelete provides a mock function with given fields: ctx, walletID, userID
+func (_m *MockQuerier) WalletGrantDelete(ctx context.Context, walletID string, userID string) error {
+	ret := _m.Called(ctx, walletID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WalletGrantDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, walletID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_WalletGrantDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletGrantDelete'
+type MockQuerier_WalletGrantDelete_Call struct {
+	*mock.Call
+}
+
+// WalletGrantDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - userID string
+func (_e *MockQuerier_Expecter) WalletGrantDelete(ctx interface{}, walletID interface{}, userID interface{}) *MockQuerier_WalletGrantDelete_Call {
+	return &MockQuerier_WalletGrantDelete_Call{Call: _e.mock.On("WalletGrantDelete", ctx, walletID, userID)}
 }
 
-func (_c *MockQuerier_LocalUserSetPass_Call) Run(run func(ctx context.Context, pwdhash string, email string)) *MockQuerier_LocalUserSetPass_Call {
+func (_c *MockQuerier_WalletGrantDelete_Call) Run(run func(ctx context.Context, walletID string, userID string)) *MockQuerier_WalletGrantDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_LocalUserSetPass_Call) Return(_a0 error) *MockQuerier_LocalUserSetPass_Call {
+func (_c *MockQuerier_WalletGrantDelete_Call) Return(_a0 error) *MockQuerier_WalletGrantDelete_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_LocalUserSetPass_Call) RunAndReturn(run func(context.Context, string, string) error) *MockQuerier_LocalUserSetPass_Call {
+func (_c *MockQuerier_WalletGrantDelete_Call) RunAndReturn(run func(context.Context, string, string) error) *MockQuerier_WalletGrantDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LocalUserUpdate provides a mock function with given fields: ctx, roles, email
-func (_m *MockQuerier) LocalUserUpdate(ctx context.Context, roles string, email string) error {
-	ret := _m.Called(ctx, roles, email)
+// WalletGrantGet provides a mock function with given fields: ctx, walletID, userID
+func (_m *MockQuerier) WalletGrantGet(ctx context.Context, walletID string, userID string) (*dao.WalletGrant, error) {
+	ret := _m.Called(ctx, walletID, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LocalUserUpdate")
+		panic("no return value specified for WalletGrantGet")
+	}
+
+	var r0 *dao.WalletGrant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dao.WalletGrant, error)); ok {
+		return rf(ctx, walletID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dao.WalletGrant); ok {
+		r0 = rf(ctx, walletID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.WalletGrant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, walletID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_WalletGrantGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletGrantGet'
+type MockQuerier_WalletGrantGet_Call struct {
+	*mock.Call
+}
+
+// WalletGrantGet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+//   - userID string
+func (_e *MockQuerier_Expecter) WalletGrantGet(ctx interface{}, walletID interface{}, userID interface{}) *MockQuerier_WalletGrantGet_Call {
+	return &MockQuerier_WalletGrantGet_Call{Call: _e.mock.On("WalletGrantGet", ctx, walletID, userID)}
+}
+
+func (_c *MockQuerier_WalletGrantGet_Call) Run(run func(ctx context.Context, walletID string, userID string)) *MockQuerier_WalletGrantGet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_WalletGrantGet_Call) Return(_a0 *dao.WalletGrant, _a1 error) *MockQuerier_WalletGrantGet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_WalletGrantGet_Call) RunAndReturn(run func(context.Context, string, string) (*dao.WalletGrant, error)) *MockQuerier_WalletGrantGet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WalletGrantListByWallet provides a mock function with given fields: ctx, walletID
+func (_m *MockQuerier) WalletGrantListByWallet(ctx context.Context, walletID string) ([]*dao.WalletGrant, error) {
+	ret := _m.Called(ctx, walletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WalletGrantListByWallet")
+	}
+
+	var r0 []*dao.WalletGrant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*dao.WalletGrant, error)); ok {
+		return rf(ctx, walletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*dao.WalletGrant); ok {
+		r0 = rf(ctx, walletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.WalletGrant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, walletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_WalletGrantListByWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletGrantListByWallet'
+type MockQuerier_WalletGrantListByWallet_Call struct {
+	*mock.Call
+}
+
+// WalletGrantListByWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID string
+func (_e *MockQuerier_Expecter) WalletGrantListByWallet(ctx interface{}, walletID interface{}) *MockQuerier_WalletGrantListByWallet_Call {
+	return &MockQuerier_WalletGrantListByWallet_Call{Call: _e.mock.On("WalletGrantListByWallet", ctx, walletID)}
+}
+
+func (_c *MockQuerier_WalletGrantListByWallet_Call) Run(run func(ctx context.Context, walletID string)) *MockQuerier_WalletGrantListByWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_WalletGrantListByWallet_Call) Return(_a0 []*dao.WalletGrant, _a1 error) *MockQuerier_WalletGrantListByWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_WalletGrantListByWallet_Call) RunAndReturn(run func(context.Context, string) ([]*dao.WalletGrant, error)) *MockQuerier_WalletGrantListByWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WalletGrantUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) WalletGrantUpsert(ctx context.Context, arg *dao.WalletGrantUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WalletGrantUpsert")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, roles, email)
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.WalletGrantUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -582,32 +5198,31 @@ func (_m *MockQuerier) LocalUserUpdate(ctx context.Context, roles string, email
 	return r0
 }
 
-// MockQuerier_LocalUserUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalUserUpdate'
-type MockQuerier_LocalUserUpdate_Call struct {
+// MockQuerier_WalletGrantUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletGrantUpsert'
+type MockQuerier_WalletGrantUpsert_Call struct {
 	*mock.Call
 }
 
-// LocalUserUpdate is a helper method to define mock.On call
+// WalletGrantUpsert is a helper method to define mock.On call
 //   - ctx context.Context
-//   - roles string
-//   - email string
-func (_e *MockQuerier_Expecter) LocalUserUpdate(ctx interface{}, roles interface{}, email interface{}) *MockQuerier_LocalUserUpdate_Call {
-	return &MockQuerier_LocalUserUpdate_Call{Call: _e.mock.On("LocalUserUpdate", ctx, roles, email)}
+//   - arg *dao.WalletGrantUpsertParams
+func (_e *MockQuerier_Expecter) WalletGrantUpsert(ctx interface{}, arg interface{}) *MockQuerier_WalletGrantUpsert_Call {
+	return &MockQuerier_WalletGrantUpsert_Call{Call: _e.mock.On("WalletGrantUpsert", ctx, arg)}
 }
 
-func (_c *MockQuerier_LocalUserUpdate_Call) Run(run func(ctx context.Context, roles string, email string)) *MockQuerier_LocalUserUpdate_Call {
+func (_c *MockQuerier_WalletGrantUpsert_Call) Run(run func(ctx context.Context, arg *dao.WalletGrantUpsertParams)) *MockQuerier_WalletGrantUpsert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(*dao.WalletGrantUpsertParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_LocalUserUpdate_Call) Return(_a0 error) *MockQuerier_LocalUserUpdate_Call {
+func (_c *MockQuerier_WalletGrantUpsert_Call) Return(_a0 error) *MockQuerier_WalletGrantUpsert_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_LocalUserUpdate_Call) RunAndReturn(run func(context.Context, string, string) error) *MockQuerier_LocalUserUpdate_Call {
+func (_c *MockQuerier_WalletGrantUpsert_Call) RunAndReturn(run func(context.Context, *dao.WalletGrantUpsertParams) error) *MockQuerier_WalletGrantUpsert_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -659,17 +5274,182 @@ func (_c *MockQuerier_WalletInsert_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// WalletUpdateBalance provides a mock function with given fields: ctx, balance, iD
-func (_m *MockQuerier) WalletUpdateBalance(ctx context.Context, balance float64, iD string) error {
-	ret := _m.Called(ctx, balance, iD)
+// WalletInsertKind provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) WalletInsertKind(ctx context.Context, arg *dao.WalletInsertKindParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WalletInsertKind")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.WalletInsertKindParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_WalletInsertKind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletInsertKind'
+type MockQuerier_WalletInsertKind_Call struct {
+	*mock.Call
+}
+
+// WalletInsertKind is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.WalletInsertKindParams
+func (_e *MockQuerier_Expecter) WalletInsertKind(ctx interface{}, arg interface{}) *MockQuerier_WalletInsertKind_Call {
+	return &MockQuerier_WalletInsertKind_Call{Call: _e.mock.On("WalletInsertKind", ctx, arg)}
+}
+
+func (_c *MockQuerier_WalletInsertKind_Call) Run(run func(ctx context.Context, arg *dao.WalletInsertKindParams)) *MockQuerier_WalletInsertKind_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.WalletInsertKindParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_WalletInsertKind_Call) Return(_a0 error) *MockQuerier_WalletInsertKind_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_WalletInsertKind_Call) RunAndReturn(run func(context.Context, *dao.WalletInsertKindParams) error) *MockQuerier_WalletInsertKind_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WalletPage provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) WalletPage(ctx context.Context, arg *dao.WalletPageParams) ([]*dao.Wallet, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WalletPage")
+	}
+
+	var r0 []*dao.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.WalletPageParams) ([]*dao.Wallet, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.WalletPageParams) []*dao.Wallet); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.WalletPageParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_WalletPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletPage'
+type MockQuerier_WalletPage_Call struct {
+	*mock.Call
+}
+
+// WalletPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.WalletPageParams
+func (_e *MockQuerier_Expecter) WalletPage(ctx interface{}, arg interface{}) *MockQuerier_WalletPage_Call {
+	return &MockQuerier_WalletPage_Call{Call: _e.mock.On("WalletPage", ctx, arg)}
+}
+
+func (_c *MockQuerier_WalletPage_Call) Run(run func(ctx context.Context, arg *dao.WalletPageParams)) *MockQuerier_WalletPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.WalletPageParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_WalletPage_Call) Return(_a0 []*dao.Wallet, _a1 error) *MockQuerier_WalletPage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_WalletPage_Call) RunAndReturn(run func(context.Context, *dao.WalletPageParams) ([]*dao.Wallet, error)) *MockQuerier_WalletPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WalletPageFromEnd provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) WalletPageFromEnd(ctx context.Context, arg *dao.WalletPageFromEndParams) ([]*dao.Wallet, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WalletPageFromEnd")
+	}
+
+	var r0 []*dao.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.WalletPageFromEndParams) ([]*dao.Wallet, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.WalletPageFromEndParams) []*dao.Wallet); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.WalletPageFromEndParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_WalletPageFromEnd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalletPageFromEnd'
+type MockQuerier_WalletPageFromEnd_Call struct {
+	*mock.Call
+}
+
+// WalletPageFromEnd is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg *dao.WalletPageFromEndParams
+func (_e *MockQuerier_Expecter) WalletPageFromEnd(ctx interface{}, arg interface{}) *MockQuerier_WalletPageFromEnd_Call {
+	return &MockQuerier_WalletPageFromEnd_Call{Call: _e.mock.On("WalletPageFromEnd", ctx, arg)}
+}
+
+func (_c *MockQuerier_WalletPageFromEnd_Call) Run(run func(ctx context.Context, arg *dao.WalletPageFromEndParams)) *MockQuerier_WalletPageFromEnd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.WalletPageFromEndParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_WalletPageFromEnd_Call) Return(_a0 []*dao.Wallet, _a1 error) *MockQuerier_WalletPageFromEnd_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_WalletPageFromEnd_Call) RunAndReturn(run func(context.Context, *dao.WalletPageFromEndParams) ([]*dao.Wallet, error)) *MockQuerier_WalletPageFromEnd_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WalletUpdateBalance provides a mock function with given fields: ctx, delta, iD
+func (_m *MockQuerier) WalletUpdateBalance(ctx context.Context, delta money.Decimal, iD string) error {
+	ret := _m.Called(ctx, delta, iD)
 
 	if len(ret) == 0 {
 		panic("no return value specified for WalletUpdateBalance")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, float64, string) error); ok {
-		r0 = rf(ctx, balance, iD)
+	if rf, ok := ret.Get(0).(func(context.Context, money.Decimal, string) error); ok {
+		r0 = rf(ctx, delta, iD)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -684,15 +5464,15 @@ type MockQuerier_WalletUpdateBalance_Call struct {
 
 // WalletUpdateBalance is a helper method to define mock.On call
 //   - ctx context.Context
-//   - balance float64
+//   - delta money.Decimal
 //   - iD string
-func (_e *MockQuerier_Expecter) WalletUpdateBalance(ctx interface{}, balance interface{}, iD interface{}) *MockQuerier_WalletUpdateBalance_Call {
-	return &MockQuerier_WalletUpdateBalance_Call{Call: _e.mock.On("WalletUpdateBalance", ctx, balance, iD)}
+func (_e *MockQuerier_Expecter) WalletUpdateBalance(ctx interface{}, delta interface{}, iD interface{}) *MockQuerier_WalletUpdateBalance_Call {
+	return &MockQuerier_WalletUpdateBalance_Call{Call: _e.mock.On("WalletUpdateBalance", ctx, delta, iD)}
 }
 
-func (_c *MockQuerier_WalletUpdateBalance_Call) Run(run func(ctx context.Context, balance float64, iD string)) *MockQuerier_WalletUpdateBalance_Call {
+func (_c *MockQuerier_WalletUpdateBalance_Call) Run(run func(ctx context.Context, delta money.Decimal, iD string)) *MockQuerier_WalletUpdateBalance_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(float64), args[2].(string))
+		run(args[0].(context.Context), args[1].(money.Decimal), args[2].(string))
 	})
 	return _c
 }
@@ -702,7 +5482,7 @@ func (_c *MockQuerier_WalletUpdateBalance_Call) Return(_a0 error) *MockQuerier_W
 	return _c
 }
 
-func (_c *MockQuerier_WalletUpdateBalance_Call) RunAndReturn(run func(context.Context, float64, string) error) *MockQuerier_WalletUpdateBalance_Call {
+func (_c *MockQuerier_WalletUpdateBalance_Call) RunAndReturn(run func(context.Context, money.Decimal, string) error) *MockQuerier_WalletUpdateBalance_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,89 @@
+// Package access decides what users may do with wallets, for the GraphQL API as well as for imports, exports and
+// recurring rules running outside of it.
+package access
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+)
+
+// ErrWalletNotFound is returned for wallets which do not exist or the user has no access to.
+var ErrWalletNotFound = fmt.Errorf("wallet not found")
+
+// Level is the access of a user to a wallet, as stored in wallet grants. Values are those of the WalletAccess enum of
+// the GraphQL API.
+type Level string
+
+const (
+	Viewer Level = "viewer"
+	Editor Level = "editor"
+	Owner  Level = "owner"
+)
+
+// Roles of household members, as stored in the database. Values are those of the HouseholdRole enum of the GraphQL
+// API.
+const (
+	HouseholdViewer = "viewer"
+	HouseholdMember = "member"
+	HouseholdOwner  = "owner"
+)
+
+// Rank orders wallet access levels, each level includes permissions of the lower ones.
+var Rank = map[Level]int{
+	Viewer: 1,
+	Editor: 2,
+	Owner:  3,
+}
+
+// HouseholdWallet maps household roles to access of members to household wallets.
+var HouseholdWallet = map[string]Level{
+	HouseholdViewer: Viewer,
+	HouseholdMember: Editor,
+	HouseholdOwner:  Owner,
+}
+
+// Wallet returns the wallet identified by walletID and the access of the user identified by userID to it. The owner of
+// a personal wallet has owner access, members of a household owning the wallet have access matching their role. Wallet
+// grants may give users more access. Wallets the user has no access to are reported as not found.
+func Wallet(ctx context.Context, q dao.Querier, userID, walletID string) (*dao.Wallet, Level, error) {
+	wallet, err := q.WalletGetByID(ctx, walletID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "", ErrWalletNotFound
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot read wallet: %w", err)
+	}
+
+	var access Level
+	switch {
+	case wallet.HouseholdID.Valid:
+		member, err := q.HouseholdMemberGet(ctx, wallet.HouseholdID.String, userID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, "", fmt.Errorf("cannot read household member: %w", err)
+		}
+		if err == nil {
+			access = HouseholdWallet[member.Role]
+		}
+	case wallet.UserID == userID:
+		access = Owner
+	}
+
+	if access != Owner {
+		grant, err := q.WalletGrantGet(ctx, wallet.ID, userID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, "", fmt.Errorf("cannot read wallet grant: %w", err)
+		}
+		if err == nil && Rank[Level(grant.Access)] > Rank[access] {
+			access = Level(grant.Access)
+		}
+	}
+
+	if access == "" {
+		return nil, "", ErrWalletNotFound
+	}
+
+	return wallet, access, nil
+}
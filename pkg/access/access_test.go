@@ -0,0 +1,62 @@
+package access
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestWallet(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	now := time.Now().UTC()
+
+	require.Nil(t, d.HouseholdInsert(ctx, "h1", "Family", now))
+	for user, role := range map[string]string{
+		"parent": HouseholdOwner,
+		"teen":   HouseholdMember,
+		"child":  HouseholdViewer,
+	} {
+		require.Nil(t, d.HouseholdMemberUpsert(ctx, &dao.HouseholdMemberUpsertParams{
+			HouseholdID: "h1", UserID: user, Email: user + "@example.com", Role: role, CreatedAt: now,
+		}))
+	}
+	// The wallet was created by a user who is no longer a member, and is shared with child as an editor.
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{
+		ID: "w1", UserID: "former", HouseholdID: dao.NilStr("h1"), Currency: "PLN", CreatedAt: now,
+	}))
+	require.Nil(t, d.WalletGrantUpsert(ctx, &dao.WalletGrantUpsertParams{
+		WalletID: "w1", UserID: "child", Email: "child@example.com", Access: string(Editor), CreatedAt: now,
+	}))
+
+	tests := []struct {
+		user    string
+		want    Level
+		wantErr error
+	}{
+		{user: "parent", want: Owner},
+		{user: "teen", want: Editor},
+		{user: "child", want: Editor},
+		{user: "former", wantErr: ErrWalletNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.user, func(t *testing.T) {
+			_, access, err := Wallet(ctx, d, tt.user, "w1")
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, access)
+		})
+	}
+
+	wallets, err := d.WalletList(ctx, dao.NilStr("teen"), &dao.Page{})
+	require.Nil(t, err)
+	require.Len(t, wallets, 1)
+	assert.Equal(t, "w1", wallets[0].ID)
+}
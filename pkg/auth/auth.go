@@ -42,6 +42,28 @@ func NewFromConfig(ctx context.Context, log *logz.Log, c *conf.Config, dbQuerier
 	return New(authProvider), nil
 }
 
+// ProviderName returns the name of auth provider managing users.
+func (s *Service) ProviderName() string {
+	return s.provider.ProviderName()
+}
+
+// txProvider is implemented by providers keeping users in the database, so users can be changed in a transaction of
+// the caller.
+type txProvider interface {
+	withTx(tx dao.DBInterface) Provider
+}
+
+// InTx returns a Service changing users with tx, a transaction started by the caller, and true when its provider keeps
+// users in the database. Other providers change users on their own, so s and false are returned for them.
+func (s *Service) InTx(tx dao.DBInterface) (*Service, bool) {
+	p, ok := s.provider.(txProvider)
+	if !ok {
+		return s, false
+	}
+
+	return &Service{provider: p.withTx(tx), cUsers: s.cUsers}, true
+}
+
 func (s *Service) GetUsers(ctx context.Context) ([]*User, error) {
 	users, _, err := s.provider.ListUsers(ctx)
 	if err != nil {
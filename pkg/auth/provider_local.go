@@ -16,6 +16,8 @@ type LocalProvider struct {
 	db   dao.DBInterface
 	log  logz.Logger
 	conf *conf.Auth0
+	// inTx is set when db is the transaction of a caller, see withTx.
+	inTx bool
 }
 
 var _ Provider = (*LocalProvider)(nil)
@@ -28,6 +30,28 @@ func NewLocalProvider(log logz.Logger, dao dao.DBInterface, conf *conf.Auth0) *L
 	}
 }
 
+// withTx returns a copy of the provider making changes with tx, a transaction started by the caller. The caller
+// commits the transaction, together with its own changes.
+func (p *LocalProvider) withTx(tx dao.DBInterface) Provider {
+	return &LocalProvider{db: tx, log: p.log, conf: p.conf, inTx: true}
+}
+
+// begin starts a transaction, or continues the transaction of the caller of withTx.
+func (p *LocalProvider) begin(ctx context.Context) (dao.DBInterface, func(), error) {
+	if p.inTx {
+		return p.db, func() {}, nil
+	}
+	return p.db.BeginTx(ctx)
+}
+
+// commit commits a transaction started by begin, leaving the transaction of the caller of withTx to them.
+func (p *LocalProvider) commit(ctx context.Context, tx dao.DBInterface) error {
+	if p.inTx {
+		return nil
+	}
+	return tx.Commit(ctx)
+}
+
 func userFromLocal(u *dao.LocalUser) *User {
 	return &User{
 		ID:          u.ID,
@@ -67,16 +91,16 @@ func (p *LocalProvider) ListUsers(ctx context.Context) ([]*User, int, error) {
 		return nil, -1, p.log.Errorw(ctx, err, "cannot list users")
 	}
 
-	out := make([]*User, len(usrList))
+	var out []*User
 	for i := range usrList {
-		out[i] = userFromLocal(usrList[i])
+		out = append(out, userFromLocal(usrList[i]))
 	}
 
 	return out, len(out), nil
 }
 
 func (p *LocalProvider) CreateUser(ctx context.Context, email string, name string, roles Roles) (*User, error) {
-	tx, rollbacker, err := p.db.BeginTx(ctx)
+	tx, rollbacker, err := p.begin(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -99,11 +123,11 @@ func (p *LocalProvider) CreateUser(ctx context.Context, email string, name strin
 		return nil, p.log.Errorw(ctx, err, "cannot retrieve user with email", "email", email)
 	}
 
-	return userFromLocal(usr), tx.Commit(ctx)
+	return userFromLocal(usr), p.commit(ctx, tx)
 }
 
 func (p *LocalProvider) AssignRoles(ctx context.Context, email string, roles []RoleID) ([]RoleID, error) {
-	tx, rollbacker, err := p.db.BeginTx(ctx)
+	tx, rollbacker, err := p.begin(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -119,7 +143,7 @@ func (p *LocalProvider) AssignRoles(ctx context.Context, email string, roles []R
 		return nil, p.log.Errorw(ctx, err, "cannot retrieve user with email", "email", email)
 	}
 
-	return RolesFromString(usr.Roles), tx.Commit(ctx)
+	return RolesFromString(usr.Roles), p.commit(ctx, tx)
 }
 
 // CheckPassword compares pass to pwdhash stored in db. Used only in LocalProvider.
@@ -0,0 +1,264 @@
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/piotrekmonko/portfello/dbschema"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"io"
+	"time"
+)
+
+// Format identifies backup archives, Version is the version of their layout.
+const (
+	Format  = "portfello-backup"
+	Version = 1
+)
+
+var (
+	ErrInvalidArchive = fmt.Errorf("invalid backup archive")
+	ErrSchemaVersion  = fmt.Errorf("schema version mismatch")
+	ErrNotEmpty       = fmt.Errorf("database is not empty")
+)
+
+// Header opens an archive. It is followed by one Record per row.
+type Header struct {
+	Format  string `json:"format"`
+	Version int    `json:"version"`
+	// SchemaVersion is the migration version of the backed up database.
+	SchemaVersion uint      `json:"schema_version"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Record holds a row of a table, with column values keyed by column names.
+type Record struct {
+	Table string                 `json:"table"`
+	Row   map[string]interface{} `json:"row"`
+}
+
+// Counts holds the number of rows of each backed up or restored table.
+type Counts map[string]int
+
+// Archiver moves all data of a database to and from a single archive: a gzip compressed stream of JSON lines, a
+// Header followed by Records. Values are stored independently of the database, so archives of a sqlite database can be
+// restored into postgres and back.
+type Archiver struct {
+	conf *conf.Config
+	db   *dao.DAO
+}
+
+func NewArchiver(c *conf.Config, db *dao.DAO) *Archiver {
+	return &Archiver{
+		conf: c,
+		db:   db,
+	}
+}
+
+// Backup writes all rows of the database to w. Rows are read in a single read-only transaction, so the archive is
+// consistent even when the database is in use. The database must be migrated to the latest schema version.
+func (a *Archiver) Backup(ctx context.Context, w io.Writer) (Counts, error) {
+	version, err := a.schemaVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := a.db.DB().BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("cannot start backup: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	gz := gzip.NewWriter(w)
+	out := bufio.NewWriter(gz)
+	enc := json.NewEncoder(out)
+	err = enc.Encode(&Header{Format: Format, Version: Version, SchemaVersion: version, CreatedAt: time.Now().UTC()})
+	if err != nil {
+		return nil, fmt.Errorf("cannot write backup: %w", err)
+	}
+
+	counts := Counts{}
+	for _, t := range tables {
+		if counts[t.name], err = backupTable(ctx, tx, t, enc); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = out.Flush(); err != nil {
+		return nil, fmt.Errorf("cannot write backup: %w", err)
+	}
+	if err = gz.Close(); err != nil {
+		return nil, fmt.Errorf("cannot write backup: %w", err)
+	}
+	return counts, nil
+}
+
+func backupTable(ctx context.Context, tx *sql.Tx, t *table, enc *json.Encoder) (int, error) {
+	rows, err := tx.QueryContext(ctx, t.selectQuery())
+	if err != nil {
+		return 0, fmt.Errorf("cannot read %s: %w", t.name, err)
+	}
+	defer rows.Close()
+
+	var n int
+	for rows.Next() {
+		row, err := t.scanRow(rows)
+		if err != nil {
+			return 0, err
+		}
+		if err = enc.Encode(&Record{Table: t.name, Row: row}); err != nil {
+			return 0, fmt.Errorf("cannot write backup: %w", err)
+		}
+		n++
+	}
+	if err = rows.Err(); err != nil {
+		return 0, fmt.Errorf("cannot read %s: %w", t.name, err)
+	}
+	return n, nil
+}
+
+// Restore migrates the database to the latest schema version and inserts all rows read from an archive made by Backup,
+// in a single transaction. The archive must come from a database of the same schema version and the database must be
+// empty, so nothing is overwritten.
+func (a *Archiver) Restore(ctx context.Context, r io.Reader) (Counts, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	defer gz.Close()
+
+	dec := json.NewDecoder(bufio.NewReader(gz))
+	dec.UseNumber()
+	var header Header
+	if err = dec.Decode(&header); err != nil || header.Format != Format {
+		return nil, fmt.Errorf("%w: missing header", ErrInvalidArchive)
+	}
+	if header.Version != Version {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidArchive, header.Version)
+	}
+
+	if err = a.migrate(); err != nil {
+		return nil, err
+	}
+	version, err := a.schemaVersion()
+	if err != nil {
+		return nil, err
+	}
+	if header.SchemaVersion != version {
+		return nil, fmt.Errorf("%w: backup has schema version %d, database has %d", ErrSchemaVersion, header.SchemaVersion, version)
+	}
+
+	tx, err := a.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot start restore: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err = checkEmpty(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	counts := Counts{}
+	for _, t := range tables {
+		counts[t.name] = 0
+	}
+	inserts := map[string]*sql.Stmt{}
+	for {
+		var record Record
+		err = dec.Decode(&record)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+		}
+
+		t := tableByName(record.Table)
+		if t == nil {
+			return nil, fmt.Errorf("%w: unknown table %q", ErrInvalidArchive, record.Table)
+		}
+		args, err := t.values(record.Row)
+		if err != nil {
+			return nil, err
+		}
+
+		insert, ok := inserts[t.name]
+		if !ok {
+			if insert, err = tx.PrepareContext(ctx, t.insertQuery()); err != nil {
+				return nil, fmt.Errorf("cannot restore %s: %w", t.name, err)
+			}
+			defer insert.Close()
+			inserts[t.name] = insert
+		}
+		if _, err = insert.ExecContext(ctx, args...); err != nil {
+			return nil, fmt.Errorf("cannot restore %s: %w", t.name, err)
+		}
+		counts[t.name]++
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("cannot restore: %w", err)
+	}
+	return counts, nil
+}
+
+// checkEmpty returns ErrNotEmpty if any table holds rows.
+func checkEmpty(ctx context.Context, tx *sql.Tx) error {
+	for _, t := range tables {
+		var n int64
+		if err := tx.QueryRowContext(ctx, "SELECT count(*) FROM "+t.name).Scan(&n); err != nil {
+			return fmt.Errorf("cannot read %s: %w", t.name, err)
+		}
+		if n > 0 {
+			return fmt.Errorf("%w: table %s has %d rows", ErrNotEmpty, t.name, n)
+		}
+	}
+	return nil
+}
+
+// migrate applies missing migrations, as restoring into a new database is the usual case.
+func (a *Archiver) migrate() error {
+	migrator, err := dbschema.NewMigrator(a.conf.DatabaseDSN)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	if err = migrator.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("cannot migrate database: %w", err)
+	}
+	return nil
+}
+
+// schemaVersion returns the migration version of the database, which must be the latest one known to this build, as
+// tables list its columns.
+func (a *Archiver) schemaVersion() (uint, error) {
+	migrator, err := dbschema.NewMigrator(a.conf.DatabaseDSN)
+	if err != nil {
+		return 0, err
+	}
+	defer migrator.Close()
+
+	version, dirty, err := migrator.Version()
+	if err != nil {
+		return 0, fmt.Errorf("cannot read schema version: %w", err)
+	}
+	if dirty {
+		return 0, fmt.Errorf("%w: database is dirty at version %d", ErrSchemaVersion, version)
+	}
+
+	latest, err := dbschema.LatestVersion()
+	if err != nil {
+		return 0, err
+	}
+	if version != latest {
+		return 0, fmt.Errorf("%w: database has schema version %d, expected %d, run migrate up first", ErrSchemaVersion, version, latest)
+	}
+	return version, nil
+}
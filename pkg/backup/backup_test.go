@@ -0,0 +1,193 @@
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"github.com/piotrekmonko/portfello/dbschema"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/logz"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+)
+
+// newTestArchiver returns an Archiver of a fresh sqlite database with all migrations applied.
+func newTestArchiver(t *testing.T) (*Archiver, *dao.DAO) {
+	c := conf.NewTestConfig()
+	c.DatabaseDSN = "sqlite://" + filepath.Join(t.TempDir(), "test.sqlite")
+
+	d, closer, err := dao.NewDAO(context.Background(), logz.NewTestLogger(t).Log, c)
+	require.Nil(t, err)
+	t.Cleanup(closer)
+
+	return NewArchiver(c, d), d
+}
+
+func TestTables(t *testing.T) {
+	ctx := context.Background()
+	_, d := newTestArchiver(t)
+
+	rows, err := d.DB().QueryContext(ctx, "SELECT name FROM sqlite_master WHERE type = 'table' "+
+		"AND name NOT LIKE 'sqlite_%' AND name != 'schema_migrations' ORDER BY name")
+	require.Nil(t, err)
+	var names []string
+	for rows.Next() {
+		var name string
+		require.Nil(t, rows.Scan(&name))
+		names = append(names, name)
+	}
+	require.Nil(t, rows.Err())
+	require.Nil(t, rows.Close())
+
+	archived := Tables()
+	sort.Strings(archived)
+	assert.Equal(t, names, archived, "every table must be archived")
+
+	for _, table := range tables {
+		rows, err := d.DB().QueryContext(ctx, "SELECT * FROM "+table.name+" LIMIT 0")
+		require.Nil(t, err)
+		columns, err := rows.Columns()
+		require.Nil(t, err)
+		require.Nil(t, rows.Close())
+
+		var archived []string
+		for _, c := range table.columns {
+			archived = append(archived, c.name)
+		}
+		assert.ElementsMatch(t, columns, archived, "every column of %s must be archived", table.name)
+	}
+}
+
+func seed(t *testing.T, d *dao.DAO) {
+	ctx := context.Background()
+	now := time.Date(2024, 3, 1, 8, 30, 0, 123456000, time.UTC)
+
+	require.Nil(t, d.LocalUserInsert(ctx, &dao.LocalUserInsertParams{ID: "u1", Email: "one@example.com", DisplayName: "One", Roles: "user", CreatedAt: now}))
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: "u1", Currency: "EUR", CreatedAt: now}))
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w2", UserID: "u1", Currency: "PLN", CreatedAt: now}))
+	// The subcategory sorts before its parent, so restoring it in order of IDs would break the foreign key.
+	require.Nil(t, d.CategoryInsert(ctx, &dao.CategoryInsertParams{ID: "z-food", UserID: "u1", Name: "Food", CreatedAt: now}))
+	require.Nil(t, d.CategoryInsert(ctx, &dao.CategoryInsertParams{ID: "a-coffee", UserID: "u1", ParentID: dao.NilStr("z-food"), Name: "Coffee", CreatedAt: now}))
+	require.Nil(t, d.TagInsert(ctx, "t1", "u1", "vacation", now))
+	require.Nil(t, d.ExpenseInsert(ctx, &dao.ExpenseInsertParams{ID: "e1", WalletID: "w1", Amount: money.MustParse("-12.5"), Description: dao.NilStr("Coffee"), CategoryID: dao.NilStr("a-coffee"), CreatedAt: now}))
+	require.Nil(t, d.ExpenseInsert(ctx, &dao.ExpenseInsertParams{ID: "e2", WalletID: "w1", Amount: money.MustParse("-3"), CreatedAt: now.Add(time.Hour)}))
+	require.Nil(t, d.ExpenseTagInsert(ctx, "e1", "t1"))
+	require.Nil(t, d.TransferInsert(ctx, &dao.TransferInsertParams{ID: "tr1", TransferID: "x1", WalletID: "w1", CounterpartWalletID: "w2", Amount: money.MustParse("-10"), Rate: 4.3125, CreatedAt: now}))
+	require.Nil(t, d.BudgetInsert(ctx, &dao.BudgetInsertParams{ID: "b1", UserID: "u1", WalletID: dao.NilStr("w1"), Currency: "EUR", Period: "month", Amount: money.MustParse("100"), Rollover: true, StartsAt: now, CreatedAt: now}))
+	require.Nil(t, d.HistoryInsert(ctx, &dao.HistoryInsertParams{ID: "h1", Namespace: "expense", Reference: "e1", Event: "created", Email: "one@example.com", CreatedAt: now}))
+}
+
+// records decodes all records of an archive.
+func records(t *testing.T, archive []byte) (*Header, []*Record) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	require.Nil(t, err)
+	dec := json.NewDecoder(gz)
+	dec.UseNumber()
+
+	var header Header
+	require.Nil(t, dec.Decode(&header))
+	var out []*Record
+	for dec.More() {
+		var record Record
+		require.Nil(t, dec.Decode(&record))
+		out = append(out, &record)
+	}
+	return &header, out
+}
+
+func TestArchiver(t *testing.T) {
+	ctx := context.Background()
+	source, d := newTestArchiver(t)
+	seed(t, d)
+
+	var archive bytes.Buffer
+	counts, err := source.Backup(ctx, &archive)
+	require.Nil(t, err)
+	assert.Equal(t, 2, counts["wallet"])
+	assert.Equal(t, 2, counts["expense"])
+	assert.Equal(t, 0, counts["income"])
+
+	header, backedUp := records(t, archive.Bytes())
+	assert.Equal(t, Format, header.Format)
+	latest, err := dbschema.LatestVersion()
+	require.Nil(t, err)
+	assert.Equal(t, latest, header.SchemaVersion)
+	var categories []interface{}
+	for _, r := range backedUp {
+		if r.Table == "category" {
+			categories = append(categories, r.Row["id"])
+		}
+	}
+	assert.Equal(t, []interface{}{"z-food", "a-coffee"}, categories)
+
+	target, restoredDAO := newTestArchiver(t)
+	restored, err := target.Restore(ctx, bytes.NewReader(archive.Bytes()))
+	require.Nil(t, err)
+	assert.Equal(t, counts, restored)
+
+	var again bytes.Buffer
+	_, err = target.Backup(ctx, &again)
+	require.Nil(t, err)
+	_, restoredRecords := records(t, again.Bytes())
+	assert.Equal(t, backedUp, restoredRecords)
+
+	wallet, err := restoredDAO.WalletGetByID(ctx, "w1")
+	require.Nil(t, err)
+	assert.Equal(t, "EUR", wallet.Currency)
+	budget, err := restoredDAO.BudgetGetByID(ctx, "b1")
+	require.Nil(t, err)
+	assert.True(t, budget.Rollover)
+	assert.Equal(t, money.MustParse("100"), budget.Amount)
+
+	_, err = target.Restore(ctx, bytes.NewReader(archive.Bytes()))
+	assert.ErrorIs(t, err, ErrNotEmpty)
+}
+
+func TestArchiver_RestoreInvalid(t *testing.T) {
+	ctx := context.Background()
+	archive := func(lines ...interface{}) *bytes.Buffer {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		enc := json.NewEncoder(gz)
+		for _, line := range lines {
+			require.Nil(t, enc.Encode(line))
+		}
+		require.Nil(t, gz.Close())
+		return &buf
+	}
+	latest, err := dbschema.LatestVersion()
+	require.Nil(t, err)
+	header := &Header{Format: Format, Version: Version, SchemaVersion: latest}
+	now := "2024-03-01T00:00:00Z"
+
+	for name, tc := range map[string]struct {
+		archive *bytes.Buffer
+		err     error
+	}{
+		"not gzip":       {bytes.NewBufferString("id,amount\n"), ErrInvalidArchive},
+		"no header":      {archive(&Record{Table: "wallet"}), ErrInvalidArchive},
+		"newer layout":   {archive(&Header{Format: Format, Version: Version + 1, SchemaVersion: latest}), ErrInvalidArchive},
+		"older schema":   {archive(&Header{Format: Format, Version: Version, SchemaVersion: latest - 1}), ErrSchemaVersion},
+		"unknown table":  {archive(header, &Record{Table: "account", Row: map[string]interface{}{}}), ErrInvalidArchive},
+		"unknown column": {archive(header, &Record{Table: "tag", Row: map[string]interface{}{"id": "t1", "user_id": "u1", "name": "x", "created_at": now, "color": "red"}}), ErrInvalidArchive},
+		"missing value":  {archive(header, &Record{Table: "tag", Row: map[string]interface{}{"id": "t1", "user_id": "u1", "created_at": now}}), ErrInvalidArchive},
+		"bad value":      {archive(header, &Record{Table: "tag", Row: map[string]interface{}{"id": "t1", "user_id": "u1", "name": "x", "created_at": "yesterday"}}), ErrInvalidArchive},
+	} {
+		t.Run(name, func(t *testing.T) {
+			a, d := newTestArchiver(t)
+			_, err := a.Restore(ctx, tc.archive)
+			assert.ErrorIs(t, err, tc.err)
+
+			var n int
+			require.Nil(t, d.DB().QueryRowContext(ctx, "SELECT count(*) FROM tag").Scan(&n))
+			assert.Zero(t, n, "nothing is restored from an invalid archive")
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package backup
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// kind is the type of a column, as understood by every supported database.
+type kind int
+
+const (
+	text kind = iota
+	integer
+	float
+	boolean
+	timestamp
+)
+
+type column struct {
+	name     string
+	kind     kind
+	nullable bool
+}
+
+// table describes how rows of a table are read and written. Rows are archived in order of dependency, so that rows
+// referenced by foreign keys are restored first.
+type table struct {
+	name    string
+	columns []column
+	// from is the FROM clause reading rows in order, defaults to the table itself ordered by its first column.
+	from string
+}
+
+// tables lists every table of the current schema. TestTables fails when a migration changes the schema without
+// updating this list.
+var tables = []*table{
+	{name: "local_user", columns: []column{
+		{name: "id", kind: text},
+		{name: "email", kind: text},
+		{name: "display_name", kind: text},
+		{name: "roles", kind: text},
+		{name: "pwdhash", kind: text},
+		{name: "created_at", kind: timestamp},
+	}},
+	{name: "household", columns: []column{
+		{name: "id", kind: text},
+		{name: "name", kind: text},
+		{name: "created_at", kind: timestamp},
+	}},
+	{name: "household_member", columns: []column{
+		{name: "household_id", kind: text},
+		{name: "user_id", kind: text},
+		{name: "email", kind: text},
+		{name: "role", kind: text},
+		{name: "created_at", kind: timestamp},
+	}},
+	{name: "household_invitation", columns: []column{
+		{name: "id", kind: text},
+		{name: "household_id", kind: text},
+		{name: "user_id", kind: text},
+		{name: "email", kind: text},
+		{name: "role", kind: text},
+		{name: "invited_by", kind: text},
+		{name: "created_at", kind: timestamp},
+	}},
+	{name: "wallet", columns: []column{
+		{name: "id", kind: text},
+		{name: "user_id", kind: text},
+		{name: "currency", kind: text},
+		{name: "created_at", kind: timestamp},
+		{name: "balance", kind: integer},
+		{name: "household_id", kind: text, nullable: true},
+		{name: "kind", kind: text},
+	}},
+	{name: "wallet_grant", columns: []column{
+		{name: "wallet_id", kind: text},
+		{name: "user_id", kind: text},
+		{name: "email", kind: text},
+		{name: "access", kind: text},
+		{name: "created_at", kind: timestamp},
+	}},
+	{
+		name: "category",
+		columns: []column{
+			{name: "id", kind: text},
+			{name: "user_id", kind: text},
+			{name: "parent_id", kind: text, nullable: true},
+			{name: "name", kind: text},
+			{name: "created_at", kind: timestamp},
+		},
+		// Parents come before their subcategories. Categories outside of the tree, which a database not enforcing foreign
+		// keys might hold, come last rather than being left out.
+		from: "category LEFT JOIN (WITH RECURSIVE tree (id, depth) AS (" +
+			"SELECT category.id, 0 FROM category WHERE category.parent_id IS NULL " +
+			"UNION ALL " +
+			"SELECT category.id, tree.depth + 1 FROM category JOIN tree ON category.parent_id = tree.id" +
+			") SELECT id, depth FROM tree) AS tree ON tree.id = category.id " +
+			"ORDER BY tree.depth IS NULL, tree.depth, category.id",
+	},
+	{name: "tag", columns: []column{
+		{name: "id", kind: text},
+		{name: "user_id", kind: text},
+		{name: "name", kind: text},
+		{name: "created_at", kind: timestamp},
+	}},
+	{name: "debt", columns: []column{
+		{name: "id", kind: text},
+		{name: "user_id", kind: text},
+		{name: "name", kind: text},
+		{name: "lender", kind: text, nullable: true},
+		{name: "currency", kind: text},
+		{name: "principal", kind: integer},
+		{name: "interest_rate", kind: float},
+		{name: "term_months", kind: integer},
+		{name: "payment_day", kind: integer},
+		{name: "starts_at", kind: timestamp},
+		{name: "created_at", kind: timestamp},
+	}},
+	{name: "expense", columns: []column{
+		{name: "id", kind: text},
+		{name: "wallet_id", kind: text},
+		{name: "description", kind: text, nullable: true},
+		{name: "created_at", kind: timestamp},
+		{name: "amount", kind: integer},
+		{name: "category_id", kind: text, nullable: true},
+		{name: "external_id", kind: text, nullable: true},
+		{name: "debt_id", kind: text, nullable: true},
+	}},
+	{name: "expense_tag", columns: []column{
+		{name: "expense_id", kind: text},
+		{name: "tag_id", kind: text},
+	}},
+	{name: "income", columns: []column{
+		{name: "id", kind: text},
+		{name: "wallet_id", kind: text},
+		{name: "description", kind: text, nullable: true},
+		{name: "created_at", kind: timestamp},
+		{name: "amount", kind: integer},
+		{name: "external_id", kind: text, nullable: true},
+	}},
+	{name: "transfer", columns: []column{
+		{name: "id", kind: text},
+		{name: "transfer_id", kind: text},
+		{name: "wallet_id", kind: text},
+		{name: "counterpart_wallet_id", kind: text},
+		{name: "rate", kind: float},
+		{name: "description", kind: text, nullable: true},
+		{name: "created_at", kind: timestamp},
+		{name: "amount", kind: integer},
+	}},
+	{name: "trade", columns: []column{
+		{name: "id", kind: text},
+		{name: "wallet_id", kind: text},
+		{name: "ticker", kind: text},
+		{name: "kind", kind: text},
+		{name: "quantity", kind: integer},
+		{name: "price", kind: integer},
+		{name: "fee", kind: integer},
+		{name: "amount", kind: integer},
+		{name: "description", kind: text, nullable: true},
+		{name: "created_at", kind: timestamp},
+	}},
+	{name: "balance_snapshot", columns: []column{
+		{name: "wallet_id", kind: text},
+		{name: "taken_at", kind: timestamp},
+		{name: "balance", kind: integer},
+	}},
+	{name: "exchange_rate", columns: []column{
+		{name: "base", kind: text},
+		{name: "currency", kind: text},
+		{name: "valid_on", kind: timestamp},
+		{name: "rate", kind: float},
+		{name: "source", kind: text},
+		{name: "created_at", kind: timestamp},
+	}},
+	{name: "security_price", columns: []column{
+		{name: "ticker", kind: text},
+		{name: "priced_on", kind: timestamp},
+		{name: "price", kind: integer},
+		{name: "currency", kind: text},
+		{name: "source", kind: text},
+		{name: "created_at", kind: timestamp},
+	}},
+	{name: "budget", columns: []column{
+		{name: "id", kind: text},
+		{name: "user_id", kind: text},
+		{name: "wallet_id", kind: text, nullable: true},
+		{name: "category_id", kind: text, nullable: true},
+		{name: "currency", kind: text},
+		{name: "period", kind: text},
+		{name: "amount", kind: integer},
+		{name: "rollover", kind: boolean},
+		{name: "starts_at", kind: timestamp},
+		{name: "created_at", kind: timestamp},
+	}},
+	{name: "recurring", columns: []column{
+		{name: "id", kind: text},
+		{name: "user_id", kind: text},
+		{name: "email", kind: text},
+		{name: "wallet_id", kind: text},
+		{name: "kind", kind: text},
+		{name: "amount", kind: integer},
+		{name: "description", kind: text, nullable: true},
+		{name: "category_id", kind: text, nullable: true},
+		{name: "rrule", kind: text},
+		{name: "starts_at", kind: timestamp},
+		{name: "ends_at", kind: timestamp, nullable: true},
+		{name: "next_at", kind: timestamp, nullable: true},
+		{name: "occurrences", kind: integer},
+		{name: "created_at", kind: timestamp},
+	}},
+	{name: "history", columns: []column{
+		{name: "id", kind: text},
+		{name: "namespace", kind: text},
+		{name: "event", kind: text},
+		{name: "email", kind: text},
+		{name: "created_at", kind: timestamp},
+		{name: "reference", kind: text},
+	}},
+}
+
+func tableByName(name string) *table {
+	for _, t := range tables {
+		if t.name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// selectQuery reads all rows of t in order.
+func (t *table) selectQuery() string {
+	names := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		names[i] = t.name + "." + c.name
+	}
+
+	from := t.from
+	if from == "" {
+		from = t.name + " ORDER BY " + names[0]
+		if len(names) > 1 {
+			from += ", " + names[1]
+		}
+	}
+	return "SELECT " + strings.Join(names, ", ") + " FROM " + from
+}
+
+// insertQuery writes a row of t. Numbered placeholders are understood by both postgres and sqlite drivers.
+func (t *table) insertQuery() string {
+	names := make([]string, len(t.columns))
+	placeholders := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		names[i] = c.name
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+	return "INSERT INTO " + t.name + " (" + strings.Join(names, ", ") + ") VALUES (" + strings.Join(placeholders, ", ") + ")"
+}
+
+// scanRow reads the current row of rows into a map of column values ready for JSON encoding. Nulls are nil, times are
+// RFC 3339 strings in UTC.
+func (t *table) scanRow(rows *sql.Rows) (map[string]interface{}, error) {
+	dest := make([]interface{}, len(t.columns))
+	for i, c := range t.columns {
+		switch c.kind {
+		case integer:
+			dest[i] = &sql.NullInt64{}
+		case float:
+			dest[i] = &sql.NullFloat64{}
+		case boolean:
+			dest[i] = &sql.NullBool{}
+		case timestamp:
+			dest[i] = &sql.NullTime{}
+		default:
+			dest[i] = &sql.NullString{}
+		}
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", t.name, err)
+	}
+
+	row := make(map[string]interface{}, len(t.columns))
+	for i, c := range t.columns {
+		var value interface{}
+		switch v := dest[i].(type) {
+		case *sql.NullInt64:
+			if v.Valid {
+				value = v.Int64
+			}
+		case *sql.NullFloat64:
+			if v.Valid {
+				value = v.Float64
+			}
+		case *sql.NullBool:
+			if v.Valid {
+				value = v.Bool
+			}
+		case *sql.NullTime:
+			if v.Valid {
+				value = v.Time.UTC().Format(time.RFC3339Nano)
+			}
+		case *sql.NullString:
+			if v.Valid {
+				value = v.String
+			}
+		}
+		row[c.name] = value
+	}
+	return row, nil
+}
+
+// values converts a row decoded from JSON, with numbers as json.Number, to arguments of insertQuery.
+func (t *table) values(row map[string]interface{}) ([]interface{}, error) {
+	for name := range row {
+		if !t.hasColumn(name) {
+			return nil, fmt.Errorf("%w: unknown column %s.%s", ErrInvalidArchive, t.name, name)
+		}
+	}
+
+	args := make([]interface{}, len(t.columns))
+	for i, c := range t.columns {
+		value := row[c.name]
+		if value == nil {
+			if !c.nullable {
+				return nil, fmt.Errorf("%w: %s.%s must not be null", ErrInvalidArchive, t.name, c.name)
+			}
+			continue
+		}
+
+		var err error
+		if args[i], err = c.value(value); err != nil {
+			return nil, fmt.Errorf("%w: %s.%s: %w", ErrInvalidArchive, t.name, c.name, err)
+		}
+	}
+	return args, nil
+}
+
+func (t *table) hasColumn(name string) bool {
+	for _, c := range t.columns {
+		if c.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (c column) value(v interface{}) (interface{}, error) {
+	switch c.kind {
+	case integer:
+		if n, ok := v.(json.Number); ok {
+			return n.Int64()
+		}
+	case float:
+		if n, ok := v.(json.Number); ok {
+			return n.Float64()
+		}
+	case boolean:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	case timestamp:
+		if s, ok := v.(string); ok {
+			t, err := time.Parse(time.RFC3339Nano, s)
+			return t.UTC(), err
+		}
+	default:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected value %v", v)
+}
+
+// Tables returns names of archived tables in the order they are archived.
+func Tables() []string {
+	names := make([]string, len(tables))
+	for i, t := range tables {
+		names[i] = t.name
+	}
+	return names
+}
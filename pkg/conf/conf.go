@@ -15,6 +15,7 @@ type Config struct {
 	Graph       GraphQL `yaml:"graphql" mapstructure:"graphql"`
 	Auth        Auth0   `yaml:"auth" mapstructure:"auth"`
 	Logging     Logging `yaml:"logging" mapstructure:"logging"`
+	Import      Import  `yaml:"import" mapstructure:"import"`
 }
 
 type GraphQL struct {
@@ -42,6 +43,56 @@ type Logging struct {
 	Format string `yaml:"format" mapstructure:"format"`
 }
 
+// Import configures reading of bank statements.
+type Import struct {
+	// CSV maps profile names to the layouts of CSV statements exported by banks.
+	CSV map[string]CSVProfile `yaml:"csv" mapstructure:"csv"`
+	// QIFDateOrder is the order of day, month and year in dates of QIF statements, which varies with the locale of
+	// the exporting program. One of DateOrderMDY (default), DateOrderDMY or DateOrderYMD.
+	QIFDateOrder string `yaml:"qif_date_order" mapstructure:"qif_date_order"`
+}
+
+// Orders of day, month and year in dates.
+const (
+	DateOrderMDY = "mdy"
+	DateOrderDMY = "dmy"
+	DateOrderYMD = "ymd"
+)
+
+// Sign conventions of CSV statement amounts.
+const (
+	// SignExpensesNegative reads amounts as they are, expenses are negative.
+	SignExpensesNegative = "expenses_negative"
+	// SignExpensesPositive negates amounts, for statements listing expenses as positive.
+	SignExpensesPositive = "expenses_positive"
+)
+
+// CSVProfile describes the layout of a CSV statement. Columns are given by their names in the header row, or by
+// their numbers counted from 1.
+type CSVProfile struct {
+	// Delimiter separates fields, defaults to a comma.
+	Delimiter string `yaml:"delimiter" mapstructure:"delimiter"`
+	// SkipRows is the number of lines before the header row, or before the first row without a header.
+	SkipRows int  `yaml:"skip_rows" mapstructure:"skip_rows"`
+	Header   bool `yaml:"header" mapstructure:"header"`
+	// DateFormat is a Go time layout, defaults to 2006-01-02. Dates without time zone are read as UTC.
+	DateFormat string `yaml:"date_format" mapstructure:"date_format"`
+	DateColumn string `yaml:"date_column" mapstructure:"date_column"`
+	// AmountColumn holds signed amounts. Statements with separate columns of money going out and in set DebitColumn
+	// and CreditColumn instead.
+	AmountColumn string `yaml:"amount_column" mapstructure:"amount_column"`
+	DebitColumn  string `yaml:"debit_column" mapstructure:"debit_column"`
+	CreditColumn string `yaml:"credit_column" mapstructure:"credit_column"`
+	// DescriptionColumns are joined with spaces into expense description.
+	DescriptionColumns []string `yaml:"description_columns" mapstructure:"description_columns"`
+	// DecimalSeparator defaults to a dot.
+	DecimalSeparator   string `yaml:"decimal_separator" mapstructure:"decimal_separator"`
+	ThousandsSeparator string `yaml:"thousands_separator" mapstructure:"thousands_separator"`
+	// Sign is one of SignExpensesNegative (default) or SignExpensesPositive. It does not apply to debit and credit
+	// columns, which hold amounts without sign.
+	Sign string `yaml:"sign" mapstructure:"sign"`
+}
+
 func New() *Config {
 	c := &Config{}
 
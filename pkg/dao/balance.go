@@ -0,0 +1,104 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"strings"
+	"time"
+)
+
+// BalanceInterval is the length of periods of a balance history. Values are those of the GraphQL enum.
+type BalanceInterval string
+
+const (
+	BalanceIntervalDay   BalanceInterval = "DAY"
+	BalanceIntervalWeek  BalanceInterval = "WEEK"
+	BalanceIntervalMonth BalanceInterval = "MONTH"
+)
+
+// PeriodSum is the sum of amounts of operations created in a period.
+type PeriodSum struct {
+	// Period is the start of the day, week or month in UTC. Weeks start on Monday.
+	Period time.Time
+	Amount money.Decimal
+}
+
+// walletOperations selects created_at and amount of every operation changing balance of the wallet given as the first
+// argument: its expenses, incomes, both sides of transfers and trades.
+const walletOperations = "(SELECT created_at, amount FROM expense WHERE wallet_id = $1 " +
+	"UNION ALL SELECT created_at, amount FROM income WHERE wallet_id = $1 " +
+	"UNION ALL SELECT created_at, amount FROM transfer WHERE wallet_id = $1 " +
+	"UNION ALL SELECT created_at, amount FROM trade WHERE wallet_id = $1) AS operation"
+
+// OperationSum returns the sum of amounts of operations of a wallet created at or after from and before to. Nil times
+// do not limit the range.
+func (q *DAO) OperationSum(ctx context.Context, walletID string, from, to *time.Time) (money.Decimal, error) {
+	b := &queryBuilder{}
+	b.arg(walletID)
+	operationRange(b, from, to)
+
+	var sum money.Decimal
+	err := q.db.QueryRowContext(ctx, "SELECT COALESCE(SUM(operation.amount), 0) FROM "+walletOperations+b.whereClause(), b.values...).Scan(&sum)
+	if err != nil {
+		return 0, fmt.Errorf("cannot sum operations: %w", err)
+	}
+	return sum, nil
+}
+
+// OperationSums works like OperationSum, but sums operations of every period of given interval separately. Only
+// periods having operations are listed, in order of time.
+func (q *DAO) OperationSums(ctx context.Context, walletID string, from, to *time.Time, interval BalanceInterval) ([]*PeriodSum, error) {
+	b := &queryBuilder{}
+	b.arg(walletID)
+	operationRange(b, from, to)
+
+	period := q.periodStart(strings.ToLower(string(interval)), "operation.created_at")
+	rows, err := q.db.QueryContext(ctx, "SELECT "+period+", SUM(operation.amount) FROM "+walletOperations+b.whereClause()+
+		" GROUP BY "+period+" ORDER BY "+period, b.values...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot sum operations: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*PeriodSum
+	for rows.Next() {
+		var i PeriodSum
+		var period string
+		if err := rows.Scan(&period, &i.Amount); err != nil {
+			return nil, err
+		}
+		if i.Period, err = time.Parse(time.DateOnly, period); err != nil {
+			return nil, fmt.Errorf("cannot read operations period: %w", err)
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func operationRange(b *queryBuilder, from, to *time.Time) {
+	if from != nil {
+		b.where("operation.created_at >= " + b.arg(from.UTC()))
+	}
+	if to != nil {
+		b.where("operation.created_at < " + b.arg(to.UTC()))
+	}
+}
+
+// UpdateBalance adds delta to the balance of a wallet, changed by an operation created at given time, and drops balance
+// snapshots taken since, which no longer hold.
+func (q *Queries) UpdateBalance(ctx context.Context, walletID string, delta money.Decimal, createdAt time.Time) error {
+	if err := q.WalletUpdateBalance(ctx, delta, walletID); err != nil {
+		return fmt.Errorf("cannot update wallet balance: %w", err)
+	}
+	if err := q.BalanceSnapshotDeleteAfter(ctx, walletID, createdAt); err != nil {
+		return fmt.Errorf("cannot update balance snapshots: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestDAO_OperationSums(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDAO(t)
+	now := time.Now().UTC()
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 12, 0, 0, 0, time.UTC)
+	}
+
+	for _, w := range []string{"w1", "w2"} {
+		require.Nil(t, d.WalletInsert(ctx, &WalletInsertParams{ID: w, UserID: "u1", Currency: "EUR", CreatedAt: now}))
+	}
+	require.Nil(t, d.ExpenseInsert(ctx, &ExpenseInsertParams{ID: "e1", WalletID: "w1", Amount: money.MustParse("-10"), CreatedAt: date(1, 31)}))
+	require.Nil(t, d.ExpenseInsert(ctx, &ExpenseInsertParams{ID: "e2", WalletID: "w2", Amount: money.MustParse("-99"), CreatedAt: date(2, 1)}))
+	require.Nil(t, d.IncomeInsert(ctx, &IncomeInsertParams{ID: "i1", WalletID: "w1", Amount: money.MustParse("100"), CreatedAt: date(2, 1)}))
+	require.Nil(t, d.TransferInsert(ctx, &TransferInsertParams{
+		ID: "t1", TransferID: "t", WalletID: "w1", CounterpartWalletID: "w2", Amount: money.MustParse("-25.5"), Rate: 1, CreatedAt: date(3, 15),
+	}))
+	require.Nil(t, d.TradeInsert(ctx, &TradeInsertParams{
+		ID: "b1", WalletID: "w1", Ticker: "AAPL", Kind: "buy", Quantity: money.FromInt(1), Price: money.MustParse("14.5"),
+		Amount: money.MustParse("-14.5"), CreatedAt: date(3, 20),
+	}))
+
+	sums, err := d.OperationSums(ctx, "w1", nil, nil, BalanceIntervalMonth)
+	require.Nil(t, err)
+	assert.Equal(t, []*PeriodSum{
+		{Period: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Amount: money.MustParse("-10")},
+		{Period: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Amount: money.MustParse("100")},
+		{Period: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Amount: money.MustParse("-40")},
+	}, sums)
+
+	from, to := date(1, 29), date(3, 15)
+	sums, err = d.OperationSums(ctx, "w1", &from, &to, BalanceIntervalWeek)
+	require.Nil(t, err)
+	assert.Equal(t, []*PeriodSum{
+		{Period: time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC), Amount: money.MustParse("90")},
+	}, sums)
+
+	sum, err := d.OperationSum(ctx, "w1", &from, nil)
+	require.Nil(t, err)
+	assert.Equal(t, money.MustParse("50"), sum)
+	sum, err = d.OperationSum(ctx, "w1", nil, &from)
+	require.Nil(t, err)
+	assert.Equal(t, money.Decimal(0), sum)
+}
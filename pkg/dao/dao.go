@@ -9,6 +9,7 @@ import (
 	"github.com/piotrekmonko/portfello/dbschema"
 	"github.com/piotrekmonko/portfello/pkg/conf"
 	"github.com/piotrekmonko/portfello/pkg/logz"
+	"github.com/piotrekmonko/portfello/pkg/money"
 	"strings"
 	"time"
 )
@@ -16,6 +17,8 @@ import (
 type DAO struct {
 	log logz.Logger
 	db  DBTX
+	// driver is the database driver, for the few queries which differ between postgres and sqlite
+	driver string
 	// txDepth reports how many times a transaction was started and closed
 	txDepth int
 	*Queries
@@ -23,6 +26,18 @@ type DAO struct {
 
 type DBInterface interface {
 	Querier
+	ExpenseList(ctx context.Context, arg *ExpenseListParams, page *Page) ([]*Expense, error)
+	ExpenseEach(ctx context.Context, arg *ExpenseListParams, fn func(*Expense) error) error
+	ExpenseCount(ctx context.Context, arg *ExpenseListParams) (int64, error)
+	ExpenseSum(ctx context.Context, arg *ExpenseListParams) (money.Decimal, error)
+	WalletList(ctx context.Context, userID sql.NullString, page *Page) ([]*Wallet, error)
+	Audit(ctx context.Context, email, namespace, reference, event string) error
+	HistorySearch(ctx context.Context, filter *HistoryFilter, page *Page) ([]*History, error)
+	HistorySearchCount(ctx context.Context, filter *HistoryFilter) (int64, error)
+	SpendingReport(ctx context.Context, arg *SpendingReportParams) ([]*SpendingGroup, error)
+	UpdateBalance(ctx context.Context, walletID string, delta money.Decimal, createdAt time.Time) error
+	OperationSum(ctx context.Context, walletID string, from, to *time.Time) (money.Decimal, error)
+	OperationSums(ctx context.Context, walletID string, from, to *time.Time, interval BalanceInterval) ([]*PeriodSum, error)
 	DB() *sql.DB
 	Ping(ctx context.Context) error
 	BeginTx(ctx context.Context) (DBInterface, func(), error)
@@ -65,6 +80,7 @@ func NewDAO(ctx context.Context, logz *logz.Log, c *conf.Config) (*DAO, func(),
 	return &DAO{
 			log:     log.Named("dbdao"),
 			db:      db,
+			driver:  driver,
 			Queries: New(db),
 		}, func() {
 			_ = db.Close()
@@ -93,6 +109,7 @@ func (q *DAO) BeginTx(ctx context.Context) (DBInterface, func(), error) {
 	return &DAO{
 			log:     txLog,
 			db:      q.db,
+			driver:  q.driver,
 			txDepth: q.txDepth + 1,
 			Queries: q.WithTx(tx),
 		}, func() {
@@ -121,6 +138,38 @@ func NilStr(s string) sql.NullString {
 	}
 }
 
+// NilStrPtr works like NilStr, but also treats nil pointer as NULL.
+func NilStrPtr(s *string) sql.NullString {
+	if s == nil {
+		return sql.NullString{}
+	}
+	return NilStr(*s)
+}
+
+// StrPtr returns a pointer to string held by s, or nil if s is NULL.
+func StrPtr(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+// NilTime works like NilStr, treating zero time as NULL.
+func NilTime(t time.Time) sql.NullTime {
+	return sql.NullTime{
+		Time:  t,
+		Valid: !t.IsZero(),
+	}
+}
+
+// TimePtr returns a pointer to time held by t, or nil if t is NULL.
+func TimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
 func driverFromDSN(dsn string) (string, string, error) {
 	if dsn == "" {
 		return "", "", fmt.Errorf("empty")
@@ -1,11 +1,30 @@
 package dao
 
 import (
+	"context"
 	"database/sql"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/logz"
+	"path/filepath"
 	"reflect"
 	"testing"
 )
 
+// newTestDAO returns a DAO connected to a fresh sqlite database with all migrations applied. Packages other than dao
+// use daotest.New instead, which cannot be imported here.
+func newTestDAO(tb testing.TB) *DAO {
+	c := conf.NewTestConfig()
+	c.DatabaseDSN = "sqlite://" + filepath.Join(tb.TempDir(), "test.sqlite")
+
+	d, closer, err := NewDAO(context.Background(), logz.NewTestLogger(tb).Log, c)
+	if err != nil {
+		tb.Fatalf("cannot create test dao: %v", err)
+	}
+	tb.Cleanup(closer)
+
+	return d
+}
+
 func TestNilStr(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -37,3 +56,38 @@ func TestNilStr(t *testing.T) {
 		})
 	}
 }
+
+func TestNilStrPtr(t *testing.T) {
+	empty, valid := "", "valid"
+	tests := []struct {
+		name  string
+		input *string
+		want  sql.NullString
+	}{
+		{
+			name:  "nil pointer",
+			input: nil,
+			want:  sql.NullString{},
+		},
+		{
+			name:  "empty string",
+			input: &empty,
+			want:  sql.NullString{},
+		},
+		{
+			name:  "valid string",
+			input: &valid,
+			want: sql.NullString{
+				String: "valid",
+				Valid:  true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NilStrPtr(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NilStrPtr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
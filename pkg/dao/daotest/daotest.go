@@ -0,0 +1,26 @@
+// Package daotest provides a DAO backed by a throwaway sqlite database for tests of packages using pkg/dao.
+package daotest
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/logz"
+	"path/filepath"
+	"testing"
+)
+
+// New returns a DAO connected to a fresh sqlite database with all migrations applied. The database is closed when
+// the test finishes.
+func New(tb testing.TB) *dao.DAO {
+	c := conf.NewTestConfig()
+	c.DatabaseDSN = "sqlite://" + filepath.Join(tb.TempDir(), "test.sqlite")
+
+	d, closer, err := dao.NewDAO(context.Background(), logz.NewTestLogger(tb).Log, c)
+	if err != nil {
+		tb.Fatalf("cannot create test dao: %v", err)
+	}
+	tb.Cleanup(closer)
+
+	return d
+}
@@ -1,6 +1,9 @@
 package dao
 
-import "time"
+import (
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"time"
+)
 
 func (e *Expense) IsOperation() {}
 
@@ -12,7 +15,7 @@ func (e *Expense) GetWalletID() string {
 	return e.WalletID
 }
 
-func (e *Expense) GetAmount() float64 {
+func (e *Expense) GetAmount() money.Decimal {
 	return e.Amount
 }
 
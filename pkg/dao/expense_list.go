@@ -0,0 +1,313 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExpenseSort names the column expenses are sorted by. Expenses with equal values are sorted by id.
+type ExpenseSort string
+
+const (
+	ExpenseSortCreatedAt ExpenseSort = "created_at"
+	ExpenseSortAmount    ExpenseSort = "amount"
+)
+
+// ExpenseListParams selects expenses and their order. Empty fields do not filter, set fields must all match.
+type ExpenseListParams struct {
+	WalletID string
+	// Currency matches expenses of wallets in the given currency.
+	Currency *string
+	// CategoryID matches expenses in the given category or any of its subcategories.
+	CategoryID *string
+	// TagsAny matches expenses having at least one of the tags.
+	TagsAny []string
+	// TagsAll matches expenses having every one of the tags.
+	TagsAll []string
+	// TagUserID is the user owning tags of TagsAny and TagsAll. Tags are per user, so users sharing a wallet may each
+	// have a tag of the same name.
+	TagUserID string
+	// CreatedFrom and CreatedTo match expenses created at or after CreatedFrom and before CreatedTo.
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	// AmountMin and AmountMax match expenses with amount in the inclusive range.
+	AmountMin *money.Decimal
+	AmountMax *money.Decimal
+	// Description matches expenses whose description contains given text, regardless of case.
+	Description *string
+	// Sign matches positive amounts when greater than zero, negative amounts when less than zero.
+	Sign int
+	// Sort defaults to ExpenseSortCreatedAt.
+	Sort       ExpenseSort
+	Descending bool
+}
+
+const expenseColumns = "expense.id, expense.wallet_id, expense.description, expense.created_at, expense.amount, expense.category_id, expense.external_id, expense.debt_id"
+
+// ExpenseList returns a page of expenses matching arg, in the requested order. Nil page returns all of them. The query
+// is built at runtime since sqlc cannot express optional filters and sorting in a way supported by both postgres and
+// sqlite.
+func (q *Queries) ExpenseList(ctx context.Context, arg *ExpenseListParams, page *Page) ([]*Expense, error) {
+	b := &queryBuilder{}
+	with := arg.build(b)
+	if page == nil {
+		page = &Page{}
+	}
+	order := b.page(page, "expense."+string(arg.sort()), arg.sortKey, arg.Descending)
+
+	rows, err := q.db.QueryContext(ctx, with+"SELECT "+expenseColumns+" FROM expense"+b.whereClause()+order, b.values...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list expenses: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*Expense
+	for rows.Next() {
+		i, err := scanExpense(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if page.FromEnd {
+		reverse(items)
+	}
+	return items, nil
+}
+
+// ExpenseEach calls fn with every expense matching arg, in the requested order. Expenses are read one at a time, so
+// any number of them can be exported without holding them in memory. Iteration stops at the first error of fn.
+func (q *Queries) ExpenseEach(ctx context.Context, arg *ExpenseListParams, fn func(*Expense) error) error {
+	b := &queryBuilder{}
+	with := arg.build(b)
+	order := b.page(&Page{}, "expense."+string(arg.sort()), arg.sortKey, arg.Descending)
+
+	rows, err := q.db.QueryContext(ctx, with+"SELECT "+expenseColumns+" FROM expense"+b.whereClause()+order, b.values...)
+	if err != nil {
+		return fmt.Errorf("cannot list expenses: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		i, err := scanExpense(rows)
+		if err != nil {
+			return err
+		}
+		if err = fn(i); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}
+
+// scanExpense reads a row of expenseColumns.
+func scanExpense(rows *sql.Rows) (*Expense, error) {
+	var i Expense
+	err := rows.Scan(
+		&i.ID,
+		&i.WalletID,
+		&i.Description,
+		&i.CreatedAt,
+		&i.Amount,
+		&i.CategoryID,
+		&i.ExternalID,
+		&i.DebtID,
+	)
+	return &i, err
+}
+
+// ExpenseSum returns the total amount of expenses matching arg.
+func (q *Queries) ExpenseSum(ctx context.Context, arg *ExpenseListParams) (money.Decimal, error) {
+	b := &queryBuilder{}
+	with := arg.build(b)
+
+	var sum money.Decimal
+	err := q.db.QueryRowContext(ctx, with+"SELECT COALESCE(SUM(expense.amount), 0) FROM expense"+b.whereClause(), b.values...).Scan(&sum)
+	if err != nil {
+		return 0, fmt.Errorf("cannot sum expenses: %w", err)
+	}
+	return sum, nil
+}
+
+// ExpenseCount returns the number of expenses matching arg.
+func (q *Queries) ExpenseCount(ctx context.Context, arg *ExpenseListParams) (int64, error) {
+	b := &queryBuilder{}
+	with := arg.build(b)
+
+	var count int64
+	err := q.db.QueryRowContext(ctx, with+"SELECT count(*) FROM expense"+b.whereClause(), b.values...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("cannot count expenses: %w", err)
+	}
+	return count, nil
+}
+
+func (arg *ExpenseListParams) sort() ExpenseSort {
+	if arg.Sort == "" {
+		return ExpenseSortCreatedAt
+	}
+	return arg.Sort
+}
+
+// sortKey returns the value of sorted column at cursor c.
+func (arg *ExpenseListParams) sortKey(c *Cursor) interface{} {
+	if arg.sort() == ExpenseSortAmount {
+		return c.Amount
+	}
+	return c.CreatedAt
+}
+
+// build adds filter conditions to b and returns the WITH clause they need.
+func (arg *ExpenseListParams) build(b *queryBuilder) string {
+	var with string
+
+	if arg.WalletID != "" {
+		b.where("expense.wallet_id = " + b.arg(arg.WalletID))
+	}
+
+	if arg.Currency != nil {
+		b.where("expense.wallet_id IN (SELECT wallet.id FROM wallet WHERE wallet.currency = " + b.arg(*arg.Currency) + ")")
+	}
+
+	if arg.CategoryID != nil {
+		with = "WITH RECURSIVE subcategory (id) AS (" +
+			"SELECT category.id FROM category WHERE category.id = " + b.arg(*arg.CategoryID) +
+			" UNION ALL " +
+			"SELECT category.id FROM category JOIN subcategory ON category.parent_id = subcategory.id) "
+		b.where("expense.category_id IN (SELECT id FROM subcategory)")
+	}
+
+	if len(arg.TagsAny) > 0 {
+		b.where("expense.id IN (SELECT expense_tag.expense_id FROM expense_tag JOIN tag ON tag.id = expense_tag.tag_id " +
+			"WHERE tag.user_id = " + b.arg(arg.TagUserID) + " AND tag.name IN (" + b.args(arg.TagsAny) + "))")
+	}
+
+	if len(arg.TagsAll) > 0 {
+		tags := uniqueStrings(arg.TagsAll)
+		b.where("expense.id IN (SELECT expense_tag.expense_id FROM expense_tag JOIN tag ON tag.id = expense_tag.tag_id " +
+			"WHERE tag.user_id = " + b.arg(arg.TagUserID) + " AND tag.name IN (" + b.args(tags) + ") " +
+			"GROUP BY expense_tag.expense_id HAVING count(DISTINCT tag.name) = " + b.arg(len(tags)) + ")")
+	}
+
+	if arg.CreatedFrom != nil {
+		b.where("expense.created_at >= " + b.arg(arg.CreatedFrom.UTC()))
+	}
+	if arg.CreatedTo != nil {
+		b.where("expense.created_at < " + b.arg(arg.CreatedTo.UTC()))
+	}
+
+	if arg.AmountMin != nil {
+		b.where("expense.amount >= " + b.arg(*arg.AmountMin))
+	}
+	if arg.AmountMax != nil {
+		b.where("expense.amount <= " + b.arg(*arg.AmountMax))
+	}
+
+	if arg.Description != nil && *arg.Description != "" {
+		b.where("lower(expense.description) LIKE " + b.arg("%"+escapeLike(strings.ToLower(*arg.Description))+"%") +
+			" ESCAPE '\\'")
+	}
+
+	switch {
+	case arg.Sign > 0:
+		b.where("expense.amount > 0")
+	case arg.Sign < 0:
+		b.where("expense.amount < 0")
+	}
+
+	return with
+}
+
+// queryBuilder collects conditions and their positional arguments. Numbered placeholders are understood by both
+// postgres and sqlite drivers.
+type queryBuilder struct {
+	conditions []string
+	values     []interface{}
+}
+
+func (b *queryBuilder) where(condition string) {
+	b.conditions = append(b.conditions, condition)
+}
+
+func (b *queryBuilder) whereClause() string {
+	if len(b.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(b.conditions, " AND ")
+}
+
+// page adds keyset conditions of p on given column, with ties broken by id of the same table, and returns matching
+// ORDER BY and LIMIT clauses. Key returns the value of column at a cursor. Rows of a page taken from end are selected
+// in reverse order.
+func (b *queryBuilder) page(p *Page, column string, key func(*Cursor) interface{}, desc bool) string {
+	id := column[:strings.LastIndex(column, ".")+1] + "id"
+	forward, backward, asc, reversed := ">", "<", " ASC", " DESC"
+	if desc {
+		forward, backward, asc, reversed = backward, forward, reversed, asc
+	}
+
+	if p.After != nil {
+		value, cursorID := b.arg(key(p.After)), b.arg(p.After.ID)
+		b.where("(" + column + " " + forward + " " + value +
+			" OR (" + column + " = " + value + " AND " + id + " " + forward + " " + cursorID + "))")
+	}
+	if p.Before != nil {
+		value, cursorID := b.arg(key(p.Before)), b.arg(p.Before.ID)
+		b.where("(" + column + " " + backward + " " + value +
+			" OR (" + column + " = " + value + " AND " + id + " " + backward + " " + cursorID + "))")
+	}
+
+	if p.FromEnd {
+		asc = reversed
+	}
+	clause := " ORDER BY " + column + asc + ", " + id + asc
+	if p.Limit > 0 {
+		clause += " LIMIT " + b.arg(p.Limit)
+	}
+	return clause
+}
+
+func (b *queryBuilder) arg(v interface{}) string {
+	b.values = append(b.values, v)
+	return "$" + strconv.Itoa(len(b.values))
+}
+
+func (b *queryBuilder) args(values []string) string {
+	placeholders := make([]string, len(values))
+	for i, v := range values {
+		placeholders[i] = b.arg(v)
+	}
+	return strings.Join(placeholders, ", ")
+}
+
+// escapeLike escapes wildcards of a LIKE pattern, using backslash as the escape character.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,297 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestQueries_ExpenseList(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDAO(t)
+	now := time.Now().UTC()
+
+	for _, w := range []string{"w1", "w2"} {
+		require.Nil(t, d.WalletInsert(ctx, &WalletInsertParams{ID: w, UserID: "u1", Currency: "PLN", CreatedAt: now}))
+	}
+	require.Nil(t, d.CategoryInsert(ctx, &CategoryInsertParams{ID: "food", UserID: "u1", Name: "Food", CreatedAt: now}))
+	require.Nil(t, d.CategoryInsert(ctx, &CategoryInsertParams{
+		ID: "groceries", UserID: "u1", ParentID: NilStr("food"), Name: "Groceries", CreatedAt: now,
+	}))
+	for _, tag := range []string{"vacation", "tax", "work"} {
+		require.Nil(t, d.TagInsert(ctx, tag, "u1", tag, now))
+	}
+	require.Nil(t, d.TagInsert(ctx, "u2-vacation", "u2", "vacation", now))
+
+	expenses := []struct {
+		id       string
+		wallet   string
+		category string
+		tags     []string
+	}{
+		{id: "e1", wallet: "w1", category: "food", tags: []string{"vacation"}},
+		{id: "e2", wallet: "w1", category: "groceries", tags: []string{"vacation", "tax"}},
+		{id: "e3", wallet: "w1", tags: []string{"tax", "work"}},
+		{id: "e4", wallet: "w1", tags: []string{"u2-vacation"}},
+		{id: "e5", wallet: "w2", category: "food", tags: []string{"vacation", "tax"}},
+	}
+	for _, e := range expenses {
+		require.Nil(t, d.ExpenseInsert(ctx, &ExpenseInsertParams{
+			ID: e.id, WalletID: e.wallet, CategoryID: NilStr(e.category), CreatedAt: now,
+		}))
+		for _, tag := range e.tags {
+			require.Nil(t, d.ExpenseTagInsert(ctx, e.id, tag))
+		}
+	}
+
+	food, groceries := "food", "groceries"
+	tests := []struct {
+		name string
+		arg  ExpenseListParams
+		want []string
+	}{
+		{name: "wallet only", arg: ExpenseListParams{WalletID: "w1"}, want: []string{"e1", "e2", "e3", "e4"}},
+		{name: "category with subcategories", arg: ExpenseListParams{WalletID: "w1", CategoryID: &food}, want: []string{"e1", "e2"}},
+		{name: "subcategory", arg: ExpenseListParams{WalletID: "w1", CategoryID: &groceries}, want: []string{"e2"}},
+		{name: "tags any", arg: ExpenseListParams{WalletID: "w1", TagsAny: []string{"vacation", "work"}, TagUserID: "u1"}, want: []string{"e1", "e2", "e3"}},
+		{name: "tags all", arg: ExpenseListParams{WalletID: "w1", TagsAll: []string{"vacation", "tax", "tax"}, TagUserID: "u1"}, want: []string{"e2"}},
+		{name: "unknown tag", arg: ExpenseListParams{WalletID: "w1", TagsAll: []string{"tax", "missing"}, TagUserID: "u1"}, want: nil},
+		{name: "tags of other user", arg: ExpenseListParams{WalletID: "w1", TagsAll: []string{"vacation"}, TagUserID: "u2"}, want: []string{"e4"}},
+		{
+			name: "combined",
+			arg:  ExpenseListParams{WalletID: "w1", CategoryID: &food, TagsAny: []string{"tax", "work"}, TagsAll: []string{"vacation"}, TagUserID: "u1"},
+			want: []string{"e2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.ExpenseList(ctx, &tt.arg, nil)
+			require.Nil(t, err)
+
+			var ids []string
+			for _, e := range got {
+				ids = append(ids, e.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+
+			count, err := d.ExpenseCount(ctx, &tt.arg)
+			require.Nil(t, err)
+			assert.Equal(t, int64(len(tt.want)), count)
+
+			ids = nil
+			err = d.ExpenseEach(ctx, &tt.arg, func(e *Expense) error {
+				ids = append(ids, e.ID)
+				return nil
+			})
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+
+	errStop := fmt.Errorf("stop")
+	var ids []string
+	err := d.ExpenseEach(ctx, &ExpenseListParams{WalletID: "w1"}, func(e *Expense) error {
+		ids = append(ids, e.ID)
+		return errStop
+	})
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, []string{"e1"}, ids)
+}
+
+func TestQueries_ExpenseListPage(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDAO(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.Nil(t, d.WalletInsert(ctx, &WalletInsertParams{ID: "w1", UserID: "u1", Currency: "PLN", CreatedAt: start}))
+	// Expenses e1..e5 are a day apart, except e3b created at the same time as e3a.
+	for i, id := range []string{"e1", "e2", "e3b", "e3a", "e4", "e5"} {
+		day := i
+		if i > 2 {
+			day--
+		}
+		require.Nil(t, d.ExpenseInsert(ctx, &ExpenseInsertParams{
+			ID: id, WalletID: "w1", CreatedAt: start.AddDate(0, 0, day).Add(time.Millisecond * 1500),
+		}))
+	}
+
+	expenses, err := d.ExpenseList(ctx, &ExpenseListParams{WalletID: "w1"}, nil)
+	require.Nil(t, err)
+	cursors := make(map[string]*Cursor)
+	for _, e := range expenses {
+		cursors[e.ID] = &Cursor{CreatedAt: e.CreatedAt, ID: e.ID}
+	}
+
+	tests := []struct {
+		name string
+		page Page
+		want []string
+	}{
+		{name: "first", page: Page{Limit: 2}, want: []string{"e1", "e2"}},
+		{name: "after", page: Page{After: cursors["e2"], Limit: 2}, want: []string{"e3a", "e3b"}},
+		{name: "after same time", page: Page{After: cursors["e3a"], Limit: 2}, want: []string{"e3b", "e4"}},
+		{name: "last", page: Page{Limit: 2, FromEnd: true}, want: []string{"e4", "e5"}},
+		{name: "before", page: Page{Before: cursors["e3b"], Limit: 2, FromEnd: true}, want: []string{"e2", "e3a"}},
+		{name: "between", page: Page{After: cursors["e1"], Before: cursors["e4"]}, want: []string{"e2", "e3a", "e3b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.ExpenseList(ctx, &ExpenseListParams{WalletID: "w1"}, &tt.page)
+			require.Nil(t, err)
+
+			var ids []string
+			for _, e := range got {
+				ids = append(ids, e.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func TestQueries_ExpenseListFilterAndSort(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDAO(t)
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	require.Nil(t, d.WalletInsert(ctx, &WalletInsertParams{ID: "w1", UserID: "u1", Currency: "PLN", CreatedAt: start}))
+	expenses := []struct {
+		id          string
+		day         int
+		amount      string
+		description string
+	}{
+		{id: "e1", day: 0, amount: "-12.50", description: "Groceries at Market"},
+		{id: "e2", day: 1, amount: "-100", description: "Rent 50% share"},
+		{id: "e3", day: 2, amount: "20", description: "Refund for groceries"},
+		{id: "e4", day: 3, amount: "-12.50"},
+		{id: "e5", day: 4, amount: "-0.99", description: "app_store"},
+	}
+	for _, e := range expenses {
+		require.Nil(t, d.ExpenseInsert(ctx, &ExpenseInsertParams{
+			ID:          e.id,
+			WalletID:    "w1",
+			Amount:      money.MustParse(e.amount),
+			Description: NilStr(e.description),
+			CreatedAt:   start.AddDate(0, 0, e.day),
+		}))
+	}
+
+	ptr := func(s string) *string { return &s }
+	amount := func(s string) *money.Decimal {
+		a := money.MustParse(s)
+		return &a
+	}
+	day := func(d int) *time.Time {
+		t := start.AddDate(0, 0, d)
+		return &t
+	}
+
+	tests := []struct {
+		name string
+		arg  ExpenseListParams
+		page *Page
+		want []string
+	}{
+		{name: "default order", want: []string{"e1", "e2", "e3", "e4", "e5"}},
+		{name: "date range", arg: ExpenseListParams{CreatedFrom: day(1), CreatedTo: day(3)}, want: []string{"e2", "e3"}},
+		{name: "amount range", arg: ExpenseListParams{AmountMin: amount("-12.5"), AmountMax: amount("0")}, want: []string{"e1", "e4", "e5"}},
+		{name: "description", arg: ExpenseListParams{Description: ptr("GROCERIES")}, want: []string{"e1", "e3"}},
+		{name: "description wildcards", arg: ExpenseListParams{Description: ptr("50%")}, want: []string{"e2"}},
+		{name: "description underscore", arg: ExpenseListParams{Description: ptr("p_s")}, want: []string{"e5"}},
+		{name: "positive", arg: ExpenseListParams{Sign: 1}, want: []string{"e3"}},
+		{name: "negative", arg: ExpenseListParams{Sign: -1, CreatedFrom: day(2)}, want: []string{"e4", "e5"}},
+		{name: "newest first", arg: ExpenseListParams{Descending: true}, want: []string{"e5", "e4", "e3", "e2", "e1"}},
+		{name: "by amount", arg: ExpenseListParams{Sort: ExpenseSortAmount}, want: []string{"e2", "e1", "e4", "e5", "e3"}},
+		{
+			name: "by amount descending",
+			arg:  ExpenseListParams{Sort: ExpenseSortAmount, Descending: true},
+			want: []string{"e3", "e5", "e4", "e1", "e2"},
+		},
+		{
+			name: "by amount after tie",
+			arg:  ExpenseListParams{Sort: ExpenseSortAmount},
+			page: &Page{After: &Cursor{Amount: money.MustParse("-12.5"), ID: "e1"}, Limit: 2},
+			want: []string{"e4", "e5"},
+		},
+		{
+			name: "by amount descending from end",
+			arg:  ExpenseListParams{Sort: ExpenseSortAmount, Descending: true},
+			page: &Page{Before: &Cursor{Amount: money.MustParse("-12.5"), ID: "e1"}, Limit: 2, FromEnd: true},
+			want: []string{"e5", "e4"},
+		},
+		{
+			name: "newest first after",
+			arg:  ExpenseListParams{Descending: true},
+			page: &Page{After: &Cursor{CreatedAt: *day(2), ID: "e3"}},
+			want: []string{"e2", "e1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.arg.WalletID = "w1"
+			got, err := d.ExpenseList(ctx, &tt.arg, tt.page)
+			require.Nil(t, err)
+
+			var ids []string
+			for _, e := range got {
+				ids = append(ids, e.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func TestQueries_ExpenseSum(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDAO(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	wallets := map[string]string{"w1": "PLN", "w2": "PLN", "w3": "EUR"}
+	for w, currency := range wallets {
+		require.Nil(t, d.WalletInsert(ctx, &WalletInsertParams{ID: w, UserID: "u1", Currency: currency, CreatedAt: start}))
+	}
+	require.Nil(t, d.CategoryInsert(ctx, &CategoryInsertParams{ID: "food", UserID: "u1", Name: "Food", CreatedAt: start}))
+
+	expenses := []struct {
+		wallet   string
+		category string
+		amount   string
+		day      int
+	}{
+		{wallet: "w1", category: "food", amount: "-10.50", day: 0},
+		{wallet: "w1", amount: "-5", day: 1},
+		{wallet: "w2", category: "food", amount: "-20", day: 2},
+		{wallet: "w2", category: "food", amount: "3.25", day: 2},
+		{wallet: "w3", category: "food", amount: "-100", day: 3},
+	}
+	for i, e := range expenses {
+		require.Nil(t, d.ExpenseInsert(ctx, &ExpenseInsertParams{
+			ID: string(rune('a' + i)), WalletID: e.wallet, CategoryID: NilStr(e.category), Amount: money.MustParse(e.amount),
+			CreatedAt: start.AddDate(0, 0, e.day),
+		}))
+	}
+
+	food, pln := "food", "PLN"
+	from, to := start.AddDate(0, 0, 1), start.AddDate(0, 0, 3)
+	tests := []struct {
+		name string
+		arg  ExpenseListParams
+		want string
+	}{
+		{name: "wallet", arg: ExpenseListParams{WalletID: "w1"}, want: "-15.50"},
+		{name: "category in currency", arg: ExpenseListParams{CategoryID: &food, Currency: &pln}, want: "-27.25"},
+		{name: "dates", arg: ExpenseListParams{Currency: &pln, CreatedFrom: &from, CreatedTo: &to}, want: "-21.75"},
+		{name: "none", arg: ExpenseListParams{WalletID: "missing"}, want: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.ExpenseSum(ctx, &tt.arg)
+			require.Nil(t, err)
+			assert.Equal(t, money.MustParse(tt.want), got)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/lithammer/shortuuid/v4"
+	"math"
+	"time"
+)
+
+// HistoryFilter selects history entries. Empty fields do not filter, set fields must all match.
+type HistoryFilter struct {
+	Namespace *string
+	Reference *string
+	Email     *string
+	// CreatedFrom and CreatedTo match entries created at or after CreatedFrom and before CreatedTo.
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+// Audit records in history that the user identified by email triggered event on the resource identified by namespace
+// and reference. Call it on the transaction making the change, so the entry is stored only if the change is.
+func (q *Queries) Audit(ctx context.Context, email, namespace, reference, event string) error {
+	err := q.HistoryInsert(ctx, &HistoryInsertParams{
+		ID:        shortuuid.New(),
+		Namespace: namespace,
+		Reference: reference,
+		Event:     event,
+		Email:     email,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("cannot record history: %w", err)
+	}
+
+	return nil
+}
+
+// HistorySearch returns a page of history entries matching filter, ordered by (created_at, id).
+func (q *Queries) HistorySearch(ctx context.Context, filter *HistoryFilter, page *Page) ([]*History, error) {
+	afterCreatedAt, afterID := cursorArgs(page.After)
+	beforeCreatedAt, beforeID := cursorArgs(page.Before)
+	limit := int32(page.Limit)
+	if limit <= 0 {
+		limit = math.MaxInt32
+	}
+	args := filter.args()
+
+	if !page.FromEnd {
+		return q.HistoryPage(ctx, &HistoryPageParams{
+			Namespace:       args.Namespace,
+			Reference:       args.Reference,
+			Email:           args.Email,
+			CreatedFrom:     args.CreatedFrom,
+			CreatedTo:       args.CreatedTo,
+			AfterCreatedAt:  afterCreatedAt,
+			AfterID:         afterID,
+			BeforeCreatedAt: beforeCreatedAt,
+			BeforeID:        beforeID,
+			PageSize:        limit,
+		})
+	}
+
+	entries, err := q.HistoryPageFromEnd(ctx, &HistoryPageFromEndParams{
+		Namespace:       args.Namespace,
+		Reference:       args.Reference,
+		Email:           args.Email,
+		CreatedFrom:     args.CreatedFrom,
+		CreatedTo:       args.CreatedTo,
+		AfterCreatedAt:  afterCreatedAt,
+		AfterID:         afterID,
+		BeforeCreatedAt: beforeCreatedAt,
+		BeforeID:        beforeID,
+		PageSize:        limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reverse(entries)
+	return entries, nil
+}
+
+// HistorySearchCount returns the number of history entries matching filter.
+func (q *Queries) HistorySearchCount(ctx context.Context, filter *HistoryFilter) (int64, error) {
+	return q.HistoryCount(ctx, filter.args())
+}
+
+// args returns the arguments of sqlc history queries, which are NULL for unset fields.
+func (filter *HistoryFilter) args() *HistoryCountParams {
+	args := &HistoryCountParams{}
+	if filter == nil {
+		return args
+	}
+	args.Namespace = NilStrPtr(filter.Namespace)
+	args.Reference = NilStrPtr(filter.Reference)
+	args.Email = NilStrPtr(filter.Email)
+	if filter.CreatedFrom != nil {
+		args.CreatedFrom = sql.NullTime{Time: filter.CreatedFrom.UTC(), Valid: true}
+	}
+	if filter.CreatedTo != nil {
+		args.CreatedTo = sql.NullTime{Time: filter.CreatedTo.UTC(), Valid: true}
+	}
+	return args
+}
@@ -0,0 +1,94 @@
+package dao
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestQueries_HistorySearch(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDAO(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	entries := []struct {
+		id        string
+		namespace string
+		reference string
+		email     string
+	}{
+		{id: "h1", namespace: "wallet", reference: "w1", email: "one@example.com"},
+		{id: "h2", namespace: "expense", reference: "e1", email: "one@example.com"},
+		{id: "h3", namespace: "wallet", reference: "w1", email: "two@example.com"},
+		{id: "h4", namespace: "local", reference: "0f8fad5b-d9cb-469f-a165-70867728950e", email: "one@example.com"},
+	}
+	for i, e := range entries {
+		require.Nil(t, d.HistoryInsert(ctx, &HistoryInsertParams{
+			ID: e.id, Namespace: e.namespace, Reference: e.reference, Event: "created", Email: e.email,
+			CreatedAt: start.Add(time.Hour * time.Duration(i)),
+		}))
+	}
+	str := func(s string) *string { return &s }
+	at := func(i int) *time.Time {
+		t := start.Add(time.Hour * time.Duration(i))
+		return &t
+	}
+
+	tests := []struct {
+		name      string
+		filter    HistoryFilter
+		page      Page
+		want      []string
+		wantCount int64
+	}{
+		{name: "all", want: []string{"h1", "h2", "h3", "h4"}, wantCount: 4},
+		{name: "resource", filter: HistoryFilter{Namespace: str("wallet"), Reference: str("w1")}, want: []string{"h1", "h3"}, wantCount: 2},
+		{name: "email", filter: HistoryFilter{Email: str("two@example.com")}, want: []string{"h3"}, wantCount: 1},
+		{name: "time", filter: HistoryFilter{CreatedFrom: at(1), CreatedTo: at(3)}, want: []string{"h2", "h3"}, wantCount: 2},
+		{name: "first", page: Page{Limit: 2}, want: []string{"h1", "h2"}, wantCount: 4},
+		{name: "after", page: Page{After: &Cursor{CreatedAt: *at(1), ID: "h2"}}, want: []string{"h3", "h4"}, wantCount: 4},
+		{name: "last", filter: HistoryFilter{Email: str("one@example.com")}, page: Page{Limit: 2, FromEnd: true}, want: []string{"h2", "h4"}, wantCount: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.HistorySearch(ctx, &tt.filter, &tt.page)
+			require.Nil(t, err)
+
+			var ids []string
+			for _, h := range got {
+				ids = append(ids, h.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+
+			count, err := d.HistorySearchCount(ctx, &tt.filter)
+			require.Nil(t, err)
+			assert.Equal(t, tt.wantCount, count)
+		})
+	}
+}
+
+func TestQueries_Audit(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDAO(t)
+
+	q, rollBacker, err := d.BeginTx(ctx)
+	require.Nil(t, err)
+	require.Nil(t, q.Audit(ctx, "one@example.com", "wallet", "w1", "created with currency PLN"))
+	require.Nil(t, q.Commit(ctx))
+	rollBacker()
+
+	q, rollBacker, err = d.BeginTx(ctx)
+	require.Nil(t, err)
+	require.Nil(t, q.Audit(ctx, "one@example.com", "wallet", "w2", "created with currency EUR"))
+	rollBacker()
+
+	entries, err := d.HistorySearch(ctx, &HistoryFilter{}, &Page{})
+	require.Nil(t, err)
+	require.Len(t, entries, 1, "entries of rolled back transactions are not recorded")
+	assert.Equal(t, "wallet", entries[0].Namespace)
+	assert.Equal(t, "w1", entries[0].Reference)
+	assert.Equal(t, "created with currency PLN", entries[0].Event)
+	assert.Equal(t, "one@example.com", entries[0].Email)
+}
@@ -0,0 +1,31 @@
+package dao
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"time"
+)
+
+func (i *Income) IsOperation() {}
+
+func (i *Income) GetID() string {
+	return i.ID
+}
+
+func (i *Income) GetWalletID() string {
+	return i.WalletID
+}
+
+func (i *Income) GetAmount() money.Decimal {
+	return i.Amount
+}
+
+func (i *Income) GetDescription() *string {
+	if !i.Description.Valid {
+		return nil
+	}
+	return &i.Description.String
+}
+
+func (i *Income) GetCreatedAt() time.Time {
+	return i.CreatedAt.UTC()
+}
@@ -7,23 +7,116 @@ package dao
 import (
 	"database/sql"
 	"time"
+
+	"github.com/piotrekmonko/portfello/pkg/money"
 )
 
+type BalanceSnapshot struct {
+	WalletID string
+	TakenAt  time.Time
+	Balance  money.Decimal
+}
+
+type Budget struct {
+	ID         string
+	UserID     string
+	WalletID   sql.NullString
+	CategoryID sql.NullString
+	Currency   string
+	Period     string
+	Amount     money.Decimal
+	Rollover   bool
+	StartsAt   time.Time
+	CreatedAt  time.Time
+}
+
+type Category struct {
+	ID        string
+	UserID    string
+	ParentID  sql.NullString
+	Name      string
+	CreatedAt time.Time
+}
+
+type Debt struct {
+	ID           string
+	UserID       string
+	Name         string
+	Lender       sql.NullString
+	Currency     string
+	Principal    money.Decimal
+	InterestRate float64
+	TermMonths   int32
+	PaymentDay   int32
+	StartsAt     time.Time
+	CreatedAt    time.Time
+}
+
+type ExchangeRate struct {
+	Base      string
+	Currency  string
+	ValidOn   time.Time
+	Rate      float64
+	Source    string
+	CreatedAt time.Time
+}
+
 type Expense struct {
 	ID          string
 	WalletID    string
-	Amount      float64
 	Description sql.NullString
 	CreatedAt   time.Time
+	Amount      money.Decimal
+	CategoryID  sql.NullString
+	ExternalID  sql.NullString
+	DebtID      sql.NullString
+}
+
+type ExpenseTag struct {
+	ExpenseID string
+	TagID     string
 }
 
 type History struct {
 	ID        string
 	Namespace string
-	Reference string
 	Event     string
 	Email     string
 	CreatedAt time.Time
+	Reference string
+}
+
+type Household struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+}
+
+type HouseholdInvitation struct {
+	ID          string
+	HouseholdID string
+	UserID      string
+	Email       string
+	Role        string
+	InvitedBy   string
+	CreatedAt   time.Time
+}
+
+type HouseholdMember struct {
+	HouseholdID string
+	UserID      string
+	Email       string
+	Role        string
+	CreatedAt   time.Time
+}
+
+type Income struct {
+	ID          string
+	WalletID    string
+	Description sql.NullString
+	CreatedAt   time.Time
+	Amount      money.Decimal
+	ExternalID  sql.NullString
 }
 
 type LocalUser struct {
@@ -35,10 +128,77 @@ type LocalUser struct {
 	CreatedAt   time.Time
 }
 
-type Wallet struct {
+type Recurring struct {
+	ID          string
+	UserID      string
+	Email       string
+	WalletID    string
+	Kind        string
+	Amount      money.Decimal
+	Description sql.NullString
+	CategoryID  sql.NullString
+	Rrule       string
+	StartsAt    time.Time
+	EndsAt      sql.NullTime
+	NextAt      sql.NullTime
+	Occurrences int32
+	CreatedAt   time.Time
+}
+
+type SecurityPrice struct {
+	Ticker    string
+	PricedOn  time.Time
+	Price     money.Decimal
+	Currency  string
+	Source    string
+	CreatedAt time.Time
+}
+
+type Tag struct {
 	ID        string
 	UserID    string
-	Balance   float64
-	Currency  string
+	Name      string
+	CreatedAt time.Time
+}
+
+type Trade struct {
+	ID          string
+	WalletID    string
+	Ticker      string
+	Kind        string
+	Quantity    money.Decimal
+	Price       money.Decimal
+	Fee         money.Decimal
+	Amount      money.Decimal
+	Description sql.NullString
+	CreatedAt   time.Time
+}
+
+type Transfer struct {
+	ID                  string
+	TransferID          string
+	WalletID            string
+	CounterpartWalletID string
+	Rate                float64
+	Description         sql.NullString
+	CreatedAt           time.Time
+	Amount              money.Decimal
+}
+
+type Wallet struct {
+	ID          string
+	UserID      string
+	Currency    string
+	CreatedAt   time.Time
+	Balance     money.Decimal
+	HouseholdID sql.NullString
+	Kind        string
+}
+
+type WalletGrant struct {
+	WalletID  string
+	UserID    string
+	Email     string
+	Access    string
 	CreatedAt time.Time
 }
@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"math"
+	"time"
+)
+
+// Cursor is a position in a list ordered by one of its columns and id. Only the sorted column needs to be set.
+type Cursor struct {
+	CreatedAt time.Time
+	Amount    money.Decimal
+	ID        string
+}
+
+// Page selects a slice of an ordered list. Rows are always returned in list order.
+type Page struct {
+	// After and Before exclude rows at or past given positions.
+	After  *Cursor
+	Before *Cursor
+	// Limit is the maximum number of rows to return, zero means no limit.
+	Limit int
+	// FromEnd takes Limit rows closest to Before, instead of closest to After.
+	FromEnd bool
+}
+
+// reverse puts rows of a page taken from end back in ascending order.
+func reverse[T any](items []T) {
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+}
+
+// WalletList returns a page of wallets ordered by (created_at, id). Wallets of all users are listed when userID is NULL.
+func (q *Queries) WalletList(ctx context.Context, userID sql.NullString, page *Page) ([]*Wallet, error) {
+	afterCreatedAt, afterID := cursorArgs(page.After)
+	beforeCreatedAt, beforeID := cursorArgs(page.Before)
+	limit := int32(page.Limit)
+	if limit <= 0 {
+		limit = math.MaxInt32
+	}
+
+	if !page.FromEnd {
+		return q.WalletPage(ctx, &WalletPageParams{
+			UserID:          userID,
+			AfterCreatedAt:  afterCreatedAt,
+			AfterID:         afterID,
+			BeforeCreatedAt: beforeCreatedAt,
+			BeforeID:        beforeID,
+			PageSize:        limit,
+		})
+	}
+
+	wallets, err := q.WalletPageFromEnd(ctx, &WalletPageFromEndParams{
+		UserID:          userID,
+		AfterCreatedAt:  afterCreatedAt,
+		AfterID:         afterID,
+		BeforeCreatedAt: beforeCreatedAt,
+		BeforeID:        beforeID,
+		PageSize:        limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reverse(wallets)
+	return wallets, nil
+}
+
+// cursorArgs returns the arguments of sqlc keyset queries for cursor c, which are NULL when c is nil.
+func cursorArgs(c *Cursor) (sql.NullTime, sql.NullString) {
+	if c == nil {
+		return sql.NullTime{}, sql.NullString{}
+	}
+	return sql.NullTime{Time: c.CreatedAt, Valid: true}, NilStr(c.ID)
+}
@@ -0,0 +1,95 @@
+package dao
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestQueries_WalletList(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDAO(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	wallets := []struct {
+		id   string
+		user string
+	}{
+		{id: "w1", user: "u1"}, {id: "w2", user: "u2"}, {id: "w3", user: "u1"}, {id: "w4", user: "u1"},
+	}
+	for i, w := range wallets {
+		require.Nil(t, d.WalletInsert(ctx, &WalletInsertParams{
+			ID: w.id, UserID: w.user, Currency: "PLN", CreatedAt: start.Add(time.Hour * time.Duration(i)),
+		}))
+	}
+	require.Nil(t, d.WalletGrantUpsert(ctx, &WalletGrantUpsertParams{
+		WalletID: "w2", UserID: "u3", Email: "u3@example.com", Access: "viewer", CreatedAt: start,
+	}))
+	cursor := func(id string, i int) *Cursor {
+		return &Cursor{CreatedAt: start.Add(time.Hour * time.Duration(i)), ID: id}
+	}
+
+	tests := []struct {
+		name   string
+		userID string
+		page   Page
+		want   []string
+	}{
+		{name: "all users", page: Page{}, want: []string{"w1", "w2", "w3", "w4"}},
+		{name: "single user", userID: "u1", page: Page{}, want: []string{"w1", "w3", "w4"}},
+		{name: "shared", userID: "u3", page: Page{}, want: []string{"w2"}},
+		{name: "first", userID: "u1", page: Page{Limit: 2}, want: []string{"w1", "w3"}},
+		{name: "after", page: Page{After: cursor("w2", 1), Limit: 1}, want: []string{"w3"}},
+		{name: "last", page: Page{Limit: 3, FromEnd: true}, want: []string{"w2", "w3", "w4"}},
+		{name: "before", userID: "u1", page: Page{Before: cursor("w4", 3), Limit: 1, FromEnd: true}, want: []string{"w3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.WalletList(ctx, NilStr(tt.userID), &tt.page)
+			require.Nil(t, err)
+
+			var ids []string
+			for _, w := range got {
+				ids = append(ids, w.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+
+			count, err := d.WalletCount(ctx, NilStr(tt.userID))
+			require.Nil(t, err)
+			assert.Equal(t, int64(map[string]int{"": 4, "u1": 3, "u3": 1}[tt.userID]), count)
+		})
+	}
+}
+
+func TestQueries_WalletListHousehold(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDAO(t)
+	now := time.Now().UTC()
+
+	// The household wallet was created by a user who has left the household since.
+	require.Nil(t, d.HouseholdInsert(ctx, "h1", "Family", now))
+	require.Nil(t, d.HouseholdMemberUpsert(ctx, &HouseholdMemberUpsertParams{
+		HouseholdID: "h1", UserID: "member", Email: "member@example.com", Role: "viewer", CreatedAt: now,
+	}))
+	require.Nil(t, d.WalletInsert(ctx, &WalletInsertParams{ID: "w1", UserID: "former", HouseholdID: NilStr("h1"), Currency: "PLN", CreatedAt: now}))
+	require.Nil(t, d.WalletInsert(ctx, &WalletInsertParams{ID: "w2", UserID: "former", Currency: "PLN", CreatedAt: now}))
+
+	for user, want := range map[string][]string{"member": {"w1"}, "former": {"w2"}} {
+		for _, page := range []Page{{}, {Limit: 5, FromEnd: true}} {
+			got, err := d.WalletList(ctx, NilStr(user), &page)
+			require.Nil(t, err)
+
+			var ids []string
+			for _, w := range got {
+				ids = append(ids, w.ID)
+			}
+			assert.Equal(t, want, ids, user)
+		}
+
+		count, err := d.WalletCount(ctx, NilStr(user))
+		require.Nil(t, err)
+		assert.Equal(t, int64(len(want)), count, user)
+	}
+}
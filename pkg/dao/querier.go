@@ -6,22 +6,129 @@ package dao
 
 import (
 	"context"
+	"database/sql"
+	"time"
+
+	"github.com/piotrekmonko/portfello/pkg/money"
 )
 
 type Querier interface {
+	// BalanceSnapshotDeleteAfter deletes snapshots of a wallet invalidated by an operation created at given time. Call it
+	// whenever an operation is created, changed or deleted.
+	BalanceSnapshotDeleteAfter(ctx context.Context, walletID string, createdAt time.Time) error
+	// BalanceSnapshotLatest returns the latest snapshot of a wallet taken at or before given time.
+	BalanceSnapshotLatest(ctx context.Context, walletID string, takenAt time.Time) (*BalanceSnapshot, error)
+	BalanceSnapshotUpsert(ctx context.Context, walletID string, takenAt time.Time, balance money.Decimal) error
+	BudgetDelete(ctx context.Context, id string) error
+	BudgetDeleteByCategory(ctx context.Context, categoryID sql.NullString) error
+	BudgetGetByID(ctx context.Context, id string) (*Budget, error)
+	BudgetInsert(ctx context.Context, arg *BudgetInsertParams) error
+	BudgetListByUser(ctx context.Context, userID string) ([]*Budget, error)
+	BudgetSetCategory(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString) error
+	BudgetUpdate(ctx context.Context, arg *BudgetUpdateParams) error
+	CategoryCountByUser(ctx context.Context, userID string) (int64, error)
+	CategoryDelete(ctx context.Context, id string) error
+	CategoryGetByID(ctx context.Context, id string) (*Category, error)
+	CategoryInsert(ctx context.Context, arg *CategoryInsertParams) error
+	CategoryListByUser(ctx context.Context, userID string) ([]*Category, error)
+	// CategoryListByWallet lists categories assigned to expenses of a wallet, which may belong to any user sharing it.
+	CategoryListByWallet(ctx context.Context, walletID string) ([]*Category, error)
+	CategorySetParent(ctx context.Context, newParentID sql.NullString, parentID sql.NullString) error
+	CategoryUpdate(ctx context.Context, name string, parentID sql.NullString, iD string) error
+	DebtDelete(ctx context.Context, id string) error
+	DebtGetByID(ctx context.Context, id string) (*Debt, error)
+	DebtInsert(ctx context.Context, arg *DebtInsertParams) error
+	DebtListByUser(ctx context.Context, userID string) ([]*Debt, error)
+	// ExchangeRateBases lists base currencies of rates into given currency.
+	ExchangeRateBases(ctx context.Context, currency string) ([]string, error)
+	// ExchangeRateLatest returns the latest rate of a pair of currencies valid at or before given day.
+	ExchangeRateLatest(ctx context.Context, base string, currency string, validOn time.Time) (*ExchangeRate, error)
+	ExchangeRateUpsert(ctx context.Context, arg *ExchangeRateUpsertParams) error
+	ExpenseClearDebt(ctx context.Context, debtID sql.NullString) error
+	ExpenseDelete(ctx context.Context, id string) error
+	ExpenseExternalIDCount(ctx context.Context, walletID string, externalID sql.NullString) (int64, error)
+	ExpenseGetByID(ctx context.Context, id string) (*Expense, error)
 	ExpenseInsert(ctx context.Context, arg *ExpenseInsertParams) error
+	// ExpenseListByAmount lists expenses of a wallet with given amount, created between created_from and created_to.
+	ExpenseListByAmount(ctx context.Context, walletID string, amount money.Decimal, createdFrom time.Time, createdTo time.Time) ([]*Expense, error)
+	// ExpenseListByDebt lists expenses repaying a debt in order of time.
+	ExpenseListByDebt(ctx context.Context, debtID sql.NullString) ([]*Expense, error)
 	ExpenseListByWallet(ctx context.Context, walletID string) ([]*Expense, error)
 	ExpenseListByWalletByUser(ctx context.Context, walletID string, userID string) ([]*Expense, error)
+	ExpenseSetCategory(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString) error
+	ExpenseSetDebt(ctx context.Context, debtID sql.NullString, expenseID string) error
+	ExpenseSetExternalID(ctx context.Context, externalID sql.NullString, expenseID string) error
+	ExpenseTagDeleteByExpense(ctx context.Context, expenseID string) error
+	ExpenseTagDeleteByName(ctx context.Context, expenseID string, userID string, name string) error
+	ExpenseTagInsert(ctx context.Context, expenseID string, tagID string) error
+	ExpenseUpdate(ctx context.Context, arg *ExpenseUpdateParams) error
+	HistoryCount(ctx context.Context, arg *HistoryCountParams) (int64, error)
 	HistoryInsert(ctx context.Context, arg *HistoryInsertParams) error
 	HistoryList(ctx context.Context) ([]*History, error)
+	HistoryPage(ctx context.Context, arg *HistoryPageParams) ([]*History, error)
+	HistoryPageFromEnd(ctx context.Context, arg *HistoryPageFromEndParams) ([]*History, error)
+	HouseholdGetByID(ctx context.Context, id string) (*Household, error)
+	HouseholdInsert(ctx context.Context, iD string, name string, createdAt time.Time) error
+	HouseholdInvitationDelete(ctx context.Context, id string) error
+	HouseholdInvitationGetByID(ctx context.Context, id string) (*HouseholdInvitation, error)
+	HouseholdInvitationGetByUser(ctx context.Context, householdID string, userID string) (*HouseholdInvitation, error)
+	HouseholdInvitationListByUser(ctx context.Context, userID string) ([]*HouseholdInvitation, error)
+	HouseholdInvitationUpsert(ctx context.Context, arg *HouseholdInvitationUpsertParams) error
+	HouseholdListByUser(ctx context.Context, userID string) ([]*Household, error)
+	HouseholdMemberCountByRole(ctx context.Context, householdID string, role string) (int64, error)
+	HouseholdMemberDelete(ctx context.Context, householdID string, userID string) error
+	HouseholdMemberGet(ctx context.Context, householdID string, userID string) (*HouseholdMember, error)
+	HouseholdMemberListByHousehold(ctx context.Context, householdID string) ([]*HouseholdMember, error)
+	HouseholdMemberUpsert(ctx context.Context, arg *HouseholdMemberUpsertParams) error
+	IncomeDelete(ctx context.Context, id string) error
+	IncomeExternalIDCount(ctx context.Context, walletID string, externalID sql.NullString) (int64, error)
+	IncomeGetByID(ctx context.Context, id string) (*Income, error)
+	IncomeInsert(ctx context.Context, arg *IncomeInsertParams) error
+	IncomeListByWallet(ctx context.Context, walletID string) ([]*Income, error)
+	IncomeUpdate(ctx context.Context, amount money.Decimal, description sql.NullString, createdAt time.Time, iD string) error
 	LocalUserGetByEmail(ctx context.Context, email string) (*LocalUser, error)
 	LocalUserGetByID(ctx context.Context, id string) (*LocalUser, error)
 	LocalUserInsert(ctx context.Context, arg *LocalUserInsertParams) error
 	LocalUserList(ctx context.Context) ([]*LocalUser, error)
 	LocalUserSetPass(ctx context.Context, pwdhash string, email string) error
 	LocalUserUpdate(ctx context.Context, roles string, email string) error
+	RecurringAdvance(ctx context.Context, nextAt sql.NullTime, occurrences int32, ruleID string, previousOccurrences int32) (int64, error)
+	RecurringDelete(ctx context.Context, id string) error
+	RecurringGetByID(ctx context.Context, id string) (*Recurring, error)
+	RecurringInsert(ctx context.Context, arg *RecurringInsertParams) error
+	RecurringListByUser(ctx context.Context, userID string) ([]*Recurring, error)
+	RecurringListDue(ctx context.Context, until sql.NullTime) ([]*Recurring, error)
+	RecurringSetCategory(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString) error
+	// SecurityPriceLatest returns the latest price of a security on given day or before.
+	SecurityPriceLatest(ctx context.Context, ticker string, pricedOn time.Time) (*SecurityPrice, error)
+	SecurityPriceUpsert(ctx context.Context, arg *SecurityPriceUpsertParams) error
+	TagGetByName(ctx context.Context, userID string, name string) (*Tag, error)
+	TagInsert(ctx context.Context, iD string, userID string, name string, createdAt time.Time) error
+	TagListByExpense(ctx context.Context, expenseID string) ([]*Tag, error)
+	TagListByUser(ctx context.Context, userID string) ([]*Tag, error)
+	TradeDelete(ctx context.Context, id string) error
+	TradeGetByID(ctx context.Context, id string) (*Trade, error)
+	TradeInsert(ctx context.Context, arg *TradeInsertParams) error
+	// TradeListByWallet lists trades of a wallet in order of time. Buys come before dividends and sells made at the same
+	// time, so lots are bought before they are sold.
+	TradeListByWallet(ctx context.Context, walletID string) ([]*Trade, error)
+	TransferInsert(ctx context.Context, arg *TransferInsertParams) error
+	TransferListByTransferID(ctx context.Context, transferID string) ([]*Transfer, error)
+	TransferListByWallet(ctx context.Context, walletID string) ([]*Transfer, error)
+	WalletCount(ctx context.Context, userID sql.NullString) (int64, error)
+	WalletGetByID(ctx context.Context, id string) (*Wallet, error)
+	WalletGrantDelete(ctx context.Context, walletID string, userID string) error
+	WalletGrantGet(ctx context.Context, walletID string, userID string) (*WalletGrant, error)
+	WalletGrantListByWallet(ctx context.Context, walletID string) ([]*WalletGrant, error)
+	WalletGrantUpsert(ctx context.Context, arg *WalletGrantUpsertParams) error
 	WalletInsert(ctx context.Context, arg *WalletInsertParams) error
-	WalletUpdateBalance(ctx context.Context, balance float64, iD string) error
+	// WalletInsertKind is WalletInsert of a wallet of given kind, WalletInsert creates cash wallets.
+	WalletInsertKind(ctx context.Context, arg *WalletInsertKindParams) error
+	// WalletPage lists wallets visible to user, all of them without one. Household wallets are visible to members of the
+	// household rather than to their creator, as in access.Wallet.
+	WalletPage(ctx context.Context, arg *WalletPageParams) ([]*Wallet, error)
+	WalletPageFromEnd(ctx context.Context, arg *WalletPageFromEndParams) ([]*Wallet, error)
+	WalletUpdateBalance(ctx context.Context, delta money.Decimal, iD string) error
 	WalletsByAdmin(ctx context.Context) ([]*Wallet, error)
 	WalletsByUser(ctx context.Context, userID string) ([]*Wallet, error)
 }
@@ -9,24 +9,1915 @@ import (
 	"context"
 	"database/sql"
 	"time"
+
+	"github.com/piotrekmonko/portfello/pkg/money"
 )
 
-const expenseInsert = `-- name: ExpenseInsert :exec
-INSERT INTO expense (id, wallet_id, amount, description, created_at) VALUES ($1, $2, $3, $4, $5)
+const balanceSnapshotDeleteAfter = `-- name: BalanceSnapshotDeleteAfter :exec
+DELETE FROM balance_snapshot WHERE wallet_id = $1 AND taken_at > $2
+`
+
+// BalanceSnapshotDeleteAfter deletes snapshots of a wallet invalidated by an operation created at given time. Call it
+// whenever an operation is created, changed or deleted.
+func (q *Queries) BalanceSnapshotDeleteAfter(ctx context.Context, walletID string, createdAt time.Time) error {
+	_, err := q.db.ExecContext(ctx, balanceSnapshotDeleteAfter, walletID, createdAt)
+	return err
+}
+
+const balanceSnapshotLatest = `-- name: BalanceSnapshotLatest :one
+SELECT wallet_id, taken_at, balance FROM balance_snapshot WHERE wallet_id = $1 AND taken_at <= $2
+ORDER BY taken_at DESC LIMIT 1
+`
+
+// BalanceSnapshotLatest returns the latest snapshot of a wallet taken at or before given time.
+func (q *Queries) BalanceSnapshotLatest(ctx context.Context, walletID string, takenAt time.Time) (*BalanceSnapshot, error) {
+	row := q.db.QueryRowContext(ctx, balanceSnapshotLatest, walletID, takenAt)
+	var i BalanceSnapshot
+	err := row.Scan(&i.WalletID, &i.TakenAt, &i.Balance)
+	return &i, err
+}
+
+const balanceSnapshotUpsert = `-- name: BalanceSnapshotUpsert :exec
+INSERT INTO balance_snapshot (wallet_id, taken_at, balance) VALUES ($1, $2, $3)
+ON CONFLICT (wallet_id, taken_at) DO UPDATE SET balance = excluded.balance
+`
+
+func (q *Queries) BalanceSnapshotUpsert(ctx context.Context, walletID string, takenAt time.Time, balance money.Decimal) error {
+	_, err := q.db.ExecContext(ctx, balanceSnapshotUpsert, walletID, takenAt, balance)
+	return err
+}
+
+const budgetDelete = `-- name: BudgetDelete :exec
+DELETE FROM budget WHERE id = $1
+`
+
+func (q *Queries) BudgetDelete(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, budgetDelete, id)
+	return err
+}
+
+const budgetDeleteByCategory = `-- name: BudgetDeleteByCategory :exec
+DELETE FROM budget WHERE category_id = $1
+`
+
+func (q *Queries) BudgetDeleteByCategory(ctx context.Context, categoryID sql.NullString) error {
+	_, err := q.db.ExecContext(ctx, budgetDeleteByCategory, categoryID)
+	return err
+}
+
+const budgetGetByID = `-- name: BudgetGetByID :one
+SELECT id, user_id, wallet_id, category_id, currency, period, amount, rollover, starts_at, created_at FROM budget WHERE id = $1
+`
+
+func (q *Queries) BudgetGetByID(ctx context.Context, id string) (*Budget, error) {
+	row := q.db.QueryRowContext(ctx, budgetGetByID, id)
+	var i Budget
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.WalletID,
+		&i.CategoryID,
+		&i.Currency,
+		&i.Period,
+		&i.Amount,
+		&i.Rollover,
+		&i.StartsAt,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const budgetInsert = `-- name: BudgetInsert :exec
+INSERT INTO budget (id, user_id, wallet_id, category_id, currency, period, amount, rollover, starts_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+type BudgetInsertParams struct {
+	ID         string
+	UserID     string
+	WalletID   sql.NullString
+	CategoryID sql.NullString
+	Currency   string
+	Period     string
+	Amount     money.Decimal
+	Rollover   bool
+	StartsAt   time.Time
+	CreatedAt  time.Time
+}
+
+func (q *Queries) BudgetInsert(ctx context.Context, arg *BudgetInsertParams) error {
+	_, err := q.db.ExecContext(ctx, budgetInsert,
+		arg.ID,
+		arg.UserID,
+		arg.WalletID,
+		arg.CategoryID,
+		arg.Currency,
+		arg.Period,
+		arg.Amount,
+		arg.Rollover,
+		arg.StartsAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const budgetListByUser = `-- name: BudgetListByUser :many
+SELECT id, user_id, wallet_id, category_id, currency, period, amount, rollover, starts_at, created_at FROM budget WHERE user_id = $1 ORDER BY created_at, id
+`
+
+func (q *Queries) BudgetListByUser(ctx context.Context, userID string) ([]*Budget, error) {
+	rows, err := q.db.QueryContext(ctx, budgetListByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Budget
+	for rows.Next() {
+		var i Budget
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.WalletID,
+			&i.CategoryID,
+			&i.Currency,
+			&i.Period,
+			&i.Amount,
+			&i.Rollover,
+			&i.StartsAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const budgetSetCategory = `-- name: BudgetSetCategory :exec
+UPDATE budget SET category_id = $1 WHERE category_id = $2
+`
+
+func (q *Queries) BudgetSetCategory(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString) error {
+	_, err := q.db.ExecContext(ctx, budgetSetCategory, newCategoryID, categoryID)
+	return err
+}
+
+const budgetUpdate = `-- name: BudgetUpdate :exec
+UPDATE budget SET period = $1, amount = $2, rollover = $3, starts_at = $4 WHERE id = $5
+`
+
+type BudgetUpdateParams struct {
+	Period   string
+	Amount   money.Decimal
+	Rollover bool
+	StartsAt time.Time
+	ID       string
+}
+
+func (q *Queries) BudgetUpdate(ctx context.Context, arg *BudgetUpdateParams) error {
+	_, err := q.db.ExecContext(ctx, budgetUpdate,
+		arg.Period,
+		arg.Amount,
+		arg.Rollover,
+		arg.StartsAt,
+		arg.ID,
+	)
+	return err
+}
+
+const categoryCountByUser = `-- name: CategoryCountByUser :one
+SELECT count(*) FROM category WHERE user_id = $1
+`
+
+func (q *Queries) CategoryCountByUser(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, categoryCountByUser, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const categoryDelete = `-- name: CategoryDelete :exec
+DELETE FROM category WHERE id = $1
+`
+
+func (q *Queries) CategoryDelete(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, categoryDelete, id)
+	return err
+}
+
+const categoryGetByID = `-- name: CategoryGetByID :one
+SELECT id, user_id, parent_id, name, created_at FROM category WHERE id = $1
+`
+
+func (q *Queries) CategoryGetByID(ctx context.Context, id string) (*Category, error) {
+	row := q.db.QueryRowContext(ctx, categoryGetByID, id)
+	var i Category
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ParentID,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const categoryInsert = `-- name: CategoryInsert :exec
+INSERT INTO category (id, user_id, parent_id, name, created_at) VALUES ($1, $2, $3, $4, $5)
+`
+
+type CategoryInsertParams struct {
+	ID        string
+	UserID    string
+	ParentID  sql.NullString
+	Name      string
+	CreatedAt time.Time
+}
+
+func (q *Queries) CategoryInsert(ctx context.Context, arg *CategoryInsertParams) error {
+	_, err := q.db.ExecContext(ctx, categoryInsert,
+		arg.ID,
+		arg.UserID,
+		arg.ParentID,
+		arg.Name,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const categoryListByUser = `-- name: CategoryListByUser :many
+SELECT id, user_id, parent_id, name, created_at FROM category WHERE user_id = $1 ORDER BY name, id
+`
+
+func (q *Queries) CategoryListByUser(ctx context.Context, userID string) ([]*Category, error) {
+	rows, err := q.db.QueryContext(ctx, categoryListByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Category
+	for rows.Next() {
+		var i Category
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ParentID,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const categoryListByWallet = `-- name: CategoryListByWallet :many
+SELECT id, user_id, parent_id, name, created_at FROM category WHERE id IN (
+    SELECT expense.category_id FROM expense WHERE expense.wallet_id = $1
+) ORDER BY name, id
+`
+
+// CategoryListByWallet lists categories assigned to expenses of a wallet, which may belong to any user sharing it.
+func (q *Queries) CategoryListByWallet(ctx context.Context, walletID string) ([]*Category, error) {
+	rows, err := q.db.QueryContext(ctx, categoryListByWallet, walletID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Category
+	for rows.Next() {
+		var i Category
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ParentID,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const categorySetParent = `-- name: CategorySetParent :exec
+UPDATE category SET parent_id = $1 WHERE parent_id = $2
+`
+
+func (q *Queries) CategorySetParent(ctx context.Context, newParentID sql.NullString, parentID sql.NullString) error {
+	_, err := q.db.ExecContext(ctx, categorySetParent, newParentID, parentID)
+	return err
+}
+
+const categoryUpdate = `-- name: CategoryUpdate :exec
+UPDATE category SET name = $1, parent_id = $2 WHERE id = $3
+`
+
+func (q *Queries) CategoryUpdate(ctx context.Context, name string, parentID sql.NullString, iD string) error {
+	_, err := q.db.ExecContext(ctx, categoryUpdate, name, parentID, iD)
+	return err
+}
+
+const debtDelete = `-- name: DebtDelete :exec
+DELETE FROM debt WHERE id = $1
+`
+
+func (q *Queries) DebtDelete(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, debtDelete, id)
+	return err
+}
+
+const debtGetByID = `-- name: DebtGetByID :one
+SELECT id, user_id, name, lender, currency, principal, interest_rate, term_months, payment_day, starts_at, created_at FROM debt WHERE id = $1
+`
+
+func (q *Queries) DebtGetByID(ctx context.Context, id string) (*Debt, error) {
+	row := q.db.QueryRowContext(ctx, debtGetByID, id)
+	var i Debt
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Lender,
+		&i.Currency,
+		&i.Principal,
+		&i.InterestRate,
+		&i.TermMonths,
+		&i.PaymentDay,
+		&i.StartsAt,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const debtInsert = `-- name: DebtInsert :exec
+INSERT INTO debt (id, user_id, name, lender, currency, principal, interest_rate, term_months, payment_day, starts_at,
+                  created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+`
+
+type DebtInsertParams struct {
+	ID           string
+	UserID       string
+	Name         string
+	Lender       sql.NullString
+	Currency     string
+	Principal    money.Decimal
+	InterestRate float64
+	TermMonths   int32
+	PaymentDay   int32
+	StartsAt     time.Time
+	CreatedAt    time.Time
+}
+
+func (q *Queries) DebtInsert(ctx context.Context, arg *DebtInsertParams) error {
+	_, err := q.db.ExecContext(ctx, debtInsert,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.Lender,
+		arg.Currency,
+		arg.Principal,
+		arg.InterestRate,
+		arg.TermMonths,
+		arg.PaymentDay,
+		arg.StartsAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const debtListByUser = `-- name: DebtListByUser :many
+SELECT id, user_id, name, lender, currency, principal, interest_rate, term_months, payment_day, starts_at, created_at FROM debt WHERE user_id = $1 ORDER BY starts_at, id
+`
+
+func (q *Queries) DebtListByUser(ctx context.Context, userID string) ([]*Debt, error) {
+	rows, err := q.db.QueryContext(ctx, debtListByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Debt
+	for rows.Next() {
+		var i Debt
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Lender,
+			&i.Currency,
+			&i.Principal,
+			&i.InterestRate,
+			&i.TermMonths,
+			&i.PaymentDay,
+			&i.StartsAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exchangeRateBases = `-- name: ExchangeRateBases :many
+SELECT DISTINCT base FROM exchange_rate WHERE currency = $1 ORDER BY base
+`
+
+// ExchangeRateBases lists base currencies of rates into given currency.
+func (q *Queries) ExchangeRateBases(ctx context.Context, currency string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, exchangeRateBases, currency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var base string
+		if err := rows.Scan(&base); err != nil {
+			return nil, err
+		}
+		items = append(items, base)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exchangeRateLatest = `-- name: ExchangeRateLatest :one
+SELECT base, currency, valid_on, rate, source, created_at FROM exchange_rate WHERE base = $1 AND currency = $2 AND valid_on <= $3
+ORDER BY valid_on DESC LIMIT 1
+`
+
+// ExchangeRateLatest returns the latest rate of a pair of currencies valid at or before given day.
+func (q *Queries) ExchangeRateLatest(ctx context.Context, base string, currency string, validOn time.Time) (*ExchangeRate, error) {
+	row := q.db.QueryRowContext(ctx, exchangeRateLatest, base, currency, validOn)
+	var i ExchangeRate
+	err := row.Scan(
+		&i.Base,
+		&i.Currency,
+		&i.ValidOn,
+		&i.Rate,
+		&i.Source,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const exchangeRateUpsert = `-- name: ExchangeRateUpsert :exec
+INSERT INTO exchange_rate (base, currency, valid_on, rate, source, created_at) VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (base, currency, valid_on) DO UPDATE SET rate = excluded.rate, source = excluded.source,
+    created_at = excluded.created_at
+`
+
+type ExchangeRateUpsertParams struct {
+	Base      string
+	Currency  string
+	ValidOn   time.Time
+	Rate      float64
+	Source    string
+	CreatedAt time.Time
+}
+
+func (q *Queries) ExchangeRateUpsert(ctx context.Context, arg *ExchangeRateUpsertParams) error {
+	_, err := q.db.ExecContext(ctx, exchangeRateUpsert,
+		arg.Base,
+		arg.Currency,
+		arg.ValidOn,
+		arg.Rate,
+		arg.Source,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const expenseClearDebt = `-- name: ExpenseClearDebt :exec
+UPDATE expense SET debt_id = NULL WHERE debt_id = $1
+`
+
+func (q *Queries) ExpenseClearDebt(ctx context.Context, debtID sql.NullString) error {
+	_, err := q.db.ExecContext(ctx, expenseClearDebt, debtID)
+	return err
+}
+
+const expenseDelete = `-- name: ExpenseDelete :exec
+DELETE FROM expense WHERE id = $1
+`
+
+func (q *Queries) ExpenseDelete(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, expenseDelete, id)
+	return err
+}
+
+const expenseExternalIDCount = `-- name: ExpenseExternalIDCount :one
+SELECT count(*) FROM expense WHERE wallet_id = $1 AND external_id = $2
+`
+
+func (q *Queries) ExpenseExternalIDCount(ctx context.Context, walletID string, externalID sql.NullString) (int64, error) {
+	row := q.db.QueryRowContext(ctx, expenseExternalIDCount, walletID, externalID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const expenseGetByID = `-- name: ExpenseGetByID :one
+SELECT id, wallet_id, description, created_at, amount, category_id, external_id, debt_id FROM expense WHERE id = $1
+`
+
+func (q *Queries) ExpenseGetByID(ctx context.Context, id string) (*Expense, error) {
+	row := q.db.QueryRowContext(ctx, expenseGetByID, id)
+	var i Expense
+	err := row.Scan(
+		&i.ID,
+		&i.WalletID,
+		&i.Description,
+		&i.CreatedAt,
+		&i.Amount,
+		&i.CategoryID,
+		&i.ExternalID,
+		&i.DebtID,
+	)
+	return &i, err
+}
+
+const expenseInsert = `-- name: ExpenseInsert :exec
+INSERT INTO expense (id, wallet_id, amount, description, category_id, created_at, external_id) VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type ExpenseInsertParams struct {
+	ID          string
+	WalletID    string
+	Amount      money.Decimal
+	Description sql.NullString
+	CategoryID  sql.NullString
+	CreatedAt   time.Time
+	ExternalID  sql.NullString
+}
+
+func (q *Queries) ExpenseInsert(ctx context.Context, arg *ExpenseInsertParams) error {
+	_, err := q.db.ExecContext(ctx, expenseInsert,
+		arg.ID,
+		arg.WalletID,
+		arg.Amount,
+		arg.Description,
+		arg.CategoryID,
+		arg.CreatedAt,
+		arg.ExternalID,
+	)
+	return err
+}
+
+const expenseListByAmount = `-- name: ExpenseListByAmount :many
+SELECT id, wallet_id, description, created_at, amount, category_id, external_id, debt_id FROM expense
+WHERE wallet_id = $1 AND amount = $2
+  AND created_at >= $3 AND created_at <= $4
+ORDER BY created_at, id
+`
+
+// ExpenseListByAmount lists expenses of a wallet with given amount, created between created_from and created_to.
+func (q *Queries) ExpenseListByAmount(ctx context.Context, walletID string, amount money.Decimal, createdFrom time.Time, createdTo time.Time) ([]*Expense, error) {
+	rows, err := q.db.QueryContext(ctx, expenseListByAmount,
+		walletID,
+		amount,
+		createdFrom,
+		createdTo,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Expense
+	for rows.Next() {
+		var i Expense
+		if err := rows.Scan(
+			&i.ID,
+			&i.WalletID,
+			&i.Description,
+			&i.CreatedAt,
+			&i.Amount,
+			&i.CategoryID,
+			&i.ExternalID,
+			&i.DebtID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const expenseListByDebt = `-- name: ExpenseListByDebt :many
+SELECT id, wallet_id, description, created_at, amount, category_id, external_id, debt_id FROM expense WHERE debt_id = $1 ORDER BY created_at, id
+`
+
+// ExpenseListByDebt lists expenses repaying a debt in order of time.
+func (q *Queries) ExpenseListByDebt(ctx context.Context, debtID sql.NullString) ([]*Expense, error) {
+	rows, err := q.db.QueryContext(ctx, expenseListByDebt, debtID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Expense
+	for rows.Next() {
+		var i Expense
+		if err := rows.Scan(
+			&i.ID,
+			&i.WalletID,
+			&i.Description,
+			&i.CreatedAt,
+			&i.Amount,
+			&i.CategoryID,
+			&i.ExternalID,
+			&i.DebtID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const expenseListByWallet = `-- name: ExpenseListByWallet :many
+SELECT id, wallet_id, description, created_at, amount, category_id, external_id, debt_id FROM expense WHERE wallet_id = $1 ORDER BY id
+`
+
+func (q *Queries) ExpenseListByWallet(ctx context.Context, walletID string) ([]*Expense, error) {
+	rows, err := q.db.QueryContext(ctx, expenseListByWallet, walletID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Expense
+	for rows.Next() {
+		var i Expense
+		if err := rows.Scan(
+			&i.ID,
+			&i.WalletID,
+			&i.Description,
+			&i.CreatedAt,
+			&i.Amount,
+			&i.CategoryID,
+			&i.ExternalID,
+			&i.DebtID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const expenseListByWalletByUser = `-- name: ExpenseListByWalletByUser :many
+SELECT id, wallet_id, description, created_at, amount, category_id, external_id, debt_id FROM expense WHERE wallet_id = $1 AND wallet_id IN (
+    SELECT id FROM wallet WHERE user_id = $2
+) 
+ORDER BY id
+`
+
+func (q *Queries) ExpenseListByWalletByUser(ctx context.Context, walletID string, userID string) ([]*Expense, error) {
+	rows, err := q.db.QueryContext(ctx, expenseListByWalletByUser, walletID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Expense
+	for rows.Next() {
+		var i Expense
+		if err := rows.Scan(
+			&i.ID,
+			&i.WalletID,
+			&i.Description,
+			&i.CreatedAt,
+			&i.Amount,
+			&i.CategoryID,
+			&i.ExternalID,
+			&i.DebtID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const expenseSetCategory = `-- name: ExpenseSetCategory :exec
+UPDATE expense SET category_id = $1 WHERE category_id = $2
+`
+
+func (q *Queries) ExpenseSetCategory(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString) error {
+	_, err := q.db.ExecContext(ctx, expenseSetCategory, newCategoryID, categoryID)
+	return err
+}
+
+const expenseSetDebt = `-- name: ExpenseSetDebt :exec
+UPDATE expense SET debt_id = $1 WHERE id = $2
+`
+
+func (q *Queries) ExpenseSetDebt(ctx context.Context, debtID sql.NullString, expenseID string) error {
+	_, err := q.db.ExecContext(ctx, expenseSetDebt, debtID, expenseID)
+	return err
+}
+
+const expenseSetExternalID = `-- name: ExpenseSetExternalID :exec
+UPDATE expense SET external_id = $1 WHERE id = $2
+`
+
+func (q *Queries) ExpenseSetExternalID(ctx context.Context, externalID sql.NullString, expenseID string) error {
+	_, err := q.db.ExecContext(ctx, expenseSetExternalID, externalID, expenseID)
+	return err
+}
+
+const expenseTagDeleteByExpense = `-- name: ExpenseTagDeleteByExpense :exec
+DELETE FROM expense_tag WHERE expense_id = $1
+`
+
+func (q *Queries) ExpenseTagDeleteByExpense(ctx context.Context, expenseID string) error {
+	_, err := q.db.ExecContext(ctx, expenseTagDeleteByExpense, expenseID)
+	return err
+}
+
+const expenseTagDeleteByName = `-- name: ExpenseTagDeleteByName :exec
+DELETE FROM expense_tag WHERE expense_id = $1 AND tag_id IN (SELECT tag.id FROM tag WHERE tag.user_id = $2 AND tag.name = $3)
+`
+
+func (q *Queries) ExpenseTagDeleteByName(ctx context.Context, expenseID string, userID string, name string) error {
+	_, err := q.db.ExecContext(ctx, expenseTagDeleteByName, expenseID, userID, name)
+	return err
+}
+
+const expenseTagInsert = `-- name: ExpenseTagInsert :exec
+INSERT INTO expense_tag (expense_id, tag_id) VALUES ($1, $2) ON CONFLICT DO NOTHING
+`
+
+func (q *Queries) ExpenseTagInsert(ctx context.Context, expenseID string, tagID string) error {
+	_, err := q.db.ExecContext(ctx, expenseTagInsert, expenseID, tagID)
+	return err
+}
+
+const expenseUpdate = `-- name: ExpenseUpdate :exec
+UPDATE expense SET amount = $1, description = $2, category_id = $3, created_at = $4 WHERE id = $5
+`
+
+type ExpenseUpdateParams struct {
+	Amount      money.Decimal
+	Description sql.NullString
+	CategoryID  sql.NullString
+	CreatedAt   time.Time
+	ID          string
+}
+
+func (q *Queries) ExpenseUpdate(ctx context.Context, arg *ExpenseUpdateParams) error {
+	_, err := q.db.ExecContext(ctx, expenseUpdate,
+		arg.Amount,
+		arg.Description,
+		arg.CategoryID,
+		arg.CreatedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const historyCount = `-- name: HistoryCount :one
+SELECT count(*) FROM history
+WHERE (history.namespace = $1 OR $1 IS NULL)
+  AND (history.reference = $2 OR $2 IS NULL)
+  AND (history.email = $3 OR $3 IS NULL)
+  AND (history.created_at >= $4 OR $4 IS NULL)
+  AND (history.created_at < $5 OR $5 IS NULL)
+`
+
+type HistoryCountParams struct {
+	Namespace   sql.NullString
+	Reference   sql.NullString
+	Email       sql.NullString
+	CreatedFrom sql.NullTime
+	CreatedTo   sql.NullTime
+}
+
+func (q *Queries) HistoryCount(ctx context.Context, arg *HistoryCountParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, historyCount,
+		arg.Namespace,
+		arg.Reference,
+		arg.Email,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const historyInsert = `-- name: HistoryInsert :exec
+INSERT INTO history (id, namespace, reference, event, email, created_at) VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type HistoryInsertParams struct {
+	ID        string
+	Namespace string
+	Reference string
+	Event     string
+	Email     string
+	CreatedAt time.Time
+}
+
+func (q *Queries) HistoryInsert(ctx context.Context, arg *HistoryInsertParams) error {
+	_, err := q.db.ExecContext(ctx, historyInsert,
+		arg.ID,
+		arg.Namespace,
+		arg.Reference,
+		arg.Event,
+		arg.Email,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const historyList = `-- name: HistoryList :many
+SELECT id, namespace, event, email, created_at, reference FROM history ORDER BY id
+`
+
+func (q *Queries) HistoryList(ctx context.Context) ([]*History, error) {
+	rows, err := q.db.QueryContext(ctx, historyList)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*History
+	for rows.Next() {
+		var i History
+		if err := rows.Scan(
+			&i.ID,
+			&i.Namespace,
+			&i.Event,
+			&i.Email,
+			&i.CreatedAt,
+			&i.Reference,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const historyPage = `-- name: HistoryPage :many
+SELECT id, namespace, event, email, created_at, reference FROM history
+WHERE (history.namespace = $1 OR $1 IS NULL)
+  AND (history.reference = $2 OR $2 IS NULL)
+  AND (history.email = $3 OR $3 IS NULL)
+  AND (history.created_at >= $4 OR $4 IS NULL)
+  AND (history.created_at < $5 OR $5 IS NULL)
+  AND (history.created_at > $6 OR (history.created_at = $6 AND history.id > $7) OR $7 IS NULL)
+  AND (history.created_at < $8 OR (history.created_at = $8 AND history.id < $9) OR $9 IS NULL)
+ORDER BY history.created_at, history.id
+LIMIT $10
+`
+
+type HistoryPageParams struct {
+	Namespace       sql.NullString
+	Reference       sql.NullString
+	Email           sql.NullString
+	CreatedFrom     sql.NullTime
+	CreatedTo       sql.NullTime
+	AfterCreatedAt  sql.NullTime
+	AfterID         sql.NullString
+	BeforeCreatedAt sql.NullTime
+	BeforeID        sql.NullString
+	PageSize        int32
+}
+
+func (q *Queries) HistoryPage(ctx context.Context, arg *HistoryPageParams) ([]*History, error) {
+	rows, err := q.db.QueryContext(ctx, historyPage,
+		arg.Namespace,
+		arg.Reference,
+		arg.Email,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.BeforeCreatedAt,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*History
+	for rows.Next() {
+		var i History
+		if err := rows.Scan(
+			&i.ID,
+			&i.Namespace,
+			&i.Event,
+			&i.Email,
+			&i.CreatedAt,
+			&i.Reference,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const historyPageFromEnd = `-- name: HistoryPageFromEnd :many
+SELECT id, namespace, event, email, created_at, reference FROM history
+WHERE (history.namespace = $1 OR $1 IS NULL)
+  AND (history.reference = $2 OR $2 IS NULL)
+  AND (history.email = $3 OR $3 IS NULL)
+  AND (history.created_at >= $4 OR $4 IS NULL)
+  AND (history.created_at < $5 OR $5 IS NULL)
+  AND (history.created_at > $6 OR (history.created_at = $6 AND history.id > $7) OR $7 IS NULL)
+  AND (history.created_at < $8 OR (history.created_at = $8 AND history.id < $9) OR $9 IS NULL)
+ORDER BY history.created_at DESC, history.id DESC
+LIMIT $10
+`
+
+type HistoryPageFromEndParams struct {
+	Namespace       sql.NullString
+	Reference       sql.NullString
+	Email           sql.NullString
+	CreatedFrom     sql.NullTime
+	CreatedTo       sql.NullTime
+	AfterCreatedAt  sql.NullTime
+	AfterID         sql.NullString
+	BeforeCreatedAt sql.NullTime
+	BeforeID        sql.NullString
+	PageSize        int32
+}
+
+func (q *Queries) HistoryPageFromEnd(ctx context.Context, arg *HistoryPageFromEndParams) ([]*History, error) {
+	rows, err := q.db.QueryContext(ctx, historyPageFromEnd,
+		arg.Namespace,
+		arg.Reference,
+		arg.Email,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.BeforeCreatedAt,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*History
+	for rows.Next() {
+		var i History
+		if err := rows.Scan(
+			&i.ID,
+			&i.Namespace,
+			&i.Event,
+			&i.Email,
+			&i.CreatedAt,
+			&i.Reference,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const householdGetByID = `-- name: HouseholdGetByID :one
+SELECT id, name, created_at FROM household WHERE id = $1
+`
+
+func (q *Queries) HouseholdGetByID(ctx context.Context, id string) (*Household, error) {
+	row := q.db.QueryRowContext(ctx, householdGetByID, id)
+	var i Household
+	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
+	return &i, err
+}
+
+const householdInsert = `-- name: HouseholdInsert :exec
+INSERT INTO household (id, name, created_at) VALUES ($1, $2, $3)
+`
+
+func (q *Queries) HouseholdInsert(ctx context.Context, iD string, name string, createdAt time.Time) error {
+	_, err := q.db.ExecContext(ctx, householdInsert, iD, name, createdAt)
+	return err
+}
+
+const householdInvitationDelete = `-- name: HouseholdInvitationDelete :exec
+DELETE FROM household_invitation WHERE id = $1
+`
+
+func (q *Queries) HouseholdInvitationDelete(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, householdInvitationDelete, id)
+	return err
+}
+
+const householdInvitationGetByID = `-- name: HouseholdInvitationGetByID :one
+SELECT id, household_id, user_id, email, role, invited_by, created_at FROM household_invitation WHERE id = $1
+`
+
+func (q *Queries) HouseholdInvitationGetByID(ctx context.Context, id string) (*HouseholdInvitation, error) {
+	row := q.db.QueryRowContext(ctx, householdInvitationGetByID, id)
+	var i HouseholdInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.HouseholdID,
+		&i.UserID,
+		&i.Email,
+		&i.Role,
+		&i.InvitedBy,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const householdInvitationGetByUser = `-- name: HouseholdInvitationGetByUser :one
+SELECT id, household_id, user_id, email, role, invited_by, created_at FROM household_invitation WHERE household_id = $1 AND user_id = $2
+`
+
+func (q *Queries) HouseholdInvitationGetByUser(ctx context.Context, householdID string, userID string) (*HouseholdInvitation, error) {
+	row := q.db.QueryRowContext(ctx, householdInvitationGetByUser, householdID, userID)
+	var i HouseholdInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.HouseholdID,
+		&i.UserID,
+		&i.Email,
+		&i.Role,
+		&i.InvitedBy,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const householdInvitationListByUser = `-- name: HouseholdInvitationListByUser :many
+SELECT id, household_id, user_id, email, role, invited_by, created_at FROM household_invitation WHERE user_id = $1 ORDER BY created_at, id
+`
+
+func (q *Queries) HouseholdInvitationListByUser(ctx context.Context, userID string) ([]*HouseholdInvitation, error) {
+	rows, err := q.db.QueryContext(ctx, householdInvitationListByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*HouseholdInvitation
+	for rows.Next() {
+		var i HouseholdInvitation
+		if err := rows.Scan(
+			&i.ID,
+			&i.HouseholdID,
+			&i.UserID,
+			&i.Email,
+			&i.Role,
+			&i.InvitedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const householdInvitationUpsert = `-- name: HouseholdInvitationUpsert :exec
+INSERT INTO household_invitation (id, household_id, user_id, email, role, invited_by, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (household_id, user_id) DO UPDATE SET role = excluded.role, invited_by = excluded.invited_by
+`
+
+type HouseholdInvitationUpsertParams struct {
+	ID          string
+	HouseholdID string
+	UserID      string
+	Email       string
+	Role        string
+	InvitedBy   string
+	CreatedAt   time.Time
+}
+
+func (q *Queries) HouseholdInvitationUpsert(ctx context.Context, arg *HouseholdInvitationUpsertParams) error {
+	_, err := q.db.ExecContext(ctx, householdInvitationUpsert,
+		arg.ID,
+		arg.HouseholdID,
+		arg.UserID,
+		arg.Email,
+		arg.Role,
+		arg.InvitedBy,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const householdListByUser = `-- name: HouseholdListByUser :many
+SELECT household.id, household.name, household.created_at FROM household JOIN household_member ON household_member.household_id = household.id
+WHERE household_member.user_id = $1 ORDER BY household.name, household.id
+`
+
+func (q *Queries) HouseholdListByUser(ctx context.Context, userID string) ([]*Household, error) {
+	rows, err := q.db.QueryContext(ctx, householdListByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Household
+	for rows.Next() {
+		var i Household
+		if err := rows.Scan(&i.ID, &i.Name, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const householdMemberCountByRole = `-- name: HouseholdMemberCountByRole :one
+SELECT count(*) FROM household_member WHERE household_id = $1 AND role = $2
+`
+
+func (q *Queries) HouseholdMemberCountByRole(ctx context.Context, householdID string, role string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, householdMemberCountByRole, householdID, role)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const householdMemberDelete = `-- name: HouseholdMemberDelete :exec
+DELETE FROM household_member WHERE household_id = $1 AND user_id = $2
+`
+
+func (q *Queries) HouseholdMemberDelete(ctx context.Context, householdID string, userID string) error {
+	_, err := q.db.ExecContext(ctx, householdMemberDelete, householdID, userID)
+	return err
+}
+
+const householdMemberGet = `-- name: HouseholdMemberGet :one
+SELECT household_id, user_id, email, role, created_at FROM household_member WHERE household_id = $1 AND user_id = $2
+`
+
+func (q *Queries) HouseholdMemberGet(ctx context.Context, householdID string, userID string) (*HouseholdMember, error) {
+	row := q.db.QueryRowContext(ctx, householdMemberGet, householdID, userID)
+	var i HouseholdMember
+	err := row.Scan(
+		&i.HouseholdID,
+		&i.UserID,
+		&i.Email,
+		&i.Role,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const householdMemberListByHousehold = `-- name: HouseholdMemberListByHousehold :many
+SELECT household_id, user_id, email, role, created_at FROM household_member WHERE household_id = $1 ORDER BY created_at, user_id
+`
+
+func (q *Queries) HouseholdMemberListByHousehold(ctx context.Context, householdID string) ([]*HouseholdMember, error) {
+	rows, err := q.db.QueryContext(ctx, householdMemberListByHousehold, householdID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*HouseholdMember
+	for rows.Next() {
+		var i HouseholdMember
+		if err := rows.Scan(
+			&i.HouseholdID,
+			&i.UserID,
+			&i.Email,
+			&i.Role,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const householdMemberUpsert = `-- name: HouseholdMemberUpsert :exec
+INSERT INTO household_member (household_id, user_id, email, role, created_at) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (household_id, user_id) DO UPDATE SET email = excluded.email, role = excluded.role
+`
+
+type HouseholdMemberUpsertParams struct {
+	HouseholdID string
+	UserID      string
+	Email       string
+	Role        string
+	CreatedAt   time.Time
+}
+
+func (q *Queries) HouseholdMemberUpsert(ctx context.Context, arg *HouseholdMemberUpsertParams) error {
+	_, err := q.db.ExecContext(ctx, householdMemberUpsert,
+		arg.HouseholdID,
+		arg.UserID,
+		arg.Email,
+		arg.Role,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const incomeDelete = `-- name: IncomeDelete :exec
+DELETE FROM income WHERE id = $1
+`
+
+func (q *Queries) IncomeDelete(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, incomeDelete, id)
+	return err
+}
+
+const incomeExternalIDCount = `-- name: IncomeExternalIDCount :one
+SELECT count(*) FROM income WHERE wallet_id = $1 AND external_id = $2
+`
+
+func (q *Queries) IncomeExternalIDCount(ctx context.Context, walletID string, externalID sql.NullString) (int64, error) {
+	row := q.db.QueryRowContext(ctx, incomeExternalIDCount, walletID, externalID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const incomeGetByID = `-- name: IncomeGetByID :one
+SELECT id, wallet_id, description, created_at, amount, external_id FROM income WHERE id = $1
+`
+
+func (q *Queries) IncomeGetByID(ctx context.Context, id string) (*Income, error) {
+	row := q.db.QueryRowContext(ctx, incomeGetByID, id)
+	var i Income
+	err := row.Scan(
+		&i.ID,
+		&i.WalletID,
+		&i.Description,
+		&i.CreatedAt,
+		&i.Amount,
+		&i.ExternalID,
+	)
+	return &i, err
+}
+
+const incomeInsert = `-- name: IncomeInsert :exec
+INSERT INTO income (id, wallet_id, amount, description, created_at, external_id) VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type IncomeInsertParams struct {
+	ID          string
+	WalletID    string
+	Amount      money.Decimal
+	Description sql.NullString
+	CreatedAt   time.Time
+	ExternalID  sql.NullString
+}
+
+func (q *Queries) IncomeInsert(ctx context.Context, arg *IncomeInsertParams) error {
+	_, err := q.db.ExecContext(ctx, incomeInsert,
+		arg.ID,
+		arg.WalletID,
+		arg.Amount,
+		arg.Description,
+		arg.CreatedAt,
+		arg.ExternalID,
+	)
+	return err
+}
+
+const incomeListByWallet = `-- name: IncomeListByWallet :many
+SELECT id, wallet_id, description, created_at, amount, external_id FROM income WHERE wallet_id = $1 ORDER BY id
+`
+
+func (q *Queries) IncomeListByWallet(ctx context.Context, walletID string) ([]*Income, error) {
+	rows, err := q.db.QueryContext(ctx, incomeListByWallet, walletID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Income
+	for rows.Next() {
+		var i Income
+		if err := rows.Scan(
+			&i.ID,
+			&i.WalletID,
+			&i.Description,
+			&i.CreatedAt,
+			&i.Amount,
+			&i.ExternalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incomeUpdate = `-- name: IncomeUpdate :exec
+UPDATE income SET amount = $1, description = $2, created_at = $3 WHERE id = $4
+`
+
+func (q *Queries) IncomeUpdate(ctx context.Context, amount money.Decimal, description sql.NullString, createdAt time.Time, iD string) error {
+	_, err := q.db.ExecContext(ctx, incomeUpdate,
+		amount,
+		description,
+		createdAt,
+		iD,
+	)
+	return err
+}
+
+const localUserGetByEmail = `-- name: LocalUserGetByEmail :one
+SELECT id, email, display_name, roles, pwdhash, created_at FROM local_user WHERE email = $1
+`
+
+func (q *Queries) LocalUserGetByEmail(ctx context.Context, email string) (*LocalUser, error) {
+	row := q.db.QueryRowContext(ctx, localUserGetByEmail, email)
+	var i LocalUser
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.DisplayName,
+		&i.Roles,
+		&i.Pwdhash,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const localUserGetByID = `-- name: LocalUserGetByID :one
+SELECT id, email, display_name, roles, pwdhash, created_at FROM local_user WHERE id = $1
+`
+
+func (q *Queries) LocalUserGetByID(ctx context.Context, id string) (*LocalUser, error) {
+	row := q.db.QueryRowContext(ctx, localUserGetByID, id)
+	var i LocalUser
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.DisplayName,
+		&i.Roles,
+		&i.Pwdhash,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const localUserInsert = `-- name: LocalUserInsert :exec
+INSERT INTO local_user (id, email, display_name, roles, created_at, pwdhash) VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type LocalUserInsertParams struct {
+	ID          string
+	Email       string
+	DisplayName string
+	Roles       string
+	CreatedAt   time.Time
+	Pwdhash     string
+}
+
+func (q *Queries) LocalUserInsert(ctx context.Context, arg *LocalUserInsertParams) error {
+	_, err := q.db.ExecContext(ctx, localUserInsert,
+		arg.ID,
+		arg.Email,
+		arg.DisplayName,
+		arg.Roles,
+		arg.CreatedAt,
+		arg.Pwdhash,
+	)
+	return err
+}
+
+const localUserList = `-- name: LocalUserList :many
+SELECT id, email, display_name, roles, pwdhash, created_at FROM local_user ORDER BY email
+`
+
+func (q *Queries) LocalUserList(ctx context.Context) ([]*LocalUser, error) {
+	rows, err := q.db.QueryContext(ctx, localUserList)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*LocalUser
+	for rows.Next() {
+		var i LocalUser
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.DisplayName,
+			&i.Roles,
+			&i.Pwdhash,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const localUserSetPass = `-- name: LocalUserSetPass :exec
+UPDATE local_user SET pwdhash = $1 WHERE email = $2
+`
+
+func (q *Queries) LocalUserSetPass(ctx context.Context, pwdhash string, email string) error {
+	_, err := q.db.ExecContext(ctx, localUserSetPass, pwdhash, email)
+	return err
+}
+
+const localUserUpdate = `-- name: LocalUserUpdate :exec
+UPDATE local_user SET roles = $1 WHERE email = $2
+`
+
+func (q *Queries) LocalUserUpdate(ctx context.Context, roles string, email string) error {
+	_, err := q.db.ExecContext(ctx, localUserUpdate, roles, email)
+	return err
+}
+
+const recurringAdvance = `-- name: RecurringAdvance :execrows
+UPDATE recurring SET next_at = $1, occurrences = $2
+WHERE id = $3 AND occurrences = $4
+`
+
+func (q *Queries) RecurringAdvance(ctx context.Context, nextAt sql.NullTime, occurrences int32, ruleID string, previousOccurrences int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, recurringAdvance,
+		nextAt,
+		occurrences,
+		ruleID,
+		previousOccurrences,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const recurringDelete = `-- name: RecurringDelete :exec
+DELETE FROM recurring WHERE id = $1
+`
+
+func (q *Queries) RecurringDelete(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, recurringDelete, id)
+	return err
+}
+
+const recurringGetByID = `-- name: RecurringGetByID :one
+SELECT id, user_id, email, wallet_id, kind, amount, description, category_id, rrule, starts_at, ends_at, next_at, occurrences, created_at FROM recurring WHERE id = $1
+`
+
+func (q *Queries) RecurringGetByID(ctx context.Context, id string) (*Recurring, error) {
+	row := q.db.QueryRowContext(ctx, recurringGetByID, id)
+	var i Recurring
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Email,
+		&i.WalletID,
+		&i.Kind,
+		&i.Amount,
+		&i.Description,
+		&i.CategoryID,
+		&i.Rrule,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.NextAt,
+		&i.Occurrences,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const recurringInsert = `-- name: RecurringInsert :exec
+INSERT INTO recurring (id, user_id, email, wallet_id, kind, amount, description, category_id, rrule, starts_at, ends_at,
+                       next_at, occurrences, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+`
+
+type RecurringInsertParams struct {
+	ID          string
+	UserID      string
+	Email       string
+	WalletID    string
+	Kind        string
+	Amount      money.Decimal
+	Description sql.NullString
+	CategoryID  sql.NullString
+	Rrule       string
+	StartsAt    time.Time
+	EndsAt      sql.NullTime
+	NextAt      sql.NullTime
+	Occurrences int32
+	CreatedAt   time.Time
+}
+
+func (q *Queries) RecurringInsert(ctx context.Context, arg *RecurringInsertParams) error {
+	_, err := q.db.ExecContext(ctx, recurringInsert,
+		arg.ID,
+		arg.UserID,
+		arg.Email,
+		arg.WalletID,
+		arg.Kind,
+		arg.Amount,
+		arg.Description,
+		arg.CategoryID,
+		arg.Rrule,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.NextAt,
+		arg.Occurrences,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const recurringListByUser = `-- name: RecurringListByUser :many
+SELECT id, user_id, email, wallet_id, kind, amount, description, category_id, rrule, starts_at, ends_at, next_at, occurrences, created_at FROM recurring WHERE user_id = $1 ORDER BY created_at, id
+`
+
+func (q *Queries) RecurringListByUser(ctx context.Context, userID string) ([]*Recurring, error) {
+	rows, err := q.db.QueryContext(ctx, recurringListByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Recurring
+	for rows.Next() {
+		var i Recurring
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Email,
+			&i.WalletID,
+			&i.Kind,
+			&i.Amount,
+			&i.Description,
+			&i.CategoryID,
+			&i.Rrule,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.NextAt,
+			&i.Occurrences,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recurringListDue = `-- name: RecurringListDue :many
+SELECT id, user_id, email, wallet_id, kind, amount, description, category_id, rrule, starts_at, ends_at, next_at, occurrences, created_at FROM recurring WHERE next_at IS NOT NULL AND next_at <= $1 ORDER BY next_at, id
+`
+
+func (q *Queries) RecurringListDue(ctx context.Context, until sql.NullTime) ([]*Recurring, error) {
+	rows, err := q.db.QueryContext(ctx, recurringListDue, until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Recurring
+	for rows.Next() {
+		var i Recurring
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Email,
+			&i.WalletID,
+			&i.Kind,
+			&i.Amount,
+			&i.Description,
+			&i.CategoryID,
+			&i.Rrule,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.NextAt,
+			&i.Occurrences,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recurringSetCategory = `-- name: RecurringSetCategory :exec
+UPDATE recurring SET category_id = $1 WHERE category_id = $2
+`
+
+func (q *Queries) RecurringSetCategory(ctx context.Context, newCategoryID sql.NullString, categoryID sql.NullString) error {
+	_, err := q.db.ExecContext(ctx, recurringSetCategory, newCategoryID, categoryID)
+	return err
+}
+
+const securityPriceLatest = `-- name: SecurityPriceLatest :one
+SELECT ticker, priced_on, price, currency, source, created_at FROM security_price WHERE ticker = $1 AND priced_on <= $2
+ORDER BY priced_on DESC LIMIT 1
+`
+
+// SecurityPriceLatest returns the latest price of a security on given day or before.
+func (q *Queries) SecurityPriceLatest(ctx context.Context, ticker string, pricedOn time.Time) (*SecurityPrice, error) {
+	row := q.db.QueryRowContext(ctx, securityPriceLatest, ticker, pricedOn)
+	var i SecurityPrice
+	err := row.Scan(
+		&i.Ticker,
+		&i.PricedOn,
+		&i.Price,
+		&i.Currency,
+		&i.Source,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const securityPriceUpsert = `-- name: SecurityPriceUpsert :exec
+INSERT INTO security_price (ticker, priced_on, price, currency, source, created_at) VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (ticker, priced_on) DO UPDATE SET price = excluded.price, currency = excluded.currency,
+    source = excluded.source, created_at = excluded.created_at
+`
+
+type SecurityPriceUpsertParams struct {
+	Ticker    string
+	PricedOn  time.Time
+	Price     money.Decimal
+	Currency  string
+	Source    string
+	CreatedAt time.Time
+}
+
+func (q *Queries) SecurityPriceUpsert(ctx context.Context, arg *SecurityPriceUpsertParams) error {
+	_, err := q.db.ExecContext(ctx, securityPriceUpsert,
+		arg.Ticker,
+		arg.PricedOn,
+		arg.Price,
+		arg.Currency,
+		arg.Source,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const tagGetByName = `-- name: TagGetByName :one
+SELECT id, user_id, name, created_at FROM tag WHERE user_id = $1 AND name = $2
+`
+
+func (q *Queries) TagGetByName(ctx context.Context, userID string, name string) (*Tag, error) {
+	row := q.db.QueryRowContext(ctx, tagGetByName, userID, name)
+	var i Tag
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const tagInsert = `-- name: TagInsert :exec
+INSERT INTO tag (id, user_id, name, created_at) VALUES ($1, $2, $3, $4)
+`
+
+func (q *Queries) TagInsert(ctx context.Context, iD string, userID string, name string, createdAt time.Time) error {
+	_, err := q.db.ExecContext(ctx, tagInsert,
+		iD,
+		userID,
+		name,
+		createdAt,
+	)
+	return err
+}
+
+const tagListByExpense = `-- name: TagListByExpense :many
+SELECT tag.id, tag.user_id, tag.name, tag.created_at FROM tag JOIN expense_tag ON expense_tag.tag_id = tag.id WHERE expense_tag.expense_id = $1 ORDER BY tag.name
+`
+
+func (q *Queries) TagListByExpense(ctx context.Context, expenseID string) ([]*Tag, error) {
+	rows, err := q.db.QueryContext(ctx, tagListByExpense, expenseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Tag
+	for rows.Next() {
+		var i Tag
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const tagListByUser = `-- name: TagListByUser :many
+SELECT id, user_id, name, created_at FROM tag WHERE user_id = $1 ORDER BY name
+`
+
+func (q *Queries) TagListByUser(ctx context.Context, userID string) ([]*Tag, error) {
+	rows, err := q.db.QueryContext(ctx, tagListByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Tag
+	for rows.Next() {
+		var i Tag
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const tradeDelete = `-- name: TradeDelete :exec
+DELETE FROM trade WHERE id = $1
 `
 
-type ExpenseInsertParams struct {
+func (q *Queries) TradeDelete(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, tradeDelete, id)
+	return err
+}
+
+const tradeGetByID = `-- name: TradeGetByID :one
+SELECT id, wallet_id, ticker, kind, quantity, price, fee, amount, description, created_at FROM trade WHERE id = $1
+`
+
+func (q *Queries) TradeGetByID(ctx context.Context, id string) (*Trade, error) {
+	row := q.db.QueryRowContext(ctx, tradeGetByID, id)
+	var i Trade
+	err := row.Scan(
+		&i.ID,
+		&i.WalletID,
+		&i.Ticker,
+		&i.Kind,
+		&i.Quantity,
+		&i.Price,
+		&i.Fee,
+		&i.Amount,
+		&i.Description,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const tradeInsert = `-- name: TradeInsert :exec
+INSERT INTO trade (id, wallet_id, ticker, kind, quantity, price, fee, amount, description, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+type TradeInsertParams struct {
 	ID          string
 	WalletID    string
-	Amount      float64
+	Ticker      string
+	Kind        string
+	Quantity    money.Decimal
+	Price       money.Decimal
+	Fee         money.Decimal
+	Amount      money.Decimal
 	Description sql.NullString
 	CreatedAt   time.Time
 }
 
-func (q *Queries) ExpenseInsert(ctx context.Context, arg *ExpenseInsertParams) error {
-	_, err := q.db.ExecContext(ctx, expenseInsert,
+func (q *Queries) TradeInsert(ctx context.Context, arg *TradeInsertParams) error {
+	_, err := q.db.ExecContext(ctx, tradeInsert,
 		arg.ID,
 		arg.WalletID,
+		arg.Ticker,
+		arg.Kind,
+		arg.Quantity,
+		arg.Price,
+		arg.Fee,
 		arg.Amount,
 		arg.Description,
 		arg.CreatedAt,
@@ -34,22 +1925,29 @@ func (q *Queries) ExpenseInsert(ctx context.Context, arg *ExpenseInsertParams) e
 	return err
 }
 
-const expenseListByWallet = `-- name: ExpenseListByWallet :many
-SELECT id, wallet_id, amount, description, created_at FROM expense WHERE wallet_id = $1 ORDER BY id
+const tradeListByWallet = `-- name: TradeListByWallet :many
+SELECT id, wallet_id, ticker, kind, quantity, price, fee, amount, description, created_at FROM trade WHERE wallet_id = $1 ORDER BY created_at, kind, id
 `
 
-func (q *Queries) ExpenseListByWallet(ctx context.Context, walletID string) ([]*Expense, error) {
-	rows, err := q.db.QueryContext(ctx, expenseListByWallet, walletID)
+// TradeListByWallet lists trades of a wallet in order of time. Buys come before dividends and sells made at the same
+// time, so lots are bought before they are sold.
+func (q *Queries) TradeListByWallet(ctx context.Context, walletID string) ([]*Trade, error) {
+	rows, err := q.db.QueryContext(ctx, tradeListByWallet, walletID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*Expense
+	var items []*Trade
 	for rows.Next() {
-		var i Expense
+		var i Trade
 		if err := rows.Scan(
 			&i.ID,
 			&i.WalletID,
+			&i.Ticker,
+			&i.Kind,
+			&i.Quantity,
+			&i.Price,
+			&i.Fee,
 			&i.Amount,
 			&i.Description,
 			&i.CreatedAt,
@@ -67,28 +1965,58 @@ func (q *Queries) ExpenseListByWallet(ctx context.Context, walletID string) ([]*
 	return items, nil
 }
 
-const expenseListByWalletByUser = `-- name: ExpenseListByWalletByUser :many
-SELECT id, wallet_id, amount, description, created_at FROM expense WHERE wallet_id = $1 AND wallet_id IN (
-    SELECT id FROM wallet WHERE user_id = $2
-) 
-ORDER BY id
+const transferInsert = `-- name: TransferInsert :exec
+INSERT INTO transfer (id, transfer_id, wallet_id, counterpart_wallet_id, amount, rate, description, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
-func (q *Queries) ExpenseListByWalletByUser(ctx context.Context, walletID string, userID string) ([]*Expense, error) {
-	rows, err := q.db.QueryContext(ctx, expenseListByWalletByUser, walletID, userID)
+type TransferInsertParams struct {
+	ID                  string
+	TransferID          string
+	WalletID            string
+	CounterpartWalletID string
+	Amount              money.Decimal
+	Rate                float64
+	Description         sql.NullString
+	CreatedAt           time.Time
+}
+
+func (q *Queries) TransferInsert(ctx context.Context, arg *TransferInsertParams) error {
+	_, err := q.db.ExecContext(ctx, transferInsert,
+		arg.ID,
+		arg.TransferID,
+		arg.WalletID,
+		arg.CounterpartWalletID,
+		arg.Amount,
+		arg.Rate,
+		arg.Description,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const transferListByTransferID = `-- name: TransferListByTransferID :many
+SELECT id, transfer_id, wallet_id, counterpart_wallet_id, rate, description, created_at, amount FROM transfer WHERE transfer_id = $1 ORDER BY amount
+`
+
+func (q *Queries) TransferListByTransferID(ctx context.Context, transferID string) ([]*Transfer, error) {
+	rows, err := q.db.QueryContext(ctx, transferListByTransferID, transferID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*Expense
+	var items []*Transfer
 	for rows.Next() {
-		var i Expense
+		var i Transfer
 		if err := rows.Scan(
 			&i.ID,
+			&i.TransferID,
 			&i.WalletID,
-			&i.Amount,
+			&i.CounterpartWalletID,
+			&i.Rate,
 			&i.Description,
 			&i.CreatedAt,
+			&i.Amount,
 		); err != nil {
 			return nil, err
 		}
@@ -103,51 +2031,28 @@ func (q *Queries) ExpenseListByWalletByUser(ctx context.Context, walletID string
 	return items, nil
 }
 
-const historyInsert = `-- name: HistoryInsert :exec
-INSERT INTO history (id, namespace, reference, event, email, created_at) VALUES ($1, $2, $3, $4, $5, $6)
-`
-
-type HistoryInsertParams struct {
-	ID        string
-	Namespace string
-	Reference string
-	Event     string
-	Email     string
-	CreatedAt time.Time
-}
-
-func (q *Queries) HistoryInsert(ctx context.Context, arg *HistoryInsertParams) error {
-	_, err := q.db.ExecContext(ctx, historyInsert,
-		arg.ID,
-		arg.Namespace,
-		arg.Reference,
-		arg.Event,
-		arg.Email,
-		arg.CreatedAt,
-	)
-	return err
-}
-
-const historyList = `-- name: HistoryList :many
-SELECT id, namespace, reference, event, email, created_at FROM history ORDER BY id
+const transferListByWallet = `-- name: TransferListByWallet :many
+SELECT id, transfer_id, wallet_id, counterpart_wallet_id, rate, description, created_at, amount FROM transfer WHERE wallet_id = $1 ORDER BY id
 `
 
-func (q *Queries) HistoryList(ctx context.Context) ([]*History, error) {
-	rows, err := q.db.QueryContext(ctx, historyList)
+func (q *Queries) TransferListByWallet(ctx context.Context, walletID string) ([]*Transfer, error) {
+	rows, err := q.db.QueryContext(ctx, transferListByWallet, walletID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*History
+	var items []*Transfer
 	for rows.Next() {
-		var i History
+		var i Transfer
 		if err := rows.Scan(
 			&i.ID,
-			&i.Namespace,
-			&i.Reference,
-			&i.Event,
-			&i.Email,
+			&i.TransferID,
+			&i.WalletID,
+			&i.CounterpartWalletID,
+			&i.Rate,
+			&i.Description,
 			&i.CreatedAt,
+			&i.Amount,
 		); err != nil {
 			return nil, err
 		}
@@ -162,86 +2067,83 @@ func (q *Queries) HistoryList(ctx context.Context) ([]*History, error) {
 	return items, nil
 }
 
-const localUserGetByEmail = `-- name: LocalUserGetByEmail :one
-SELECT id, email, display_name, roles, pwdhash, created_at FROM local_user WHERE email = $1
+const walletCount = `-- name: WalletCount :one
+SELECT count(*) FROM wallet WHERE ((wallet.household_id IS NULL AND wallet.user_id = $1)
+    OR wallet.id IN (SELECT wallet_grant.wallet_id FROM wallet_grant WHERE wallet_grant.user_id = $1)
+    OR wallet.household_id IN (SELECT household_member.household_id FROM household_member WHERE household_member.user_id = $1)
+    OR $1 IS NULL)
 `
 
-func (q *Queries) LocalUserGetByEmail(ctx context.Context, email string) (*LocalUser, error) {
-	row := q.db.QueryRowContext(ctx, localUserGetByEmail, email)
-	var i LocalUser
-	err := row.Scan(
-		&i.ID,
-		&i.Email,
-		&i.DisplayName,
-		&i.Roles,
-		&i.Pwdhash,
-		&i.CreatedAt,
-	)
-	return &i, err
+func (q *Queries) WalletCount(ctx context.Context, userID sql.NullString) (int64, error) {
+	row := q.db.QueryRowContext(ctx, walletCount, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-const localUserGetByID = `-- name: LocalUserGetByID :one
-SELECT id, email, display_name, roles, pwdhash, created_at FROM local_user WHERE id = $1
+const walletGetByID = `-- name: WalletGetByID :one
+SELECT id, user_id, currency, created_at, balance, household_id, kind FROM wallet WHERE id = $1
 `
 
-func (q *Queries) LocalUserGetByID(ctx context.Context, id string) (*LocalUser, error) {
-	row := q.db.QueryRowContext(ctx, localUserGetByID, id)
-	var i LocalUser
+func (q *Queries) WalletGetByID(ctx context.Context, id string) (*Wallet, error) {
+	row := q.db.QueryRowContext(ctx, walletGetByID, id)
+	var i Wallet
 	err := row.Scan(
 		&i.ID,
-		&i.Email,
-		&i.DisplayName,
-		&i.Roles,
-		&i.Pwdhash,
+		&i.UserID,
+		&i.Currency,
 		&i.CreatedAt,
+		&i.Balance,
+		&i.HouseholdID,
+		&i.Kind,
 	)
 	return &i, err
 }
 
-const localUserInsert = `-- name: LocalUserInsert :exec
-INSERT INTO local_user (id, email, display_name, roles, created_at, pwdhash) VALUES ($1, $2, $3, $4, $5, $6)
+const walletGrantDelete = `-- name: WalletGrantDelete :exec
+DELETE FROM wallet_grant WHERE wallet_id = $1 AND user_id = $2
 `
 
-type LocalUserInsertParams struct {
-	ID          string
-	Email       string
-	DisplayName string
-	Roles       string
-	CreatedAt   time.Time
-	Pwdhash     string
+func (q *Queries) WalletGrantDelete(ctx context.Context, walletID string, userID string) error {
+	_, err := q.db.ExecContext(ctx, walletGrantDelete, walletID, userID)
+	return err
 }
 
-func (q *Queries) LocalUserInsert(ctx context.Context, arg *LocalUserInsertParams) error {
-	_, err := q.db.ExecContext(ctx, localUserInsert,
-		arg.ID,
-		arg.Email,
-		arg.DisplayName,
-		arg.Roles,
-		arg.CreatedAt,
-		arg.Pwdhash,
+const walletGrantGet = `-- name: WalletGrantGet :one
+SELECT wallet_id, user_id, email, access, created_at FROM wallet_grant WHERE wallet_id = $1 AND user_id = $2
+`
+
+func (q *Queries) WalletGrantGet(ctx context.Context, walletID string, userID string) (*WalletGrant, error) {
+	row := q.db.QueryRowContext(ctx, walletGrantGet, walletID, userID)
+	var i WalletGrant
+	err := row.Scan(
+		&i.WalletID,
+		&i.UserID,
+		&i.Email,
+		&i.Access,
+		&i.CreatedAt,
 	)
-	return err
+	return &i, err
 }
 
-const localUserList = `-- name: LocalUserList :many
-SELECT id, email, display_name, roles, pwdhash, created_at FROM local_user ORDER BY email
+const walletGrantListByWallet = `-- name: WalletGrantListByWallet :many
+SELECT wallet_id, user_id, email, access, created_at FROM wallet_grant WHERE wallet_id = $1 ORDER BY created_at, user_id
 `
 
-func (q *Queries) LocalUserList(ctx context.Context) ([]*LocalUser, error) {
-	rows, err := q.db.QueryContext(ctx, localUserList)
+func (q *Queries) WalletGrantListByWallet(ctx context.Context, walletID string) ([]*WalletGrant, error) {
+	rows, err := q.db.QueryContext(ctx, walletGrantListByWallet, walletID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*LocalUser
+	var items []*WalletGrant
 	for rows.Next() {
-		var i LocalUser
+		var i WalletGrant
 		if err := rows.Scan(
-			&i.ID,
+			&i.WalletID,
+			&i.UserID,
 			&i.Email,
-			&i.DisplayName,
-			&i.Roles,
-			&i.Pwdhash,
+			&i.Access,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -257,58 +2159,214 @@ func (q *Queries) LocalUserList(ctx context.Context) ([]*LocalUser, error) {
 	return items, nil
 }
 
-const localUserSetPass = `-- name: LocalUserSetPass :exec
-UPDATE local_user SET pwdhash = $1 WHERE email = $2
+const walletGrantUpsert = `-- name: WalletGrantUpsert :exec
+INSERT INTO wallet_grant (wallet_id, user_id, email, access, created_at) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (wallet_id, user_id) DO UPDATE SET email = excluded.email, access = excluded.access
 `
 
-func (q *Queries) LocalUserSetPass(ctx context.Context, pwdhash string, email string) error {
-	_, err := q.db.ExecContext(ctx, localUserSetPass, pwdhash, email)
-	return err
+type WalletGrantUpsertParams struct {
+	WalletID  string
+	UserID    string
+	Email     string
+	Access    string
+	CreatedAt time.Time
 }
 
-const localUserUpdate = `-- name: LocalUserUpdate :exec
-UPDATE local_user SET roles = $1 WHERE email = $2
-`
-
-func (q *Queries) LocalUserUpdate(ctx context.Context, roles string, email string) error {
-	_, err := q.db.ExecContext(ctx, localUserUpdate, roles, email)
+func (q *Queries) WalletGrantUpsert(ctx context.Context, arg *WalletGrantUpsertParams) error {
+	_, err := q.db.ExecContext(ctx, walletGrantUpsert,
+		arg.WalletID,
+		arg.UserID,
+		arg.Email,
+		arg.Access,
+		arg.CreatedAt,
+	)
 	return err
 }
 
 const walletInsert = `-- name: WalletInsert :exec
-INSERT INTO wallet (id, user_id, balance, currency, created_at) VALUES ($1, $2, $3, $4, $5)
+INSERT INTO wallet (id, user_id, household_id, balance, currency, created_at) VALUES ($1, $2, $3, $4, $5, $6)
 `
 
 type WalletInsertParams struct {
-	ID        string
-	UserID    string
-	Balance   float64
-	Currency  string
-	CreatedAt time.Time
+	ID          string
+	UserID      string
+	HouseholdID sql.NullString
+	Balance     money.Decimal
+	Currency    string
+	CreatedAt   time.Time
 }
 
 func (q *Queries) WalletInsert(ctx context.Context, arg *WalletInsertParams) error {
 	_, err := q.db.ExecContext(ctx, walletInsert,
 		arg.ID,
 		arg.UserID,
+		arg.HouseholdID,
+		arg.Balance,
+		arg.Currency,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const walletInsertKind = `-- name: WalletInsertKind :exec
+INSERT INTO wallet (id, user_id, household_id, balance, currency, kind, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type WalletInsertKindParams struct {
+	ID          string
+	UserID      string
+	HouseholdID sql.NullString
+	Balance     money.Decimal
+	Currency    string
+	Kind        string
+	CreatedAt   time.Time
+}
+
+// WalletInsertKind is WalletInsert of a wallet of given kind, WalletInsert creates cash wallets.
+func (q *Queries) WalletInsertKind(ctx context.Context, arg *WalletInsertKindParams) error {
+	_, err := q.db.ExecContext(ctx, walletInsertKind,
+		arg.ID,
+		arg.UserID,
+		arg.HouseholdID,
 		arg.Balance,
 		arg.Currency,
+		arg.Kind,
 		arg.CreatedAt,
 	)
 	return err
 }
 
+const walletPage = `-- name: WalletPage :many
+SELECT id, user_id, currency, created_at, balance, household_id, kind FROM wallet
+WHERE ((wallet.household_id IS NULL AND wallet.user_id = $1)
+    OR wallet.id IN (SELECT wallet_grant.wallet_id FROM wallet_grant WHERE wallet_grant.user_id = $1)
+    OR wallet.household_id IN (SELECT household_member.household_id FROM household_member WHERE household_member.user_id = $1)
+    OR $1 IS NULL)
+  AND (wallet.created_at > $2 OR (wallet.created_at = $2 AND wallet.id > $3) OR $3 IS NULL)
+  AND (wallet.created_at < $4 OR (wallet.created_at = $4 AND wallet.id < $5) OR $5 IS NULL)
+ORDER BY wallet.created_at, wallet.id
+LIMIT $6
+`
+
+type WalletPageParams struct {
+	UserID          sql.NullString
+	AfterCreatedAt  sql.NullTime
+	AfterID         sql.NullString
+	BeforeCreatedAt sql.NullTime
+	BeforeID        sql.NullString
+	PageSize        int32
+}
+
+// WalletPage lists wallets visible to user, all of them without one. Household wallets are visible to members of the
+// household rather than to their creator, as in access.Wallet.
+func (q *Queries) WalletPage(ctx context.Context, arg *WalletPageParams) ([]*Wallet, error) {
+	rows, err := q.db.QueryContext(ctx, walletPage,
+		arg.UserID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.BeforeCreatedAt,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Wallet
+	for rows.Next() {
+		var i Wallet
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Balance,
+			&i.HouseholdID,
+			&i.Kind,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const walletPageFromEnd = `-- name: WalletPageFromEnd :many
+SELECT id, user_id, currency, created_at, balance, household_id, kind FROM wallet
+WHERE ((wallet.household_id IS NULL AND wallet.user_id = $1)
+    OR wallet.id IN (SELECT wallet_grant.wallet_id FROM wallet_grant WHERE wallet_grant.user_id = $1)
+    OR wallet.household_id IN (SELECT household_member.household_id FROM household_member WHERE household_member.user_id = $1)
+    OR $1 IS NULL)
+  AND (wallet.created_at > $2 OR (wallet.created_at = $2 AND wallet.id > $3) OR $3 IS NULL)
+  AND (wallet.created_at < $4 OR (wallet.created_at = $4 AND wallet.id < $5) OR $5 IS NULL)
+ORDER BY wallet.created_at DESC, wallet.id DESC
+LIMIT $6
+`
+
+type WalletPageFromEndParams struct {
+	UserID          sql.NullString
+	AfterCreatedAt  sql.NullTime
+	AfterID         sql.NullString
+	BeforeCreatedAt sql.NullTime
+	BeforeID        sql.NullString
+	PageSize        int32
+}
+
+func (q *Queries) WalletPageFromEnd(ctx context.Context, arg *WalletPageFromEndParams) ([]*Wallet, error) {
+	rows, err := q.db.QueryContext(ctx, walletPageFromEnd,
+		arg.UserID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.BeforeCreatedAt,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Wallet
+	for rows.Next() {
+		var i Wallet
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Balance,
+			&i.HouseholdID,
+			&i.Kind,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const walletUpdateBalance = `-- name: WalletUpdateBalance :exec
-UPDATE wallet SET balance = $1 WHERE id = $2
+UPDATE wallet SET balance = balance + $1 WHERE id = $2
 `
 
-func (q *Queries) WalletUpdateBalance(ctx context.Context, balance float64, iD string) error {
-	_, err := q.db.ExecContext(ctx, walletUpdateBalance, balance, iD)
+func (q *Queries) WalletUpdateBalance(ctx context.Context, delta money.Decimal, iD string) error {
+	_, err := q.db.ExecContext(ctx, walletUpdateBalance, delta, iD)
 	return err
 }
 
 const walletsByAdmin = `-- name: WalletsByAdmin :many
-SELECT id, user_id, balance, currency, created_at FROM wallet ORDER BY wallet.user_id, wallet.created_at
+SELECT id, user_id, currency, created_at, balance, household_id, kind FROM wallet ORDER BY wallet.user_id, wallet.created_at
 `
 
 func (q *Queries) WalletsByAdmin(ctx context.Context) ([]*Wallet, error) {
@@ -323,9 +2381,11 @@ func (q *Queries) WalletsByAdmin(ctx context.Context) ([]*Wallet, error) {
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
-			&i.Balance,
 			&i.Currency,
 			&i.CreatedAt,
+			&i.Balance,
+			&i.HouseholdID,
+			&i.Kind,
 		); err != nil {
 			return nil, err
 		}
@@ -341,7 +2401,7 @@ func (q *Queries) WalletsByAdmin(ctx context.Context) ([]*Wallet, error) {
 }
 
 const walletsByUser = `-- name: WalletsByUser :many
-SELECT id, user_id, balance, currency, created_at FROM wallet WHERE user_id = $1 ORDER BY wallet.created_at
+SELECT id, user_id, currency, created_at, balance, household_id, kind FROM wallet WHERE user_id = $1 ORDER BY wallet.created_at
 `
 
 func (q *Queries) WalletsByUser(ctx context.Context, userID string) ([]*Wallet, error) {
@@ -356,9 +2416,11 @@ func (q *Queries) WalletsByUser(ctx context.Context, userID string) ([]*Wallet,
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
-			&i.Balance,
 			&i.Currency,
 			&i.CreatedAt,
+			&i.Balance,
+			&i.HouseholdID,
+			&i.Kind,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,147 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"strings"
+	"time"
+)
+
+// SpendingGroupBy names how expenses of a spending report are grouped. Values are those of the GraphQL enum.
+type SpendingGroupBy string
+
+const (
+	SpendingGroupByDay      SpendingGroupBy = "DAY"
+	SpendingGroupByWeek     SpendingGroupBy = "WEEK"
+	SpendingGroupByMonth    SpendingGroupBy = "MONTH"
+	SpendingGroupByCategory SpendingGroupBy = "CATEGORY"
+	SpendingGroupByTag      SpendingGroupBy = "TAG"
+)
+
+// SpendingReportParams selects expenses of a spending report. Empty GroupBy aggregates all of them in a single group.
+type SpendingReportParams struct {
+	WalletIDs []string
+	// CreatedFrom and CreatedTo match expenses created at or after CreatedFrom and before CreatedTo.
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	GroupBy     SpendingGroupBy
+}
+
+// SpendingGroup holds aggregates of a group of expenses. Only the field the expenses are grouped by is set: Period
+// when grouped by day, week or month, CategoryID and CategoryName when grouped by category and Tag when grouped by
+// tag. Expenses without a category or a tag are grouped together, with these fields nil.
+type SpendingGroup struct {
+	// Period is the start of the day, week or month in UTC. Weeks start on Monday.
+	Period       *time.Time
+	CategoryID   *string
+	CategoryName *string
+	Tag          *string
+	Total        money.Decimal
+	Count        int64
+	// Average is rounded to money.Scale places, half away from zero.
+	Average money.Decimal
+	Min     money.Decimal
+	Max     money.Decimal
+}
+
+// spendingAggregates are computed alike by postgres and sqlite. Average is computed on a float, since postgres sums
+// bigint into numeric and divides it exactly, while sqlite divides integers with truncation.
+const spendingAggregates = "COALESCE(SUM(expense.amount), 0), count(*), " +
+	"COALESCE(CAST(ROUND(SUM(expense.amount) * 1.0 / NULLIF(count(*), 0)) AS bigint), 0), " +
+	"COALESCE(MIN(expense.amount), 0), COALESCE(MAX(expense.amount), 0)"
+
+// SpendingReport returns aggregates of expenses matching arg, grouped as requested. Groups of periods are sorted by
+// time, groups of categories and tags by total, then by name. Expenses having many tags count in the group of each of
+// them. Periods are computed by the database, in a way depending on the driver.
+func (q *DAO) SpendingReport(ctx context.Context, arg *SpendingReportParams) ([]*SpendingGroup, error) {
+	if len(arg.WalletIDs) == 0 {
+		return nil, nil
+	}
+
+	b := &queryBuilder{}
+	b.where("expense.wallet_id IN (" + b.args(arg.WalletIDs) + ")")
+	if arg.CreatedFrom != nil {
+		b.where("expense.created_at >= " + b.arg(arg.CreatedFrom.UTC()))
+	}
+	if arg.CreatedTo != nil {
+		b.where("expense.created_at < " + b.arg(arg.CreatedTo.UTC()))
+	}
+
+	var key, from, group string
+	switch arg.GroupBy {
+	case "":
+		key, from = "NULL, NULL", "expense"
+	case SpendingGroupByDay, SpendingGroupByWeek, SpendingGroupByMonth:
+		period := q.periodStart(strings.ToLower(string(arg.GroupBy)), "expense.created_at")
+		key, from = period+", NULL", "expense"
+		group = " GROUP BY " + period + " ORDER BY " + period
+	case SpendingGroupByCategory:
+		key = "expense.category_id, category.name"
+		from = "expense LEFT JOIN category ON category.id = expense.category_id"
+		group = " GROUP BY expense.category_id, category.name" +
+			" ORDER BY 3, category.name IS NULL, category.name, expense.category_id"
+	case SpendingGroupByTag:
+		key = "tag.name, NULL"
+		from = "expense LEFT JOIN expense_tag ON expense_tag.expense_id = expense.id LEFT JOIN tag ON tag.id = expense_tag.tag_id"
+		group = " GROUP BY tag.name ORDER BY 3, tag.name IS NULL, tag.name"
+	default:
+		return nil, fmt.Errorf("unknown spending report grouping %q", arg.GroupBy)
+	}
+
+	rows, err := q.db.QueryContext(ctx, "SELECT "+key+", "+spendingAggregates+" FROM "+from+b.whereClause()+group, b.values...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot report spending: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*SpendingGroup
+	for rows.Next() {
+		var i SpendingGroup
+		var first, second sql.NullString
+		if err := rows.Scan(&first, &second, &i.Total, &i.Count, &i.Average, &i.Min, &i.Max); err != nil {
+			return nil, err
+		}
+
+		switch arg.GroupBy {
+		case SpendingGroupByDay, SpendingGroupByWeek, SpendingGroupByMonth:
+			period, err := time.Parse(time.DateOnly, first.String)
+			if err != nil {
+				return nil, fmt.Errorf("cannot read spending period: %w", err)
+			}
+			i.Period = &period
+		case SpendingGroupByCategory:
+			i.CategoryID, i.CategoryName = StrPtr(first), StrPtr(second)
+		case SpendingGroupByTag:
+			i.Tag = StrPtr(first)
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// periodStart returns an expression of the date starting the day, week or month of a timestamp column, formatted as
+// YYYY-MM-DD. Unit is one of day, week or month. Sqlite stores timestamps as text written by the driver, whose first 19
+// characters are understood by its date functions; timestamps are stored in UTC.
+func (q *DAO) periodStart(unit, column string) string {
+	if q.driver == "sqlite" {
+		column = "substr(" + column + ", 1, 19)"
+		switch unit {
+		case "week":
+			return "date(" + column + ", 'weekday 0', '-6 days')"
+		case "month":
+			return "date(" + column + ", 'start of month')"
+		default:
+			return "date(" + column + ")"
+		}
+	}
+
+	return "to_char(date_trunc('" + unit + "', " + column + "), 'YYYY-MM-DD')"
+}
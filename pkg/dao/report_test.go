@@ -0,0 +1,117 @@
+package dao
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestDAO_SpendingReport(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDAO(t)
+	now := time.Now().UTC()
+
+	for _, w := range []string{"w1", "w2", "w3"} {
+		require.Nil(t, d.WalletInsert(ctx, &WalletInsertParams{ID: w, UserID: "u1", Currency: "EUR", CreatedAt: now}))
+	}
+	require.Nil(t, d.CategoryInsert(ctx, &CategoryInsertParams{ID: "food", UserID: "u1", Name: "Food", CreatedAt: now}))
+	require.Nil(t, d.CategoryInsert(ctx, &CategoryInsertParams{
+		ID: "groceries", UserID: "u1", ParentID: NilStr("food"), Name: "Groceries", CreatedAt: now,
+	}))
+	for _, tag := range []string{"vacation", "tax"} {
+		require.Nil(t, d.TagInsert(ctx, tag, "u1", tag, now))
+	}
+
+	expenses := []struct {
+		id       string
+		wallet   string
+		amount   string
+		category string
+		tags     []string
+		at       time.Time
+	}{
+		{id: "e1", wallet: "w1", amount: "-10", category: "food", tags: []string{"vacation"}, at: time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)},
+		{id: "e2", wallet: "w1", amount: "-5", category: "groceries", tags: []string{"vacation", "tax"}, at: time.Date(2024, 3, 10, 23, 59, 59, 999, time.UTC)},
+		{id: "e3", wallet: "w2", amount: "-2.5", at: time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)},
+		{id: "e4", wallet: "w1", amount: "4", category: "food", at: time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)},
+		{id: "e5", wallet: "w3", amount: "-100", at: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{id: "e6", wallet: "w1", amount: "-1", at: time.Date(2024, 2, 29, 23, 0, 0, 0, time.UTC)},
+	}
+	for _, e := range expenses {
+		require.Nil(t, d.ExpenseInsert(ctx, &ExpenseInsertParams{
+			ID: e.id, WalletID: e.wallet, Amount: money.MustParse(e.amount), CategoryID: NilStr(e.category), CreatedAt: e.at,
+		}))
+		for _, tag := range e.tags {
+			require.Nil(t, d.ExpenseTagInsert(ctx, e.id, tag))
+		}
+	}
+
+	from, to := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	report := func(groupBy SpendingGroupBy) []*SpendingGroup {
+		groups, err := d.SpendingReport(ctx, &SpendingReportParams{
+			WalletIDs: []string{"w1", "w2"}, CreatedFrom: &from, CreatedTo: &to, GroupBy: groupBy,
+		})
+		require.Nil(t, err)
+		return groups
+	}
+	group := func(total string, count int64, average, min, max string) *SpendingGroup {
+		return &SpendingGroup{
+			Total: money.MustParse(total), Count: count, Average: money.MustParse(average),
+			Min: money.MustParse(min), Max: money.MustParse(max),
+		}
+	}
+	date := func(year int, month time.Month, day int) *time.Time {
+		t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	str := func(s string) *string { return &s }
+
+	assert.Equal(t, []*SpendingGroup{group("-13.5", 4, "-3.375", "-10", "4")}, report(""))
+
+	byDay := report(SpendingGroupByDay)
+	require.Len(t, byDay, 4)
+	assert.Equal(t, []*time.Time{date(2024, 3, 4), date(2024, 3, 10), date(2024, 3, 11), date(2024, 4, 1)},
+		[]*time.Time{byDay[0].Period, byDay[1].Period, byDay[2].Period, byDay[3].Period})
+
+	week := group("-15", 2, "-7.5", "-10", "-5")
+	week.Period = date(2024, 3, 4)
+	nextWeek := group("-2.5", 1, "-2.5", "-2.5", "-2.5")
+	nextWeek.Period = date(2024, 3, 11)
+	april := group("4", 1, "4", "4", "4")
+	april.Period = date(2024, 4, 1)
+	assert.Equal(t, []*SpendingGroup{week, nextWeek, april}, report(SpendingGroupByWeek))
+
+	march := group("-17.5", 3, "-5.8333", "-10", "-2.5")
+	march.Period = date(2024, 3, 1)
+	assert.Equal(t, []*SpendingGroup{march, april}, report(SpendingGroupByMonth))
+
+	food := group("-6", 2, "-3", "-10", "4")
+	food.CategoryID, food.CategoryName = str("food"), str("Food")
+	groceries := group("-5", 1, "-5", "-5", "-5")
+	groceries.CategoryID, groceries.CategoryName = str("groceries"), str("Groceries")
+	assert.Equal(t, []*SpendingGroup{food, groceries, group("-2.5", 1, "-2.5", "-2.5", "-2.5")}, report(SpendingGroupByCategory))
+
+	vacation := group("-15", 2, "-7.5", "-10", "-5")
+	vacation.Tag = str("vacation")
+	tax := group("-5", 1, "-5", "-5", "-5")
+	tax.Tag = str("tax")
+	assert.Equal(t, []*SpendingGroup{vacation, tax, group("1.5", 2, "0.75", "-2.5", "4")}, report(SpendingGroupByTag))
+
+	none, err := d.SpendingReport(ctx, &SpendingReportParams{WalletIDs: []string{"w1"}, CreatedTo: &from})
+	require.Nil(t, err)
+	assert.Equal(t, []*SpendingGroup{group("-1", 1, "-1", "-1", "-1")}, none)
+	empty, err := d.SpendingReport(ctx, &SpendingReportParams{WalletIDs: []string{"w2"}, CreatedFrom: &to})
+	require.Nil(t, err)
+	assert.Equal(t, []*SpendingGroup{group("0", 0, "0", "0", "0")}, empty)
+}
+
+func TestDAO_periodStart(t *testing.T) {
+	postgres := &DAO{driver: "postgres"}
+	assert.Equal(t, "to_char(date_trunc('week', expense.created_at), 'YYYY-MM-DD')", postgres.periodStart("week", "expense.created_at"))
+
+	sqlite := &DAO{driver: "sqlite"}
+	assert.Equal(t, "date(substr(expense.created_at, 1, 19), 'start of month')", sqlite.periodStart("month", "expense.created_at"))
+}
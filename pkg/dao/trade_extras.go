@@ -0,0 +1,31 @@
+package dao
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"time"
+)
+
+func (t *Trade) IsOperation() {}
+
+func (t *Trade) GetID() string {
+	return t.ID
+}
+
+func (t *Trade) GetWalletID() string {
+	return t.WalletID
+}
+
+func (t *Trade) GetAmount() money.Decimal {
+	return t.Amount
+}
+
+func (t *Trade) GetDescription() *string {
+	if !t.Description.Valid {
+		return nil
+	}
+	return &t.Description.String
+}
+
+func (t *Trade) GetCreatedAt() time.Time {
+	return t.CreatedAt.UTC()
+}
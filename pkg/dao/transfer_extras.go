@@ -0,0 +1,31 @@
+package dao
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"time"
+)
+
+func (t *Transfer) IsOperation() {}
+
+func (t *Transfer) GetID() string {
+	return t.ID
+}
+
+func (t *Transfer) GetWalletID() string {
+	return t.WalletID
+}
+
+func (t *Transfer) GetAmount() money.Decimal {
+	return t.Amount
+}
+
+func (t *Transfer) GetDescription() *string {
+	if !t.Description.Valid {
+		return nil
+	}
+	return &t.Description.String
+}
+
+func (t *Transfer) GetCreatedAt() time.Time {
+	return t.CreatedAt.UTC()
+}
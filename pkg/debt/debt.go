@@ -0,0 +1,149 @@
+// Package debt computes amortisation schedules of loans repaid in equal monthly payments, and the status of a loan
+// after repayments made so far.
+package debt
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"math"
+	"time"
+)
+
+// Payment is a monthly payment of an amortisation schedule. Amounts are in the currency of the debt.
+type Payment struct {
+	Number             int
+	DueAt              time.Time
+	Amount             money.Decimal
+	Principal          money.Decimal
+	Interest           money.Decimal
+	RemainingPrincipal money.Decimal
+}
+
+// Status is a debt after repayments made up to At.
+type Status struct {
+	Debt *dao.Debt
+	At   time.Time
+	// Repaid is the sum of repayments, of which PrincipalPaid and InterestPaid went towards the debt and Overpaid
+	// exceeded it.
+	Repaid        money.Decimal
+	Repayments    int
+	PrincipalPaid money.Decimal
+	InterestPaid  money.Decimal
+	Overpaid      money.Decimal
+	// RemainingPrincipal is the principal not repaid yet, and InterestDue the interest charged and not paid yet.
+	RemainingPrincipal money.Decimal
+	InterestDue        money.Decimal
+	// ScheduledPrincipal is the principal that should remain after payments of the schedule due up to At.
+	ScheduledPrincipal money.Decimal
+	// NextPayment is the first payment of the schedule due after At, nil once the schedule has ended.
+	NextPayment *Payment
+}
+
+// monthlyRate returns the interest rate of a month for an annual nominal rate in percent.
+func monthlyRate(annualRate float64) float64 {
+	return annualRate / 100 / 12
+}
+
+// DueAt returns the day the n-th payment, counted from 1, is due on. Payments are due on paymentDay of the months
+// following the start of the debt, or on the last day of months shorter than that.
+func DueAt(startsAt time.Time, paymentDay, n int) time.Time {
+	year, month, _ := startsAt.UTC().Date()
+	first := time.Date(year, month+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(paymentDay, last)-1)
+}
+
+// Installment returns the equal monthly payment repaying principal with interest at annualRate in term months,
+// rounded to minor units of currency.
+func Installment(principal money.Decimal, annualRate float64, term int, currency string) money.Decimal {
+	r := monthlyRate(annualRate)
+	if r == 0 {
+		return money.RoundIn(principal.Div(int64(term)), currency)
+	}
+	return money.RoundIn(principal.MulRate(r/(1-math.Pow(1+r, -float64(term)))), currency)
+}
+
+// Schedule returns the amortisation schedule of d. Every month interest is charged on the remaining principal and the
+// rest of the payment repays principal. Interest is rounded to minor units of the currency, so the last payment is
+// adjusted to repay the remaining principal exactly.
+func Schedule(d *dao.Debt) []*Payment {
+	term := int(d.TermMonths)
+	r := monthlyRate(d.InterestRate)
+	installment := Installment(d.Principal, d.InterestRate, term, d.Currency)
+
+	payments := make([]*Payment, 0, term)
+	remaining := d.Principal
+	for n := 1; n <= term && remaining > 0; n++ {
+		interest := money.RoundIn(remaining.MulRate(r), d.Currency)
+		principal := installment - interest
+		if n == term || principal > remaining {
+			principal = remaining
+		}
+		remaining -= principal
+
+		payments = append(payments, &Payment{
+			Number:             n,
+			DueAt:              DueAt(d.StartsAt, int(d.PaymentDay), n),
+			Amount:             principal + interest,
+			Principal:          principal,
+			Interest:           interest,
+			RemainingPrincipal: remaining,
+		})
+	}
+
+	return payments
+}
+
+// Compute returns the status of d after repayments made up to at. Repayments are expenses of the debt sorted by time,
+// their negated amounts repay the debt and refunds are ignored. Interest is charged on the remaining principal on every
+// payment day, also after the term while principal remains. Repayments pay the interest charged until their time first,
+// then principal.
+func Compute(d *dao.Debt, repayments []*dao.Expense, at time.Time) *Status {
+	s := &Status{Debt: d, At: at, RemainingPrincipal: d.Principal, ScheduledPrincipal: d.Principal}
+	r := monthlyRate(d.InterestRate)
+
+	n := 1
+	due := DueAt(d.StartsAt, int(d.PaymentDay), n)
+	charge := func(until time.Time) {
+		for s.RemainingPrincipal > 0 && !due.After(until) {
+			s.InterestDue += money.RoundIn(s.RemainingPrincipal.MulRate(r), d.Currency)
+			n++
+			due = DueAt(d.StartsAt, int(d.PaymentDay), n)
+		}
+	}
+
+	for _, repayment := range repayments {
+		if repayment.CreatedAt.After(at) {
+			break
+		}
+		charge(repayment.CreatedAt)
+
+		paid := repayment.Amount.Neg()
+		if !paid.IsPositive() {
+			continue
+		}
+		s.Repaid += paid
+		s.Repayments++
+
+		interest := min(paid, s.InterestDue)
+		s.InterestDue -= interest
+		s.InterestPaid += interest
+		paid -= interest
+
+		principal := min(paid, s.RemainingPrincipal)
+		s.RemainingPrincipal -= principal
+		s.PrincipalPaid += principal
+		s.Overpaid += paid - principal
+	}
+	charge(at)
+
+	for _, payment := range Schedule(d) {
+		if payment.DueAt.After(at) {
+			s.NextPayment = payment
+			break
+		}
+		s.ScheduledPrincipal = payment.RemainingPrincipal
+	}
+
+	return s
+}
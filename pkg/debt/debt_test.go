@@ -0,0 +1,109 @@
+package debt
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func repayment(amount string, at time.Time) *dao.Expense {
+	return &dao.Expense{Amount: money.MustParse(amount), CreatedAt: at}
+}
+
+// loan is repaid in 12 payments of 106.62 at 1% a month, the last one being 106.60.
+var loan = &dao.Debt{
+	ID: "d1", Currency: "USD", Principal: money.FromInt(1200), InterestRate: 12, TermMonths: 12, PaymentDay: 31,
+	StartsAt: date(2024, 1, 31).Add(9 * time.Hour),
+}
+
+func TestDueAt(t *testing.T) {
+	assert.Equal(t, date(2024, 2, 29), DueAt(loan.StartsAt, 31, 1))
+	assert.Equal(t, date(2024, 3, 31), DueAt(loan.StartsAt, 31, 2))
+	assert.Equal(t, date(2024, 4, 30), DueAt(loan.StartsAt, 31, 3))
+	assert.Equal(t, date(2024, 1, 15), DueAt(date(2023, 12, 15), 15, 1))
+	assert.Equal(t, date(2025, 2, 15), DueAt(date(2023, 12, 15), 15, 14))
+}
+
+func TestInstallment(t *testing.T) {
+	assert.Equal(t, money.MustParse("106.62"), Installment(money.FromInt(1200), 12, 12, "USD"))
+	assert.Equal(t, money.MustParse("333"), Installment(money.FromInt(1000), 0, 3, "JPY"))
+}
+
+func TestSchedule(t *testing.T) {
+	payments := Schedule(loan)
+	require.Len(t, payments, 12)
+
+	assert.Equal(t, &Payment{
+		Number: 1, DueAt: date(2024, 2, 29), Amount: money.MustParse("106.62"), Principal: money.MustParse("94.62"),
+		Interest: money.MustParse("12"), RemainingPrincipal: money.MustParse("1105.38"),
+	}, payments[0])
+	assert.Equal(t, &Payment{
+		Number: 12, DueAt: date(2025, 1, 31), Amount: money.MustParse("106.6"), Principal: money.MustParse("105.54"),
+		Interest: money.MustParse("1.06"), RemainingPrincipal: 0,
+	}, payments[11])
+
+	var principal, interest money.Decimal
+	for _, p := range payments {
+		principal += p.Principal
+		interest += p.Interest
+	}
+	assert.Equal(t, loan.Principal, principal)
+	assert.Equal(t, money.MustParse("79.42"), interest)
+
+	// Interest-free loans are repaid in equal parts, the last one taking what is left after rounding.
+	payments = Schedule(&dao.Debt{Currency: "JPY", Principal: money.FromInt(1000), TermMonths: 3, PaymentDay: 1})
+	require.Len(t, payments, 3)
+	assert.Equal(t, money.MustParse("333"), payments[0].Amount)
+	assert.Equal(t, money.MustParse("334"), payments[2].Amount)
+	assert.Equal(t, money.Decimal(0), payments[2].Interest)
+}
+
+func TestCompute(t *testing.T) {
+	repayments := []*dao.Expense{
+		repayment("-106.62", date(2024, 2, 29).Add(10*time.Hour)),
+		// The March payment was missed, so April pays interest of both months.
+		repayment("-150", date(2024, 4, 30).Add(12*time.Hour)),
+		repayment("5", date(2024, 5, 2)),
+		repayment("-106.62", date(2024, 5, 31).Add(8*time.Hour)),
+	}
+
+	s := Compute(loan, repayments, date(2024, 5, 15))
+	assert.Equal(t, money.MustParse("256.62"), s.Repaid)
+	assert.Equal(t, 2, s.Repayments)
+	assert.Equal(t, money.MustParse("34.1"), s.InterestPaid)
+	assert.Equal(t, money.MustParse("222.52"), s.PrincipalPaid)
+	assert.Equal(t, money.MustParse("977.48"), s.RemainingPrincipal)
+	assert.Equal(t, money.Decimal(0), s.InterestDue)
+	assert.Equal(t, money.Decimal(0), s.Overpaid)
+	assert.Equal(t, money.MustParse("913.29"), s.ScheduledPrincipal)
+	require.NotNil(t, s.NextPayment)
+	assert.Equal(t, 4, s.NextPayment.Number)
+	assert.Equal(t, date(2024, 5, 31), s.NextPayment.DueAt)
+
+	s = Compute(loan, repayments, date(2024, 3, 31).Add(12*time.Hour))
+	assert.Equal(t, money.MustParse("1105.38"), s.RemainingPrincipal)
+	assert.Equal(t, money.MustParse("11.05"), s.InterestDue)
+	assert.Equal(t, money.MustParse("1009.81"), s.ScheduledPrincipal)
+
+	s = Compute(loan, nil, date(2024, 1, 31))
+	assert.Equal(t, loan.Principal, s.RemainingPrincipal)
+	assert.Equal(t, loan.Principal, s.ScheduledPrincipal)
+	assert.Equal(t, 1, s.NextPayment.Number)
+
+	// No interest is charged once principal is repaid, and the schedule has ended.
+	family := &dao.Debt{Currency: "PLN", Principal: money.FromInt(100), TermMonths: 2, PaymentDay: 10, StartsAt: date(2024, 1, 10)}
+	s = Compute(family, []*dao.Expense{repayment("-120", date(2024, 2, 1))}, date(2024, 6, 1))
+	assert.Equal(t, money.Decimal(0), s.RemainingPrincipal)
+	assert.Equal(t, money.FromInt(100), s.PrincipalPaid)
+	assert.Equal(t, money.FromInt(20), s.Overpaid)
+	assert.Equal(t, money.Decimal(0), s.InterestDue)
+	assert.Equal(t, money.Decimal(0), s.ScheduledPrincipal)
+	assert.Nil(t, s.NextPayment)
+}
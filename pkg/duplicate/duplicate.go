@@ -0,0 +1,118 @@
+package duplicate
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Window is the longest time between possible duplicates. Card payments are often booked a few days after they were
+// made, so the same purchase may bear different dates in a manual entry and in a bank statement.
+const Window = 3 * 24 * time.Hour
+
+// Normalize reduces a description to its lowercase words. Digits and punctuation are dropped, as card numbers, dates
+// and references are written differently by every bank.
+func Normalize(description string) string {
+	return strings.Join(words(description), " ")
+}
+
+func words(description string) []string {
+	return strings.FieldsFunc(strings.ToLower(description), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+}
+
+// Match reports whether two expenses may record the same purchase: they have equal amounts, dates within Window and
+// descriptions which are equal once normalized, or whose words are all found in the other one. Missing descriptions
+// match any description.
+func Match(a, b *dao.Expense) bool {
+	if a.Amount != b.Amount {
+		return false
+	}
+	if d := a.CreatedAt.Sub(b.CreatedAt); d > Window || d < -Window {
+		return false
+	}
+
+	wordsA, wordsB := words(a.Description.String), words(b.Description.String)
+	if len(wordsA) > len(wordsB) {
+		wordsA, wordsB = wordsB, wordsA
+	}
+	if len(wordsA) == 0 {
+		return true
+	}
+
+	found := make(map[string]bool, len(wordsB))
+	for _, w := range wordsB {
+		found[w] = true
+	}
+	for _, w := range wordsA {
+		if !found[w] {
+			return false
+		}
+	}
+	return true
+}
+
+// Groups finds groups of expenses which may record the same purchase. Expenses matching any member of a group belong
+// to it. Groups hold at least two expenses, ordered by date, and are ordered by the date of their first expense.
+func Groups(expenses []*dao.Expense) [][]*dao.Expense {
+	sorted := make([]*dao.Expense, len(expenses))
+	copy(sorted, expenses)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Amount != sorted[j].Amount {
+			return sorted[i].Amount < sorted[j].Amount
+		}
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	parent := make([]int, len(sorted))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for i := range sorted {
+		for j := i + 1; j < len(sorted); j++ {
+			// Expenses of equal amount are sorted by date, no later one is within Window.
+			if sorted[j].Amount != sorted[i].Amount || sorted[j].CreatedAt.Sub(sorted[i].CreatedAt) > Window {
+				break
+			}
+			if Match(sorted[i], sorted[j]) {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	byRoot := map[int][]*dao.Expense{}
+	for i, e := range sorted {
+		root := find(i)
+		byRoot[root] = append(byRoot[root], e)
+	}
+
+	var groups [][]*dao.Expense
+	for _, group := range byRoot {
+		if len(group) < 2 {
+			continue
+		}
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].CreatedAt.Before(group[j].CreatedAt)
+		})
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if !groups[i][0].CreatedAt.Equal(groups[j][0].CreatedAt) {
+			return groups[i][0].CreatedAt.Before(groups[j][0].CreatedAt)
+		}
+		return groups[i][0].ID < groups[j][0].ID
+	})
+
+	return groups
+}
@@ -0,0 +1,68 @@
+package duplicate
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func expense(id, amount string, day int, description string) *dao.Expense {
+	return &dao.Expense{
+		ID:          id,
+		Amount:      money.MustParse(amount),
+		CreatedAt:   time.Date(2024, 3, day, 12, 0, 0, 0, time.UTC),
+		Description: dao.NilStr(description),
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "card payment żabka", Normalize("CARD PAYMENT 1234-**** Żabka, 03.03.2024"))
+	assert.Equal(t, "", Normalize(" 12/03 "))
+}
+
+func TestMatch(t *testing.T) {
+	coffee := expense("e1", "-12.5", 1, "Coffee Shop")
+	tests := []struct {
+		name  string
+		other *dao.Expense
+		want  bool
+	}{
+		{name: "same", other: expense("e2", "-12.5", 1, "Coffee Shop"), want: true},
+		{name: "bank description", other: expense("e2", "-12.5", 3, "CARD 1234 COFFEE SHOP 01.03"), want: true},
+		{name: "no description", other: expense("e2", "-12.5", 2, ""), want: true},
+		{name: "other amount", other: expense("e2", "-12.6", 1, "Coffee Shop"), want: false},
+		{name: "too late", other: expense("e2", "-12.5", 5, "Coffee Shop"), want: false},
+		{name: "other shop", other: expense("e2", "-12.5", 1, "Tea Shop"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Match(coffee, tt.other))
+			assert.Equal(t, tt.want, Match(tt.other, coffee))
+		})
+	}
+}
+
+func TestGroups(t *testing.T) {
+	expenses := []*dao.Expense{
+		expense("taxi", "-30", 10, "Taxi"),
+		expense("coffee", "-12.5", 1, "Coffee"),
+		expense("taxi-card", "-30", 9, "CARD Taxi"),
+		expense("rent", "-1000", 1, "Rent"),
+		expense("coffee-card", "-12.5", 3, "CARD 1234 Coffee"),
+		expense("coffee-later", "-12.5", 6, "Coffee"),
+		expense("tea", "-12.5", 2, "Tea"),
+	}
+
+	groups := Groups(expenses)
+	ids := make([][]string, len(groups))
+	for i, group := range groups {
+		for _, e := range group {
+			ids[i] = append(ids[i], e.ID)
+		}
+	}
+	// The later coffee is too late for the first one, but close enough to the card payment.
+	assert.Equal(t, [][]string{{"coffee", "coffee-card", "coffee-later"}, {"taxi-card", "taxi"}}, ids)
+	assert.Empty(t, Groups(expenses[:2]))
+}
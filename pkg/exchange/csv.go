@@ -0,0 +1,107 @@
+package exchange
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// csvDateLayouts are layouts of days in rate files: ECB historical files use ISO dates, daily ones spell out months.
+var csvDateLayouts = []string{time.DateOnly, "02 January 2006"}
+
+// ReadCSV returns a Reader of a CSV rate file laid out like ECB eurofxref.csv and eurofxref-hist.csv: a header of Date
+// followed by currency codes, then a row of rates into each currency per day. Rates are units of the currency worth one
+// unit of base. Empty and N/A rates are skipped.
+func ReadCSV(r io.Reader, base string) (Reader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: header is missing", ErrInvalidFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+	if len(header) < 2 || !strings.EqualFold(strings.TrimSpace(header[0]), "date") {
+		return nil, fmt.Errorf("%w: header must start with Date followed by currencies", ErrInvalidFile)
+	}
+
+	// ECB files end rows with a comma, giving an empty last column.
+	currencies := header[1:]
+	for i := range currencies {
+		currencies[i] = strings.TrimSpace(currencies[i])
+	}
+	for len(currencies) > 0 && currencies[len(currencies)-1] == "" {
+		currencies = currencies[:len(currencies)-1]
+	}
+
+	return &csvReader{reader: reader, base: base, currencies: currencies}, nil
+}
+
+type csvReader struct {
+	reader     *csv.Reader
+	base       string
+	currencies []string
+	day        time.Time
+	// row holds rates of the current day, those before col were read.
+	row []string
+	col int
+}
+
+func (r *csvReader) Read() (*Rate, error) {
+	for {
+		for r.col < len(r.row) {
+			i := r.col
+			value := strings.TrimSpace(r.row[i])
+			r.col++
+			if value == "" || value == "N/A" {
+				continue
+			}
+
+			rate, err := parseRate(value)
+			if err != nil {
+				line, _ := r.reader.FieldPos(0)
+				return nil, fmt.Errorf("%w: line %d: rate of %s: %w", ErrInvalidFile, line, r.currencies[i], err)
+			}
+			return &Rate{Base: r.base, Currency: r.currencies[i], ValidOn: r.day, Rate: rate}, nil
+		}
+
+		row, err := r.reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+		}
+
+		line, _ := r.reader.FieldPos(0)
+		if r.day, err = parseDay(row[0]); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidFile, line, err)
+		}
+		row = row[1:]
+		if len(row) > len(r.currencies) {
+			for _, extra := range row[len(r.currencies):] {
+				if strings.TrimSpace(extra) != "" {
+					return nil, fmt.Errorf("%w: line %d: more rates than currencies", ErrInvalidFile, line)
+				}
+			}
+			row = row[:len(r.currencies)]
+		}
+		r.row, r.col = row, 0
+	}
+}
+
+func parseDay(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range csvDateLayouts {
+		if day, err := time.Parse(layout, s); err == nil {
+			return day, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid day %q", s)
+}
@@ -0,0 +1,69 @@
+package exchange
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadCSV(t *testing.T) {
+	march := func(day int) time.Time {
+		return time.Date(2024, 3, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := map[string]struct {
+		file string
+		want []*Rate
+	}{
+		"ecb historical": {
+			file: "Date,USD,JPY,CYP,\n2024-03-04,1.0842,162.71,N/A,\n2024-03-01,1.0813,,N/A,\n",
+			want: []*Rate{
+				{Base: "EUR", Currency: "USD", ValidOn: march(4), Rate: 1.0842},
+				{Base: "EUR", Currency: "JPY", ValidOn: march(4), Rate: 162.71},
+				{Base: "EUR", Currency: "USD", ValidOn: march(1), Rate: 1.0813},
+			},
+		},
+		"ecb daily": {
+			file: "Date, USD, JPY, \n04 March 2024, 1.0842, 162.71, \n",
+			want: []*Rate{
+				{Base: "EUR", Currency: "USD", ValidOn: march(4), Rate: 1.0842},
+				{Base: "EUR", Currency: "JPY", ValidOn: march(4), Rate: 162.71},
+			},
+		},
+		"short row": {
+			file: "date,USD,JPY\n2024-03-04,1.0842\n",
+			want: []*Rate{{Base: "EUR", Currency: "USD", ValidOn: march(4), Rate: 1.0842}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, err := ReadCSV(strings.NewReader(tt.file), "EUR")
+			require.Nil(t, err)
+			rates, err := readAll(r)
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, rates)
+		})
+	}
+
+	for name, header := range map[string]string{"empty": "", "no date": "USD,JPY\n", "no currencies": "Date\n"} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ReadCSV(strings.NewReader(header), "EUR")
+			assert.ErrorIs(t, err, ErrInvalidFile)
+		})
+	}
+
+	for name, file := range map[string]string{
+		"invalid day":  "Date,USD\n2024/03/04,1.08\n",
+		"invalid rate": "Date,USD\n2024-03-04,1,08\n",
+		"zero rate":    "Date,USD\n2024-03-04,0\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			r, err := ReadCSV(strings.NewReader(file), "EUR")
+			require.Nil(t, err)
+			_, err = readAll(r)
+			assert.ErrorIs(t, err, ErrInvalidFile)
+		})
+	}
+}
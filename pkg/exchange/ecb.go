@@ -0,0 +1,99 @@
+package exchange
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// ECBBase is the base currency of ECB euro foreign exchange reference rates.
+const ECBBase = "EUR"
+
+// Reader reads rates of a rate file one by one and returns io.EOF after the last one.
+type Reader interface {
+	Read() (*Rate, error)
+}
+
+// ReadECB returns a Reader of ECB euro foreign exchange reference rates in XML, such as the daily eurofxref-daily.xml or
+// the historical eurofxref-hist.xml. Rates are read as they are decoded, so files of any size are read in constant
+// memory.
+func ReadECB(r io.Reader) Reader {
+	return &ecbReader{decoder: xml.NewDecoder(r)}
+}
+
+type ecbReader struct {
+	decoder *xml.Decoder
+	started bool
+	day     *time.Time
+}
+
+// Rates are nested in Cube elements of days, which are nested in a Cube element themselves:
+// <Cube><Cube time="2024-03-01"><Cube currency="USD" rate="1.0813"/></Cube></Cube>.
+
+func (r *ecbReader) Read() (*Rate, error) {
+	for {
+		token, err := r.decoder.Token()
+		if errors.Is(err, io.EOF) {
+			if !r.started {
+				return nil, fmt.Errorf("%w: Envelope element is missing", ErrInvalidFile)
+			}
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if !r.started {
+			if start.Name.Local != "Envelope" {
+				return nil, fmt.Errorf("%w: expected Envelope element, got %s", ErrInvalidFile, start.Name.Local)
+			}
+			r.started = true
+			continue
+		}
+		if start.Name.Local != "Cube" {
+			continue
+		}
+
+		attrs := map[string]string{}
+		for _, attr := range start.Attr {
+			attrs[attr.Name.Local] = attr.Value
+		}
+		if day, ok := attrs["time"]; ok {
+			t, err := time.Parse(time.DateOnly, day)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid day %q", ErrInvalidFile, day)
+			}
+			r.day = &t
+			continue
+		}
+
+		currency, ok := attrs["currency"]
+		if !ok {
+			continue
+		}
+		if r.day == nil {
+			return nil, fmt.Errorf("%w: rate of %s outside of a day", ErrInvalidFile, currency)
+		}
+		rate, err := parseRate(attrs["rate"])
+		if err != nil {
+			return nil, fmt.Errorf("%w: rate of %s on %s: %w", ErrInvalidFile, currency, r.day.Format(time.DateOnly), err)
+		}
+		return &Rate{Base: ECBBase, Currency: currency, ValidOn: *r.day, Rate: rate}, nil
+	}
+}
+
+// parseRate reads a positive decimal rate.
+func parseRate(s string) (float64, error) {
+	rate, err := strconv.ParseFloat(s, 64)
+	if err != nil || rate <= 0 {
+		return 0, fmt.Errorf("invalid rate %q", s)
+	}
+	return rate, nil
+}
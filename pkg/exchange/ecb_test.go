@@ -0,0 +1,69 @@
+package exchange
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+const ecbRates = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time='2024-03-04'>
+			<Cube currency='USD' rate='1.0842'/>
+			<Cube currency='JPY' rate='162.71'/>
+		</Cube>
+		<Cube time='2024-03-01'>
+			<Cube currency='USD' rate='1.0813'/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>
+`
+
+// readAll returns all rates read by r.
+func readAll(r Reader) ([]*Rate, error) {
+	var rates []*Rate
+	for {
+		rate, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return rates, nil
+		}
+		if err != nil {
+			return rates, err
+		}
+		rates = append(rates, rate)
+	}
+}
+
+func TestReadECB(t *testing.T) {
+	rates, err := readAll(ReadECB(strings.NewReader(ecbRates)))
+	require.Nil(t, err)
+	assert.Equal(t, []*Rate{
+		{Base: "EUR", Currency: "USD", ValidOn: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC), Rate: 1.0842},
+		{Base: "EUR", Currency: "JPY", ValidOn: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC), Rate: 162.71},
+		{Base: "EUR", Currency: "USD", ValidOn: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Rate: 1.0813},
+	}, rates)
+
+	tests := map[string]string{
+		"not ecb":       `<Document><Cube time="2024-03-01"><Cube currency="USD" rate="1"/></Cube></Document>`,
+		"empty":         ``,
+		"invalid day":   `<Envelope><Cube><Cube time="01.03.2024"><Cube currency="USD" rate="1"/></Cube></Cube></Envelope>`,
+		"invalid rate":  `<Envelope><Cube><Cube time="2024-03-01"><Cube currency="USD" rate="-1"/></Cube></Cube></Envelope>`,
+		"rate sans day": `<Envelope><Cube><Cube currency="USD" rate="1"/></Cube></Envelope>`,
+		"truncated":     `<Envelope><Cube><Cube time="2024-03-01">`,
+	}
+	for name, file := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := readAll(ReadECB(strings.NewReader(file)))
+			assert.ErrorIs(t, err, ErrInvalidFile)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package exchange
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"time"
+)
+
+var (
+	ErrNoRate      = fmt.Errorf("no exchange rate")
+	ErrInvalidFile = fmt.Errorf("invalid rate file")
+)
+
+// Rate is the number of units of Currency worth one unit of Base on a day.
+type Rate struct {
+	Base     string
+	Currency string
+	// ValidOn is the day the rate was published for, at midnight UTC.
+	ValidOn time.Time
+	Rate    float64
+}
+
+// Convert applies exchange rate to amount, rounding the result to minor units of currency it is converted into.
+func Convert(amount money.Decimal, rate float64, currency string) money.Decimal {
+	return money.RoundIn(amount.MulRate(rate), currency)
+}
+
+// Converter converts amounts between currencies at stored rates. Rates are looked up directly, inverted, or crossed
+// through a base currency shared by both currencies, such as EUR for ECB rates. The most recent of them is used. A
+// Converter caches rates it found, use one per request.
+type Converter struct {
+	q     dao.Querier
+	cache map[rateKey]*dao.ExchangeRate
+}
+
+type rateKey struct {
+	base, currency string
+	day            time.Time
+}
+
+func NewConverter(q dao.Querier) *Converter {
+	return &Converter{
+		q:     q,
+		cache: map[rateKey]*dao.ExchangeRate{},
+	}
+}
+
+// Rate returns the number of units of to worth one unit of from at given time, using the latest rates published for
+// the day of at or earlier.
+func (c *Converter) Rate(ctx context.Context, from, to string, at time.Time) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+
+	var (
+		rate    float64
+		validOn time.Time
+	)
+	use := func(r float64, day time.Time) {
+		if rate == 0 || day.After(validOn) {
+			rate, validOn = r, day
+		}
+	}
+
+	direct, err := c.latest(ctx, from, to, at)
+	if err != nil {
+		return 0, err
+	}
+	if direct != nil {
+		use(direct.Rate, direct.ValidOn)
+	}
+
+	inverse, err := c.latest(ctx, to, from, at)
+	if err != nil {
+		return 0, err
+	}
+	if inverse != nil {
+		use(1/inverse.Rate, inverse.ValidOn)
+	}
+
+	bases, err := c.q.ExchangeRateBases(ctx, from)
+	if err != nil {
+		return 0, fmt.Errorf("cannot read exchange rates: %w", err)
+	}
+	for _, base := range bases {
+		if base == to {
+			continue
+		}
+		fromRate, err := c.latest(ctx, base, from, at)
+		if err != nil {
+			return 0, err
+		}
+		toRate, err := c.latest(ctx, base, to, at)
+		if err != nil {
+			return 0, err
+		}
+		if fromRate == nil || toRate == nil {
+			continue
+		}
+
+		// A crossed rate is as old as the older of its rates.
+		day := fromRate.ValidOn
+		if toRate.ValidOn.Before(day) {
+			day = toRate.ValidOn
+		}
+		use(toRate.Rate/fromRate.Rate, day)
+	}
+
+	if rate == 0 {
+		return 0, fmt.Errorf("%w of %s into %s on %s", ErrNoRate, from, to, at.UTC().Format(time.DateOnly))
+	}
+	return rate, nil
+}
+
+// Convert converts amount in currency from into currency to at the rate valid at given time.
+func (c *Converter) Convert(ctx context.Context, amount money.Decimal, from, to string, at time.Time) (money.Decimal, error) {
+	if from == to {
+		return amount, nil
+	}
+
+	rate, err := c.Rate(ctx, from, to, at)
+	if err != nil {
+		return 0, err
+	}
+	return Convert(amount, rate, to), nil
+}
+
+// latest returns the latest rate of a pair valid on the day of at, or nil when there is none.
+func (c *Converter) latest(ctx context.Context, base, currency string, at time.Time) (*dao.ExchangeRate, error) {
+	key := rateKey{base: base, currency: currency, day: Day(at)}
+	if rate, ok := c.cache[key]; ok {
+		return rate, nil
+	}
+
+	rate, err := c.q.ExchangeRateLatest(ctx, base, currency, key.day)
+	if errors.Is(err, sql.ErrNoRows) {
+		rate = nil
+	} else if err != nil {
+		return nil, fmt.Errorf("cannot read exchange rate: %w", err)
+	}
+
+	c.cache[key] = rate
+	return rate, nil
+}
+
+// Day returns midnight UTC of the day of t.
+func Day(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,108 @@
+package exchange
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConvert(t *testing.T) {
+	assert.Equal(t, money.MustParse("430"), Convert(money.MustParse("100"), 4.3, "PLN"))
+	assert.Equal(t, money.MustParse("33.33"), Convert(money.MustParse("100"), 1.0/3, "EUR"))
+	assert.Equal(t, money.MustParse("-0.01"), Convert(money.MustParse("-0.005"), 1, "USD"))
+	assert.Equal(t, money.MustParse("16271"), Convert(money.MustParse("100"), 162.705, "JPY"))
+	assert.Equal(t, money.MustParse("30.797"), Convert(money.MustParse("100"), 0.30797, "KWD"))
+	assert.Equal(t, money.MustParse("33.33"), Convert(money.MustParse("100"), 1.0/3, "XYZ"))
+}
+
+func TestConverter(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	rates := []*dao.ExchangeRateUpsertParams{
+		{Base: "EUR", Currency: "USD", ValidOn: date(3, 1), Rate: 1.08},
+		{Base: "EUR", Currency: "PLN", ValidOn: date(3, 1), Rate: 4.32},
+		{Base: "EUR", Currency: "USD", ValidOn: date(3, 4), Rate: 1.1},
+		{Base: "EUR", Currency: "PLN", ValidOn: date(3, 4), Rate: 4.4},
+		{Base: "USD", Currency: "PLN", ValidOn: date(2, 1), Rate: 3.9},
+	}
+	for _, rate := range rates {
+		rate.Source, rate.CreatedAt = "csv", rate.ValidOn
+		require.Nil(t, d.ExchangeRateUpsert(ctx, rate))
+	}
+
+	tests := map[string]struct {
+		from, to string
+		at       time.Time
+		want     float64
+		err      error
+	}{
+		"same currency":         {from: "EUR", to: "EUR", at: date(1, 1), want: 1},
+		"direct":                {from: "EUR", to: "USD", at: date(3, 1).Add(12 * time.Hour), want: 1.08},
+		"latest direct":         {from: "EUR", to: "USD", at: date(3, 10), want: 1.1},
+		"inverse":               {from: "PLN", to: "EUR", at: date(3, 4), want: 1 / 4.4},
+		"crossed is fresher":    {from: "USD", to: "PLN", at: date(3, 2), want: 4.32 / 1.08},
+		"direct before crossed": {from: "USD", to: "PLN", at: date(2, 15), want: 3.9},
+		"before any rate":       {from: "EUR", to: "USD", at: date(2, 29), err: ErrNoRate},
+		"unknown currency":      {from: "EUR", to: "GBP", at: date(3, 4), err: ErrNoRate},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewConverter(d).Rate(ctx, tt.from, tt.to, tt.at)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.Nil(t, err)
+			assert.InDelta(t, tt.want, got, 1e-12)
+		})
+	}
+
+	converted, err := NewConverter(d).Convert(ctx, money.MustParse("100"), "EUR", "PLN", date(3, 5))
+	require.Nil(t, err)
+	assert.Equal(t, money.MustParse("440"), converted)
+}
+
+func TestStore_Import(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	store := NewStore(d)
+
+	r, err := ReadCSV(strings.NewReader("Date,USD,PLN\n2024-03-01,1.08,4.32\n"), "EUR")
+	require.Nil(t, err)
+	count, err := store.Import(ctx, r, "csv")
+	require.Nil(t, err)
+	assert.Equal(t, 2, count)
+
+	// Importing a day again replaces its rates.
+	r, err = ReadCSV(strings.NewReader("Date,USD\n2024-03-01,1.09\n"), "EUR")
+	require.Nil(t, err)
+	_, err = store.Import(ctx, r, "csv")
+	require.Nil(t, err)
+	rate, err := d.ExchangeRateLatest(ctx, "EUR", "USD", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	require.Nil(t, err)
+	assert.Equal(t, 1.09, rate.Rate)
+
+	// Invalid rates roll back the whole file.
+	r, err = ReadCSV(strings.NewReader("Date,USD,PLN\n2024-03-02,1.1,4.4\n2024-03-03,1.1,x\n"), "EUR")
+	require.Nil(t, err)
+	_, err = store.Import(ctx, r, "csv")
+	assert.ErrorIs(t, err, ErrInvalidFile)
+	rate, err = d.ExchangeRateLatest(ctx, "EUR", "USD", time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC))
+	require.Nil(t, err)
+	assert.Equal(t, 1.09, rate.Rate)
+
+	r, err = ReadCSV(strings.NewReader("Date,EUR\n2024-03-02,1\n"), "EUR")
+	require.Nil(t, err)
+	_, err = store.Import(ctx, r, "csv")
+	assert.ErrorIs(t, err, ErrInvalidFile)
+}
@@ -0,0 +1,59 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"io"
+	"time"
+)
+
+// Store loads rate files into the database, rates are then read by Converters.
+type Store struct {
+	db *dao.DAO
+}
+
+func NewStore(db *dao.DAO) *Store {
+	return &Store{
+		db: db,
+	}
+}
+
+// Import stores all rates read by r in one transaction, replacing rates of the same pairs and days. Source names the
+// format of the file. Any invalid rate rolls back the whole import. It returns the number of stored rates.
+func (s *Store) Import(ctx context.Context, r Reader, source string) (int, error) {
+	q, rollBacker, err := s.db.BeginTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("cannot import exchange rates: %w", err)
+	}
+	defer rollBacker()
+
+	now := time.Now().UTC()
+	count := 0
+	for {
+		rate, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return count, q.Commit(ctx)
+		}
+		if err != nil {
+			return 0, err
+		}
+		if rate.Base == rate.Currency {
+			return 0, fmt.Errorf("%w: rate of %s into itself", ErrInvalidFile, rate.Base)
+		}
+
+		err = q.ExchangeRateUpsert(ctx, &dao.ExchangeRateUpsertParams{
+			Base:      rate.Base,
+			Currency:  rate.Currency,
+			ValidOn:   Day(rate.ValidOn),
+			Rate:      rate.Rate,
+			Source:    source,
+			CreatedAt: now,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("cannot store exchange rate: %w", err)
+		}
+		count++
+	}
+}
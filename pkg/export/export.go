@@ -0,0 +1,112 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/access"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"io"
+)
+
+var (
+	ErrWalletAccess  = fmt.Errorf("wallet not found")
+	ErrUnknownFormat = fmt.Errorf("unknown export format")
+	ErrInvalidFilter = fmt.Errorf("invalid export filter")
+)
+
+// Format names the file format of an export.
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+	FormatXLSX Format = "xlsx"
+)
+
+// ParseFormat returns the Format of given name, CSV if name is empty.
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(name); f {
+	case "":
+		return FormatCSV, nil
+	case FormatCSV, FormatJSON, FormatXLSX:
+		return f, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownFormat, name)
+	}
+}
+
+// ContentType returns the MIME type of files of format f.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatJSON:
+		return "application/json"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// Exporter writes expenses of wallets to files for accountants and spreadsheets.
+type Exporter struct {
+	db   *dao.DAO
+	auth *auth.Service
+}
+
+func NewExporter(db *dao.DAO, authService *auth.Service) *Exporter {
+	return &Exporter{
+		db:   db,
+		auth: authService,
+	}
+}
+
+// LookupUser returns the user identified by email, for exports started outside of the HTTP API.
+func (e *Exporter) LookupUser(ctx context.Context, email string) (*auth.User, error) {
+	return e.auth.GetUser(ctx, email)
+}
+
+// Export writes expenses matching arg to w in given format, one row per expense. The wallet of arg must be visible to
+// user. Expenses are read from the database and written one at a time, so wallets of any size can be exported.
+func (e *Exporter) Export(ctx context.Context, w io.Writer, user *auth.User, format Format, arg *dao.ExpenseListParams) error {
+	wallet, _, err := access.Wallet(ctx, e.db, user.ID, arg.WalletID)
+	if errors.Is(err, access.ErrWalletNotFound) {
+		return ErrWalletAccess
+	}
+	if err != nil {
+		return fmt.Errorf("cannot check wallet access: %w", err)
+	}
+
+	arg.TagUserID = user.ID
+
+	categories, err := e.db.CategoryListByWallet(ctx, wallet.ID)
+	if err != nil {
+		return fmt.Errorf("cannot list categories: %w", err)
+	}
+	categoryNames := make(map[string]string, len(categories))
+	for _, category := range categories {
+		categoryNames[category.ID] = category.Name
+	}
+
+	out, err := newRowWriter(format, w)
+	if err != nil {
+		return err
+	}
+
+	err = e.db.ExpenseEach(ctx, arg, func(expense *dao.Expense) error {
+		return out.Write(&row{
+			ID:          expense.ID,
+			Date:        expense.CreatedAt.UTC(),
+			Amount:      expense.Amount,
+			Currency:    wallet.Currency,
+			Description: expense.Description.String,
+			Category:    categoryNames[expense.CategoryID.String],
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("cannot export expenses: %w", err)
+	}
+
+	return out.Close()
+}
@@ -0,0 +1,148 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// newTestExporter returns an Exporter of wallet w1 owned by u1, holding two expenses in March 2024 and one in April.
+func newTestExporter(t *testing.T) *Exporter {
+	ctx := context.Background()
+	d := daotest.New(t)
+	now := time.Now().UTC()
+
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: "u1", Currency: "EUR", CreatedAt: now}))
+	require.Nil(t, d.CategoryInsert(ctx, &dao.CategoryInsertParams{ID: "food", UserID: "u1", Name: "Food", CreatedAt: now}))
+	for _, e := range []*dao.ExpenseInsertParams{
+		{ID: "e1", Amount: money.MustParse("-12.5"), Description: dao.NilStr(`Coffee, "to go"`), CategoryID: dao.NilStr("food"), CreatedAt: time.Date(2024, 3, 1, 8, 30, 0, 0, time.UTC)},
+		{ID: "e2", Amount: money.MustParse("-1000"), CreatedAt: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{ID: "e3", Amount: money.MustParse("-3"), CreatedAt: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+	} {
+		e.WalletID = "w1"
+		require.Nil(t, d.ExpenseInsert(ctx, e))
+	}
+
+	return NewExporter(d, nil)
+}
+
+func march() *dao.ExpenseListParams {
+	from, to := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	return &dao.ExpenseListParams{WalletID: "w1", CreatedFrom: &from, CreatedTo: &to}
+}
+
+func TestExporter_Export(t *testing.T) {
+	ctx := context.Background()
+	e := newTestExporter(t)
+	owner := &auth.User{ID: "u1"}
+
+	var buf bytes.Buffer
+	require.Nil(t, e.Export(ctx, &buf, owner, FormatCSV, march()))
+	assert.Equal(t, "id,date,amount,currency,description,category\n"+
+		"e1,2024-03-01T08:30:00Z,-12.50,EUR,\"Coffee, \"\"to go\"\"\",Food\n"+
+		"e2,2024-03-15T00:00:00Z,-1000.00,EUR,,\n", buf.String())
+
+	buf.Reset()
+	require.Nil(t, e.Export(ctx, &buf, owner, FormatJSON, march()))
+	var rows []map[string]interface{}
+	d := json.NewDecoder(&buf)
+	d.UseNumber()
+	require.Nil(t, d.Decode(&rows))
+	require.Len(t, rows, 2)
+	assert.Equal(t, map[string]interface{}{
+		"id": "e1", "date": "2024-03-01T08:30:00Z", "amount": json.Number("-12.50"), "currency": "EUR",
+		"description": `Coffee, "to go"`, "category": "Food",
+	}, rows[0])
+	assert.Equal(t, json.Number("-1000.00"), rows[1]["amount"])
+
+	buf.Reset()
+	none := march()
+	none.Sign = 1
+	require.Nil(t, e.Export(ctx, &buf, owner, FormatJSON, none))
+	assert.Equal(t, "[]\n", buf.String())
+
+	buf.Reset()
+	require.Nil(t, e.Export(ctx, &buf, owner, FormatXLSX, march()))
+	f, err := excelize.OpenReader(&buf)
+	require.Nil(t, err)
+	sheet, err := f.GetRows(xlsxSheet, excelize.Options{RawCellValue: true})
+	require.Nil(t, err)
+	require.Len(t, sheet, 3)
+	assert.Equal(t, columns, sheet[0])
+	assert.Equal(t, []string{"e1", "45352.354166666664", "-12.5", "EUR", `Coffee, "to go"`, "Food"}, sheet[1])
+	date, err := f.GetCellValue(xlsxSheet, "B2")
+	require.Nil(t, err)
+	assert.Equal(t, "3/1/24 08:30", date)
+
+	assert.ErrorIs(t, e.Export(ctx, &buf, &auth.User{ID: "u2"}, FormatCSV, march()), ErrWalletAccess)
+}
+
+func TestParseParams(t *testing.T) {
+	arg, err := ParseParams(url.Values{
+		"walletId":    {"w1"},
+		"createdFrom": {"2024-03-01"},
+		"createdTo":   {"2024-04-01T00:00:00+02:00"},
+		"amountMin":   {"-100"},
+		"description": {"coffee"},
+		"sign":        {"negative"},
+		"tagsAny":     {" Vacation", "tax"},
+	})
+	require.Nil(t, err)
+	from, to := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 31, 22, 0, 0, 0, time.UTC)
+	assert.True(t, from.Equal(*arg.CreatedFrom))
+	assert.True(t, to.Equal(*arg.CreatedTo))
+	assert.Equal(t, money.MustParse("-100"), *arg.AmountMin)
+	assert.Nil(t, arg.AmountMax)
+	assert.Equal(t, "coffee", *arg.Description)
+	assert.Equal(t, -1, arg.Sign)
+	assert.Equal(t, []string{"vacation", "tax"}, arg.TagsAny)
+
+	for _, values := range []url.Values{
+		{},
+		{"walletId": {"w1"}, "createdFrom": {"March"}},
+		{"walletId": {"w1"}, "amountMax": {"1e3"}},
+		{"walletId": {"w1"}, "sign": {"zero"}},
+	} {
+		_, err = ParseParams(values)
+		assert.ErrorIs(t, err, ErrInvalidFilter, values)
+	}
+}
+
+func TestNewHandler(t *testing.T) {
+	handler := NewHandler(newTestExporter(t))
+	get := func(user *auth.User, query string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/export?"+query, nil)
+		if user != nil {
+			r = r.WithContext(context.WithValue(r.Context(), auth.CtxUserKey, user))
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := get(&auth.User{ID: "u1"}, "walletId=w1&createdFrom=2024-04-01&format=csv")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="expenses-w1.csv"`, w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "id,date,amount,currency,description,category\ne3,2024-04-01T00:00:00Z,-3.00,EUR,,\n", w.Body.String())
+
+	assert.Equal(t, http.StatusUnauthorized, get(nil, "walletId=w1").Code)
+	assert.Equal(t, http.StatusBadRequest, get(&auth.User{ID: "u1"}, "walletId=w1&format=pdf").Code)
+	assert.Equal(t, http.StatusBadRequest, get(&auth.User{ID: "u1"}, "format=csv").Code)
+
+	w = get(&auth.User{ID: "u2"}, "walletId=w1")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Empty(t, w.Header().Get("Content-Disposition"))
+}
@@ -0,0 +1,155 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ParseParams reads expense filters from query parameters named like fields of ExpenseFilter of listExpenses:
+// walletId, createdFrom, createdTo, amountMin, amountMax, description, categoryId, sign, tagsAny and tagsAll. Times are
+// given in RFC 3339 or as dates, tags are given as repeated parameters.
+func ParseParams(values url.Values) (*dao.ExpenseListParams, error) {
+	arg := &dao.ExpenseListParams{WalletID: values.Get("walletId")}
+	if arg.WalletID == "" {
+		return nil, fmt.Errorf("%w: walletId is required", ErrInvalidFilter)
+	}
+
+	var err error
+	if arg.CreatedFrom, err = parseTime(values, "createdFrom"); err != nil {
+		return nil, err
+	}
+	if arg.CreatedTo, err = parseTime(values, "createdTo"); err != nil {
+		return nil, err
+	}
+	if arg.AmountMin, err = parseAmount(values, "amountMin"); err != nil {
+		return nil, err
+	}
+	if arg.AmountMax, err = parseAmount(values, "amountMax"); err != nil {
+		return nil, err
+	}
+	if v := values.Get("description"); v != "" {
+		arg.Description = &v
+	}
+	if v := values.Get("categoryId"); v != "" {
+		arg.CategoryID = &v
+	}
+
+	switch sign := values.Get("sign"); sign {
+	case "":
+	case "positive":
+		arg.Sign = 1
+	case "negative":
+		arg.Sign = -1
+	default:
+		return nil, fmt.Errorf("%w: sign must be positive or negative, got %q", ErrInvalidFilter, sign)
+	}
+
+	arg.TagsAny = parseTags(values["tagsAny"])
+	arg.TagsAll = parseTags(values["tagsAll"])
+
+	return arg, nil
+}
+
+func parseTime(values url.Values, key string) (*time.Time, error) {
+	v := values.Get(key)
+	if v == "" {
+		return nil, nil
+	}
+
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s must be a date or RFC 3339 time, got %q", ErrInvalidFilter, key, v)
+}
+
+func parseAmount(values url.Values, key string) (*money.Decimal, error) {
+	v := values.Get(key)
+	if v == "" {
+		return nil, nil
+	}
+
+	amount, err := money.Parse(v)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalidFilter, key, err)
+	}
+	return &amount, nil
+}
+
+// parseTags lower-cases tag names, as listExpenses does.
+func parseTags(tags []string) []string {
+	var out []string
+	for _, tag := range tags {
+		if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" {
+			out = append(out, tag)
+		}
+	}
+	return out
+}
+
+// NewHandler returns a handler of GET requests downloading expenses of a wallet as a file. Filters are given by query
+// parameters read by ParseParams, format by the format parameter. Handler must be wrapped in auth.Service.Middleware.
+func NewHandler(exporter *Exporter) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		user := auth.GetCtxUser(r.Context())
+		if user == nil {
+			http.Error(w, auth.ErrNotAuthorized.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		query := r.URL.Query()
+		format, err := ParseFormat(query.Get("format"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		arg, err := ParseParams(query)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", format.ContentType())
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="expenses-%s.%s"`, arg.WalletID, format))
+
+		// Access is checked before anything is written, so the response status can still report it. Once rows are
+		// sent, the connection is aborted instead, so the client does not take a truncated file for a whole one.
+		out := &responseWriter{ResponseWriter: w}
+		err = exporter.Export(r.Context(), out, user, format, arg)
+		switch {
+		case err == nil:
+		case out.written:
+			panic(http.ErrAbortHandler)
+		case errors.Is(err, ErrWalletAccess):
+			w.Header().Del("Content-Disposition")
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			w.Header().Del("Content-Disposition")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// responseWriter records whether the response body was started.
+type responseWriter struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (w *responseWriter) Write(p []byte) (int, error) {
+	w.written = true
+	return w.ResponseWriter.Write(p)
+}
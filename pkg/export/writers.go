@@ -0,0 +1,189 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/xuri/excelize/v2"
+	"io"
+	"strconv"
+	"time"
+)
+
+// columns name the fields of row, in order of their columns.
+var columns = []string{"id", "date", "amount", "currency", "description", "category"}
+
+// row is an exported expense.
+type row struct {
+	ID          string
+	Date        time.Time
+	Amount      money.Decimal
+	Currency    string
+	Description string
+	Category    string
+}
+
+// rowWriter writes rows of an export one by one. Close completes the file, nothing is guaranteed to be written before.
+type rowWriter interface {
+	Write(r *row) error
+	Close() error
+}
+
+func newRowWriter(format Format, w io.Writer) (rowWriter, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatJSON:
+		return newJSONWriter(w), nil
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+// csvWriter writes a header row followed by a row per expense.
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	c := &csvWriter{w: csv.NewWriter(w)}
+	if err := c.w.Write(columns); err != nil {
+		return nil, fmt.Errorf("cannot write export: %w", err)
+	}
+	return c, nil
+}
+
+func (c *csvWriter) Write(r *row) error {
+	return c.w.Write([]string{r.ID, r.Date.Format(time.RFC3339), money.FormatIn(r.Amount, r.Currency), r.Currency, r.Description, r.Category})
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// jsonWriter writes an array of objects, with amounts as exact decimal numbers.
+type jsonWriter struct {
+	w    *bufio.Writer
+	rows int
+}
+
+type jsonRow struct {
+	ID          string      `json:"id"`
+	Date        time.Time   `json:"date"`
+	Amount      json.Number `json:"amount"`
+	Currency    string      `json:"currency"`
+	Description string      `json:"description,omitempty"`
+	Category    string      `json:"category,omitempty"`
+}
+
+func newJSONWriter(w io.Writer) *jsonWriter {
+	return &jsonWriter{w: bufio.NewWriter(w)}
+}
+
+func (j *jsonWriter) Write(r *row) error {
+	data, err := json.Marshal(&jsonRow{
+		ID:          r.ID,
+		Date:        r.Date,
+		Amount:      json.Number(money.FormatIn(r.Amount, r.Currency)),
+		Currency:    r.Currency,
+		Description: r.Description,
+		Category:    r.Category,
+	})
+	if err != nil {
+		return err
+	}
+
+	separator := ",\n"
+	if j.rows == 0 {
+		separator = "[\n"
+	}
+	j.rows++
+	if _, err = j.w.WriteString(separator); err != nil {
+		return err
+	}
+	_, err = j.w.Write(data)
+	return err
+}
+
+func (j *jsonWriter) Close() error {
+	end := "\n]\n"
+	if j.rows == 0 {
+		end = "[]\n"
+	}
+	if _, err := j.w.WriteString(end); err != nil {
+		return err
+	}
+	return j.w.Flush()
+}
+
+// xlsxWriter writes a single sheet workbook. Rows are streamed to a temporary file once they outgrow a memory buffer,
+// the workbook is written out on Close.
+type xlsxWriter struct {
+	w     io.Writer
+	file  *excelize.File
+	sheet *excelize.StreamWriter
+	rows  int
+}
+
+const xlsxSheet = "Expenses"
+
+func newXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	file := excelize.NewFile()
+	if err := file.SetSheetName("Sheet1", xlsxSheet); err != nil {
+		return nil, fmt.Errorf("cannot create workbook: %w", err)
+	}
+	sheet, err := file.NewStreamWriter(xlsxSheet)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create workbook: %w", err)
+	}
+
+	x := &xlsxWriter{w: w, file: file, sheet: sheet}
+	for col, width := range []float64{24, 20, 12, 10, 48, 24} {
+		if err = sheet.SetColWidth(col+1, col+1, width); err != nil {
+			return nil, fmt.Errorf("cannot create workbook: %w", err)
+		}
+	}
+	header := make([]interface{}, len(columns))
+	for i, name := range columns {
+		header[i] = name
+	}
+	if err = x.setRow(header); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+func (x *xlsxWriter) Write(r *row) error {
+	// Spreadsheets compute with floats anyway, amounts of money have few enough digits to survive the conversion.
+	amount, err := strconv.ParseFloat(r.Amount.String(), 64)
+	if err != nil {
+		return err
+	}
+	return x.setRow([]interface{}{r.ID, r.Date, amount, r.Currency, r.Description, r.Category})
+}
+
+func (x *xlsxWriter) setRow(values []interface{}) error {
+	x.rows++
+	cell, err := excelize.CoordinatesToCellName(1, x.rows)
+	if err != nil {
+		return err
+	}
+	return x.sheet.SetRow(cell, values)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+
+	if err := x.sheet.Flush(); err != nil {
+		return fmt.Errorf("cannot write workbook: %w", err)
+	}
+	if _, err := x.file.WriteTo(x.w); err != nil {
+		return fmt.Errorf("cannot write workbook: %w", err)
+	}
+	return nil
+}
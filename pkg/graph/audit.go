@@ -0,0 +1,76 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+)
+
+// Namespaces of history entries about database resources, named after their tables. Entries about users use the name
+// of auth provider instead, as users are not stored in the database with every provider.
+const (
+	auditWallet    = "wallet"
+	auditExpense   = "expense"
+	auditIncome    = "income"
+	auditTransfer  = "transfer"
+	auditTrade     = "trade"
+	auditCategory  = "category"
+	auditHousehold = "household"
+	auditBudget    = "budget"
+	auditRecurring = "recurring"
+	auditDebt      = "debt"
+)
+
+// changeUser makes a change of users with the auth provider and records in history that the user of ctx triggered the
+// event returned by change on the target user it returns. Providers keeping users in the database make the change in
+// the transaction recording history, so both are stored or neither is. Other providers make the change on their own,
+// so a failure to record it is returned even though the change was made.
+func (r *Resolver) changeUser(ctx context.Context, change func(users *auth.Service) (target *auth.User, event string, err error)) error {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot change user: %w", err)
+	}
+	defer rollBacker()
+
+	users, inTx := r.AuthService.InTx(q)
+	target, event, err := change(users)
+	if err != nil {
+		return err
+	}
+
+	err = q.Audit(ctx, user.Email, r.AuthService.ProviderName(), target.ID, event)
+	if err == nil {
+		err = q.Commit(ctx)
+	}
+	if err != nil && !inTx {
+		return fmt.Errorf("user %s changed but cannot record it: %w", target.ID, err)
+	}
+
+	return err
+}
+
+// historyFilter converts the auditLog filter argument into a dao.HistoryFilter.
+func historyFilter(filter *model.AuditLogFilter) *dao.HistoryFilter {
+	if filter == nil {
+		return &dao.HistoryFilter{}
+	}
+
+	return &dao.HistoryFilter{
+		Namespace:   filter.Namespace.Value(),
+		Reference:   filter.Reference.Value(),
+		Email:       filter.Email.Value(),
+		CreatedFrom: filter.CreatedFrom.Value(),
+		CreatedTo:   filter.CreatedTo.Value(),
+	}
+}
+
+func historyCursor(h *dao.History) dao.Cursor {
+	return dao.Cursor{CreatedAt: h.CreatedAt, ID: h.ID}
+}
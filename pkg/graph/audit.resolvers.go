@@ -0,0 +1,43 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+)
+
+// AuditLog is the resolver for the auditLog field.
+func (r *queryResolver) AuditLog(ctx context.Context, filter *model.AuditLogFilter, first *int, after *string, last *int, before *string) (*model.HistoryConnection, error) {
+	page, err := newPage(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	args := historyFilter(filter)
+	entries, err := r.Dao.HistorySearch(ctx, args, page)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list audit log: %w", err)
+	}
+
+	count, err := r.Dao.HistorySearchCount(ctx, args)
+	if err != nil {
+		return nil, fmt.Errorf("cannot count audit log: %w", err)
+	}
+
+	entries, info := pageInfo(entries, page, historyCursor)
+	connection := &model.HistoryConnection{
+		Edges:      make([]*model.HistoryEdge, len(entries)),
+		PageInfo:   info,
+		TotalCount: int(count),
+	}
+	for i, entry := range entries {
+		connection.Edges[i] = &model.HistoryEdge{Node: entry, Cursor: encodeCursor(historyCursor(entry))}
+	}
+
+	return connection, nil
+}
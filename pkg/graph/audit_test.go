@@ -0,0 +1,73 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/logz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestChangeUser(t *testing.T) {
+	d := daotest.New(t)
+	log := logz.NewTestLogger(t)
+	admin := &auth.User{ID: "u1", Email: "admin@example.com", Roles: auth.Roles{auth.RoleSuperAdmin}}
+	ctx := context.WithValue(context.Background(), auth.CtxUserKey, admin)
+	r := &Resolver{Log: log, Dao: d, AuthService: auth.New(auth.NewLocalProvider(log, d, &conf.NewTestConfig().Auth))}
+
+	created, err := r.Mutation().UserCreate(ctx, model.NewUser{Email: "two@example.com", DisplayName: "Two"})
+	require.Nil(t, err)
+
+	entries, err := d.HistorySearch(ctx, &dao.HistoryFilter{}, &dao.Page{})
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, conf.AuthProviderLocal, entries[0].Namespace)
+	assert.Equal(t, created.ID, entries[0].Reference)
+	assert.Equal(t, "created with roles user", entries[0].Event)
+	assert.Equal(t, admin.Email, entries[0].Email)
+
+	// A failed change is rolled back together with the user created by the local provider in its transaction.
+	errChange := errors.New("change failed")
+	err = r.changeUser(ctx, func(users *auth.Service) (*auth.User, string, error) {
+		_, err := users.CreateUser(ctx, "three@example.com", "Three", auth.Roles{auth.RoleUser})
+		require.Nil(t, err)
+		return nil, "", errChange
+	})
+	assert.ErrorIs(t, err, errChange)
+
+	_, err = d.LocalUserGetByEmail(ctx, "three@example.com")
+	assert.NotNil(t, err, "user of a failed change is not stored")
+	entries, err = d.HistorySearch(ctx, &dao.HistoryFilter{}, &dao.Page{})
+	require.Nil(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestChangeUserNotInTx(t *testing.T) {
+	d := daotest.New(t)
+	provider, err := auth.NewMockProvider()
+	require.Nil(t, err)
+	admin := &auth.User{ID: "u1", Email: "admin@example.com", Roles: auth.Roles{auth.RoleSuperAdmin}}
+	ctx := context.WithValue(context.Background(), auth.CtxUserKey, admin)
+	r := &Resolver{Log: logz.NewTestLogger(t), Dao: d, AuthService: auth.New(provider)}
+
+	_, err = r.Mutation().UserCreate(ctx, model.NewUser{Email: "two@example.com", DisplayName: "Two"})
+	require.Nil(t, err)
+	entries, err := d.HistorySearch(ctx, &dao.HistoryFilter{}, &dao.Page{})
+	require.Nil(t, err)
+	assert.Len(t, entries, 1)
+
+	// The mock provider stores users on its own, so a user it created stays when recording the change fails, but the
+	// failure is still returned.
+	_, err = d.DB().ExecContext(ctx, "DROP TABLE history")
+	require.Nil(t, err)
+	_, err = r.Mutation().UserCreate(ctx, model.NewUser{Email: "three@example.com", DisplayName: "Three"})
+	assert.NotNil(t, err)
+	_, err = provider.GetUserByEmail(ctx, "three@example.com")
+	assert.Nil(t, err, "user is created by the provider")
+}
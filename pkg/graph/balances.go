@@ -0,0 +1,205 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"time"
+)
+
+// maxBalancePoints limits the number of periods of a balance history.
+const maxBalancePoints = 1000
+
+// periodStart returns the start of the day, week or month of t in UTC, bucketing time like dao.OperationSums.
+func periodStart(t time.Time, interval dao.BalanceInterval) time.Time {
+	year, month, day := t.UTC().Date()
+	switch interval {
+	case dao.BalanceIntervalWeek:
+		weekday := (int(t.UTC().Weekday()) + 6) % 7 // Monday is 0.
+		return time.Date(year, month, day-weekday, 0, 0, 0, 0, time.UTC)
+	case dao.BalanceIntervalMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// nextPeriod returns the start of the period following the one starting at start.
+func nextPeriod(start time.Time, interval dao.BalanceInterval) time.Time {
+	switch interval {
+	case dao.BalanceIntervalWeek:
+		return start.AddDate(0, 0, 7)
+	case dao.BalanceIntervalMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// historyPeriods returns starts of periods of interval from the one containing from until to.
+func historyPeriods(from, to time.Time, interval dao.BalanceInterval) ([]time.Time, error) {
+	if !from.Before(to) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrHistoryRange)
+	}
+
+	var periods []time.Time
+	for period := periodStart(from, interval); period.Before(to); period = nextPeriod(period, interval) {
+		if len(periods) == maxBalancePoints {
+			return nil, fmt.Errorf("%w: more than %d periods, use a longer interval", ErrHistoryRange, maxBalancePoints)
+		}
+		periods = append(periods, period)
+	}
+	return periods, nil
+}
+
+// balanceAt returns the balance of a wallet at given time, the sum of its operations created before. It starts from
+// the latest balance snapshot taken at the start of the month of at, or before. Missing snapshots of months in between
+// are taken on the way, so later calls sum fewer operations.
+func balanceAt(ctx context.Context, q dao.DBInterface, walletID string, at time.Time) (money.Decimal, error) {
+	month := periodStart(at, dao.BalanceIntervalMonth)
+
+	var (
+		since   *time.Time
+		balance money.Decimal
+	)
+	snapshot, err := q.BalanceSnapshotLatest(ctx, walletID, month)
+	switch {
+	case err == nil:
+		since, balance = &snapshot.TakenAt, snapshot.Balance
+	case !errors.Is(err, sql.ErrNoRows):
+		return 0, fmt.Errorf("cannot read balance snapshot: %w", err)
+	}
+
+	if since == nil || since.Before(month) {
+		sums, err := q.OperationSums(ctx, walletID, since, &month, dao.BalanceIntervalMonth)
+		if err != nil {
+			return 0, err
+		}
+
+		// Balance changes only after months having operations, so snapshots of the months following them are enough.
+		for _, sum := range sums {
+			balance += sum.Amount
+			if next := nextPeriod(sum.Period, dao.BalanceIntervalMonth); next.Before(month) {
+				if err = q.BalanceSnapshotUpsert(ctx, walletID, next, balance); err != nil {
+					return 0, fmt.Errorf("cannot take balance snapshot: %w", err)
+				}
+			}
+		}
+		if err = q.BalanceSnapshotUpsert(ctx, walletID, month, balance); err != nil {
+			return 0, fmt.Errorf("cannot take balance snapshot: %w", err)
+		}
+	}
+
+	sum, err := q.OperationSum(ctx, walletID, &month, &at)
+	if err != nil {
+		return 0, err
+	}
+	return balance + sum, nil
+}
+
+// walletBalanceHistory returns balances of a wallet at the end of every period, and at to for the last one.
+func walletBalanceHistory(ctx context.Context, q dao.DBInterface, walletID string, periods []time.Time, to time.Time, interval dao.BalanceInterval) ([]*model.BalancePoint, error) {
+	balance, err := balanceAt(ctx, q, walletID, periods[0])
+	if err != nil {
+		return nil, err
+	}
+
+	sums, err := q.OperationSums(ctx, walletID, &periods[0], &to, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]*model.BalancePoint, len(periods))
+	for i, period := range periods {
+		var change money.Decimal
+		if len(sums) > 0 && sums[0].Period.Equal(period) {
+			change, sums = sums[0].Amount, sums[1:]
+		}
+		balance += change
+		points[i] = &model.BalancePoint{Period: period, Balance: balance, Change: change}
+	}
+	return points, nil
+}
+
+// pointTime returns the time a balance of the period starting at period is taken at: the end of the period, or to for
+// the last one. Times are exclusive, so it is one moment earlier.
+func pointTime(period, to time.Time, interval dao.BalanceInterval) time.Time {
+	end := nextPeriod(period, interval)
+	if to.Before(end) {
+		end = to
+	}
+	return end.Add(-time.Nanosecond)
+}
+
+// totalBalanceHistory sums balance histories of wallets, converted at the time of each point. Changes of the total are
+// differences of converted balances, so they add up to the total regardless of rounding and of exchange rate changes.
+func totalBalanceHistory(ctx context.Context, histories []*model.WalletBalanceHistory, periods []time.Time, to time.Time, interval dao.BalanceInterval, convert amountConverter) ([]*model.BalancePoint, error) {
+	total := make([]*model.BalancePoint, len(periods))
+	for i, period := range periods {
+		total[i] = &model.BalancePoint{Period: period}
+	}
+
+	var opening money.Decimal
+	for _, history := range histories {
+		for i, point := range history.Points {
+			at := pointTime(point.Period, to, interval)
+			balance, err := convert(ctx, point.Balance, history.Wallet.Currency, at)
+			if err != nil {
+				return nil, err
+			}
+			total[i].Balance += balance
+
+			// The opening balance is converted at the rate of the first point, so its change is not a rate change.
+			if i == 0 {
+				converted, err := convert(ctx, point.Balance-point.Change, history.Wallet.Currency, at)
+				if err != nil {
+					return nil, err
+				}
+				opening += converted
+			}
+		}
+	}
+
+	for _, point := range total {
+		point.Change, opening = point.Balance-opening, point.Balance
+	}
+	return total, nil
+}
+
+// reportCurrency returns the currency of a total of wallets and given rates converting other currencies into it, keyed
+// by currency. Currency defaults to the one shared by all wallets.
+func reportCurrency(wallets []*dao.Wallet, currency *string, rates []*model.ExchangeRateInput) (string, map[string]float64, error) {
+	currency, err := lookupOptionalCurrency(currency)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var reported string
+	if currency != nil {
+		reported = *currency
+	} else {
+		for _, wallet := range wallets {
+			if reported != "" && wallet.Currency != reported {
+				return "", nil, ErrReportCurrency
+			}
+			reported = wallet.Currency
+		}
+	}
+
+	given := make(map[string]float64, len(rates))
+	for _, rate := range rates {
+		rated, err := lookupCurrency(rate.Currency)
+		if err != nil {
+			return "", nil, err
+		}
+		if rate.Rate <= 0 {
+			return "", nil, fmt.Errorf("%w: rate of %s must be positive", ErrHistoryRate, rated.Code)
+		}
+		given[rated.Code] = rate.Rate
+	}
+	return reported, given, nil
+}
@@ -0,0 +1,82 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/exchange"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+)
+
+// BalanceHistory is the resolver for the balanceHistory field.
+func (r *queryResolver) BalanceHistory(ctx context.Context, walletIds []string, from time.Time, to *time.Time, interval dao.BalanceInterval, inCurrency *string, rates []*model.ExchangeRateInput) (*model.BalanceHistory, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	end := time.Now().UTC()
+	if to != nil {
+		end = to.UTC()
+	}
+	periods, err := historyPeriods(from, end, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	walletIds = slices.Clone(walletIds)
+	slices.Sort(walletIds)
+	walletIds = slices.Compact(walletIds)
+	if len(walletIds) == 0 {
+		return nil, ErrReportWallets
+	}
+
+	// Snapshots taken while computing balances are kept.
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read balance history: %w", err)
+	}
+	defer rollBacker()
+
+	wallets := make([]*dao.Wallet, len(walletIds))
+	for i, id := range walletIds {
+		if wallets[i], err = userWallet(ctx, q, user, id, model.WalletAccessViewer); err != nil {
+			return nil, err
+		}
+	}
+
+	reported, exchangeRates, err := reportCurrency(wallets, inCurrency, rates)
+	if err != nil {
+		return nil, err
+	}
+
+	histories := make([]*model.WalletBalanceHistory, len(wallets))
+	for i, wallet := range wallets {
+		points, err := walletBalanceHistory(ctx, q, wallet.ID, periods, end, interval)
+		if err != nil {
+			return nil, err
+		}
+		histories[i] = &model.WalletBalanceHistory{Wallet: wallet, Points: points}
+	}
+
+	convert := reportConverter(exchange.NewConverter(q), reported, exchangeRates)
+	total, err := totalBalanceHistory(ctx, histories, periods, end, interval, convert)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.BalanceHistory{
+		Currency: reported,
+		Interval: interval,
+		Wallets:  histories,
+		Total:    total,
+	}, q.Commit(ctx)
+}
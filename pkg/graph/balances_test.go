@@ -0,0 +1,149 @@
+package graph
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/exchange"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestHistoryPeriods(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	// March 3rd 2024 is a Sunday, its week started on February 26th.
+	periods, err := historyPeriods(date(3, 3).Add(time.Hour), date(3, 11), dao.BalanceIntervalWeek)
+	require.Nil(t, err)
+	assert.Equal(t, []time.Time{date(2, 26), date(3, 4)}, periods)
+
+	periods, err = historyPeriods(date(1, 31), date(3, 1).Add(time.Second), dao.BalanceIntervalMonth)
+	require.Nil(t, err)
+	assert.Equal(t, []time.Time{date(1, 1), date(2, 1), date(3, 1)}, periods)
+
+	_, err = historyPeriods(date(3, 1), date(3, 1), dao.BalanceIntervalDay)
+	assert.ErrorIs(t, err, ErrHistoryRange)
+	_, err = historyPeriods(date(1, 1), date(1, 1).AddDate(3, 0, 0), dao.BalanceIntervalDay)
+	assert.ErrorIs(t, err, ErrHistoryRange)
+}
+
+func TestBalanceHistory(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: "u1", Currency: "EUR", CreatedAt: date(1, 1)}))
+	require.Nil(t, d.ExpenseInsert(ctx, &dao.ExpenseInsertParams{ID: "e1", WalletID: "w1", Amount: money.MustParse("-10"), CreatedAt: date(1, 10)}))
+	require.Nil(t, d.IncomeInsert(ctx, &dao.IncomeInsertParams{ID: "i1", WalletID: "w1", Amount: money.MustParse("100"), CreatedAt: date(3, 5)}))
+	require.Nil(t, d.ExpenseInsert(ctx, &dao.ExpenseInsertParams{ID: "e2", WalletID: "w1", Amount: money.MustParse("-5"), CreatedAt: date(5, 20)}))
+
+	balance, err := balanceAt(ctx, d, "w1", date(6, 15))
+	require.Nil(t, err)
+	assert.Equal(t, money.MustParse("85"), balance)
+
+	// Snapshots were taken after months having operations and at the month asked for.
+	snapshot, err := d.BalanceSnapshotLatest(ctx, "w1", date(3, 31))
+	require.Nil(t, err)
+	assert.True(t, date(2, 1).Equal(snapshot.TakenAt))
+	assert.Equal(t, money.MustParse("-10"), snapshot.Balance)
+	snapshot, err = d.BalanceSnapshotLatest(ctx, "w1", date(6, 1))
+	require.Nil(t, err)
+	assert.True(t, date(6, 1).Equal(snapshot.TakenAt))
+	assert.Equal(t, money.MustParse("85"), snapshot.Balance)
+
+	// A backdated operation drops snapshots taken after it.
+	require.Nil(t, d.ExpenseInsert(ctx, &dao.ExpenseInsertParams{ID: "e3", WalletID: "w1", Amount: money.MustParse("-1"), CreatedAt: date(2, 20)}))
+	require.Nil(t, d.UpdateBalance(ctx, "w1", money.MustParse("-1"), date(2, 20)))
+	snapshot, err = d.BalanceSnapshotLatest(ctx, "w1", date(6, 1))
+	require.Nil(t, err)
+	assert.True(t, date(2, 1).Equal(snapshot.TakenAt))
+
+	periods, err := historyPeriods(date(2, 15), date(5, 25), dao.BalanceIntervalMonth)
+	require.Nil(t, err)
+	points, err := walletBalanceHistory(ctx, d, "w1", periods, date(5, 25), dao.BalanceIntervalMonth)
+	require.Nil(t, err)
+	assert.Equal(t, []*model.BalancePoint{
+		{Period: date(2, 1), Balance: money.MustParse("-11"), Change: money.MustParse("-1")},
+		{Period: date(3, 1), Balance: money.MustParse("89"), Change: money.MustParse("100")},
+		{Period: date(4, 1), Balance: money.MustParse("89")},
+		{Period: date(5, 1), Balance: money.MustParse("84"), Change: money.MustParse("-5")},
+	}, points)
+
+	balance, err = balanceAt(ctx, d, "w1", date(1, 5))
+	require.Nil(t, err)
+	assert.Equal(t, money.Decimal(0), balance)
+}
+
+func TestTotalBalanceHistory(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	for day, rate := range map[time.Time]float64{date(1, 31): 4, date(2, 10): 5} {
+		require.Nil(t, d.ExchangeRateUpsert(ctx, &dao.ExchangeRateUpsertParams{
+			Base: "EUR", Currency: "PLN", ValidOn: day, Rate: rate, Source: "csv", CreatedAt: day,
+		}))
+	}
+
+	periods := []time.Time{date(1, 1), date(2, 1)}
+	histories := []*model.WalletBalanceHistory{
+		{Wallet: &dao.Wallet{ID: "w1", Currency: "EUR"}, Points: []*model.BalancePoint{
+			{Period: periods[0], Balance: money.MustParse("10"), Change: money.MustParse("10")},
+			{Period: periods[1], Balance: money.MustParse("15"), Change: money.MustParse("5")},
+		}},
+		{Wallet: &dao.Wallet{ID: "w2", Currency: "PLN"}, Points: []*model.BalancePoint{
+			{Period: periods[0], Balance: money.MustParse("100")},
+			{Period: periods[1], Balance: money.MustParse("60"), Change: money.MustParse("-40")},
+		}},
+	}
+
+	// Balances are converted at rates of the end of January and of February 14th.
+	total, err := totalBalanceHistory(ctx, histories, periods, date(2, 15), dao.BalanceIntervalMonth,
+		reportConverter(exchange.NewConverter(d), "EUR", nil))
+	require.Nil(t, err)
+	assert.Equal(t, []*model.BalancePoint{
+		{Period: periods[0], Balance: money.MustParse("35"), Change: money.MustParse("10")},
+		{Period: periods[1], Balance: money.MustParse("27"), Change: money.MustParse("-8")},
+	}, total)
+
+	total, err = totalBalanceHistory(ctx, histories, periods, date(2, 15), dao.BalanceIntervalMonth,
+		reportConverter(exchange.NewConverter(d), "EUR", map[string]float64{"PLN": 0.25}))
+	require.Nil(t, err)
+	assert.Equal(t, money.MustParse("30"), total[1].Balance)
+
+	_, err = totalBalanceHistory(ctx, histories, periods, date(2, 15), dao.BalanceIntervalMonth,
+		reportConverter(exchange.NewConverter(d), "USD", nil))
+	assert.ErrorIs(t, err, exchange.ErrNoRate)
+}
+
+func TestReportCurrency(t *testing.T) {
+	eur, pln := &dao.Wallet{Currency: "EUR"}, &dao.Wallet{Currency: "PLN"}
+	str := func(s string) *string { return &s }
+
+	currency, rates, err := reportCurrency([]*dao.Wallet{eur, eur}, nil, nil)
+	require.Nil(t, err)
+	assert.Equal(t, "EUR", currency)
+	assert.Empty(t, rates)
+
+	_, _, err = reportCurrency([]*dao.Wallet{eur, pln}, nil, nil)
+	assert.ErrorIs(t, err, ErrReportCurrency)
+
+	_, _, err = reportCurrency([]*dao.Wallet{eur, pln}, str("EUR"), []*model.ExchangeRateInput{{Currency: "PLN", Rate: 0}})
+	assert.ErrorIs(t, err, ErrHistoryRate)
+
+	currency, rates, err = reportCurrency([]*dao.Wallet{eur, pln}, str("EUR"), []*model.ExchangeRateInput{
+		{Currency: "PLN", Rate: 0.23}, {Currency: "USD", Rate: 0.9},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "EUR", currency)
+	assert.Equal(t, map[string]float64{"PLN": 0.23, "USD": 0.9}, rates)
+}
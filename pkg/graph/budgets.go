@@ -0,0 +1,147 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/piotrekmonko/portfello/pkg/recurring"
+	"math"
+	"time"
+)
+
+// userBudget returns the budget identified by budgetID if it is owned by user.
+func userBudget(ctx context.Context, q dao.Querier, user *auth.User, budgetID string) (*dao.Budget, error) {
+	budget, err := q.BudgetGetByID(ctx, budgetID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && budget.UserID != user.ID) {
+		return nil, ErrBudgetNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read budget: %w", err)
+	}
+
+	return budget, nil
+}
+
+// budgetCurrency returns the currency of a budget of wallet, or of a category in all wallets when wallet is nil.
+func budgetCurrency(wallet *dao.Wallet, currency *string) (string, error) {
+	if wallet == nil && (currency == nil || *currency == "") {
+		return "", fmt.Errorf("%w: currency is required without a wallet", ErrBudgetCurrency)
+	}
+	currency, err := lookupOptionalCurrency(currency)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case wallet == nil:
+		return *currency, nil
+	case currency != nil && *currency != wallet.Currency:
+		return "", fmt.Errorf("%w: wallet currency is %s", ErrBudgetCurrency, wallet.Currency)
+	default:
+		return wallet.Currency, nil
+	}
+}
+
+// budgetPeriod returns bounds of the period of budget containing time at, along with the number of periods before
+// it. Budgets starting after at have no such period.
+func budgetPeriod(budget *dao.Budget, at time.Time) (start, end time.Time, n int, ok bool) {
+	if at.Before(budget.StartsAt) {
+		return time.Time{}, time.Time{}, 0, false
+	}
+
+	next := func(n int) time.Time {
+		switch model.BudgetPeriod(budget.Period) {
+		case model.BudgetPeriodWeek:
+			return budget.StartsAt.AddDate(0, 0, 7*n)
+		case model.BudgetPeriodYear:
+			return recurring.AddMonths(budget.StartsAt, 12*n)
+		default:
+			return recurring.AddMonths(budget.StartsAt, n)
+		}
+	}
+
+	// Estimate the number of passed periods, then step to the exact one.
+	switch model.BudgetPeriod(budget.Period) {
+	case model.BudgetPeriodWeek:
+		n = int(at.Sub(budget.StartsAt) / (7 * 24 * time.Hour))
+	case model.BudgetPeriodYear:
+		n = at.Year() - budget.StartsAt.Year()
+	default:
+		n = (at.Year()-budget.StartsAt.Year())*12 + int(at.Month()-budget.StartsAt.Month())
+	}
+	for n > 0 && next(n).After(at) {
+		n--
+	}
+	for !next(n + 1).After(at) {
+		n++
+	}
+
+	return next(n), next(n + 1), n, true
+}
+
+// budgetStatus computes spending progress of budget in its period containing time at. Nil is returned for budgets
+// starting after at.
+func budgetStatus(ctx context.Context, q dao.DBInterface, budget *dao.Budget, at time.Time) (*model.BudgetStatus, error) {
+	start, end, n, ok := budgetPeriod(budget, at)
+	if !ok {
+		return nil, nil
+	}
+
+	spent, err := budgetSpent(ctx, q, budget, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := budget.Amount
+	if budget.Rollover && n > 0 {
+		spentBefore, err := budgetSpent(ctx, q, budget, budget.StartsAt, start)
+		if err != nil {
+			return nil, err
+		}
+		limit += budget.Amount*money.Decimal(n) - spentBefore
+	}
+
+	return &model.BudgetStatus{
+		Budget:      budget,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Limit:       limit,
+		Spent:       spent,
+		Remaining:   limit - spent,
+		PercentUsed: percentUsed(spent, limit),
+	}, nil
+}
+
+// budgetSpent returns the amount spent in budget between from and to. Spending is the negated sum of expenses.
+func budgetSpent(ctx context.Context, q dao.DBInterface, budget *dao.Budget, from, to time.Time) (money.Decimal, error) {
+	sum, err := q.ExpenseSum(ctx, &dao.ExpenseListParams{
+		WalletID:    budget.WalletID.String,
+		CategoryID:  dao.StrPtr(budget.CategoryID),
+		Currency:    &budget.Currency,
+		CreatedFrom: &from,
+		CreatedTo:   &to,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return sum.Neg(), nil
+}
+
+// percentUsed returns spent as percent of limit, rounded to two decimal places. Budgets with no limit left are fully
+// used.
+func percentUsed(spent, limit money.Decimal) float64 {
+	if limit <= 0 {
+		if spent > 0 || limit < 0 {
+			return 100
+		}
+		return 0
+	}
+
+	return math.Round(float64(spent)/float64(limit)*10000) / 100
+}
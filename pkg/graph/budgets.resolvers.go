@@ -0,0 +1,273 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	shortuuid "github.com/lithammer/shortuuid/v4"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+)
+
+// WalletID is the resolver for the walletID field.
+func (r *budgetResolver) WalletID(ctx context.Context, obj *dao.Budget) (*string, error) {
+	return dao.StrPtr(obj.WalletID), nil
+}
+
+// CategoryID is the resolver for the categoryID field.
+func (r *budgetResolver) CategoryID(ctx context.Context, obj *dao.Budget) (*string, error) {
+	return dao.StrPtr(obj.CategoryID), nil
+}
+
+// Period is the resolver for the period field.
+func (r *budgetResolver) Period(ctx context.Context, obj *dao.Budget) (model.BudgetPeriod, error) {
+	return model.BudgetPeriod(obj.Period), nil
+}
+
+// Limit is the resolver for the limit field.
+func (r *budgetResolver) Limit(ctx context.Context, obj *dao.Budget) (money.Decimal, error) {
+	return obj.Amount, nil
+}
+
+// CreateBudget is the resolver for the createBudget field.
+func (r *mutationResolver) CreateBudget(ctx context.Context, input model.CreateBudgetInput) (*dao.Budget, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	if !input.Period.IsValid() {
+		return nil, fmt.Errorf("invalid budget period: %s", input.Period)
+	}
+	if input.WalletID.Value() == nil && input.CategoryID.Value() == nil {
+		return nil, ErrBudgetTarget
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create budget: %w", err)
+	}
+	defer rollBacker()
+
+	var wallet *dao.Wallet
+	if walletID := input.WalletID.Value(); walletID != nil {
+		if wallet, err = userWallet(ctx, q, user, *walletID, model.WalletAccessViewer); err != nil {
+			return nil, err
+		}
+	}
+
+	currency, err := budgetCurrency(wallet, input.Currency.Value())
+	if err != nil {
+		return nil, err
+	}
+	limit := money.RoundIn(input.Limit, currency)
+	if !limit.IsPositive() {
+		return nil, ErrBudgetLimit
+	}
+
+	categoryID, err := userCategoryRef(ctx, q, user, input.CategoryID.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	startsAt := now.Truncate(24 * time.Hour)
+	if t := input.StartsAt.Value(); t != nil {
+		startsAt = t.UTC()
+	}
+
+	newBudget := &dao.BudgetInsertParams{
+		ID:         shortuuid.New(),
+		UserID:     user.ID,
+		CategoryID: categoryID,
+		Currency:   currency,
+		Period:     string(input.Period),
+		Amount:     limit,
+		Rollover:   input.Rollover,
+		StartsAt:   startsAt,
+		CreatedAt:  now,
+	}
+	if wallet != nil {
+		newBudget.WalletID = dao.NilStr(wallet.ID)
+	}
+	if err = q.BudgetInsert(ctx, newBudget); err != nil {
+		return nil, fmt.Errorf("cannot create budget: %w", err)
+	}
+
+	event := fmt.Sprintf("created with %s limit %s %s", newBudget.Period, newBudget.Amount, newBudget.Currency)
+	if err = q.Audit(ctx, user.Email, auditBudget, newBudget.ID, event); err != nil {
+		return nil, err
+	}
+
+	budget, err := q.BudgetGetByID(ctx, newBudget.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read created budget: %w", err)
+	}
+
+	return budget, q.Commit(ctx)
+}
+
+// UpdateBudget is the resolver for the updateBudget field.
+func (r *mutationResolver) UpdateBudget(ctx context.Context, id string, input model.UpdateBudgetInput) (*dao.Budget, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot update budget: %w", err)
+	}
+	defer rollBacker()
+
+	budget, err := userBudget(ctx, q, user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	period := model.BudgetPeriod(budget.Period)
+	if input.Period.IsSet() && input.Period.Value() != nil {
+		if period = *input.Period.Value(); !period.IsValid() {
+			return nil, fmt.Errorf("invalid budget period: %s", period)
+		}
+	}
+	limit := budget.Amount
+	if input.Limit.IsSet() && input.Limit.Value() != nil {
+		if limit = money.RoundIn(*input.Limit.Value(), budget.Currency); !limit.IsPositive() {
+			return nil, ErrBudgetLimit
+		}
+	}
+	rollover := budget.Rollover
+	if input.Rollover.IsSet() && input.Rollover.Value() != nil {
+		rollover = *input.Rollover.Value()
+	}
+	startsAt := budget.StartsAt
+	if input.StartsAt.IsSet() && input.StartsAt.Value() != nil {
+		startsAt = input.StartsAt.Value().UTC()
+	}
+
+	err = q.BudgetUpdate(ctx, &dao.BudgetUpdateParams{
+		Period:   string(period),
+		Amount:   limit,
+		Rollover: rollover,
+		StartsAt: startsAt,
+		ID:       budget.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot update budget: %w", err)
+	}
+
+	event := fmt.Sprintf("updated with %s limit %s %s", period, limit, budget.Currency)
+	if err = q.Audit(ctx, user.Email, auditBudget, budget.ID, event); err != nil {
+		return nil, err
+	}
+
+	budget, err = q.BudgetGetByID(ctx, budget.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read updated budget: %w", err)
+	}
+
+	return budget, q.Commit(ctx)
+}
+
+// DeleteBudget is the resolver for the deleteBudget field.
+func (r *mutationResolver) DeleteBudget(ctx context.Context, id string) (*dao.Budget, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot delete budget: %w", err)
+	}
+	defer rollBacker()
+
+	budget, err := userBudget(ctx, q, user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = q.BudgetDelete(ctx, budget.ID); err != nil {
+		return nil, fmt.Errorf("cannot delete budget: %w", err)
+	}
+
+	if err = q.Audit(ctx, user.Email, auditBudget, budget.ID, "deleted"); err != nil {
+		return nil, err
+	}
+
+	return budget, q.Commit(ctx)
+}
+
+// ListBudgets is the resolver for the listBudgets field.
+func (r *queryResolver) ListBudgets(ctx context.Context) ([]*dao.Budget, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	budgets, err := r.Dao.BudgetListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list budgets: %w", err)
+	}
+
+	return budgets, nil
+}
+
+// BudgetStatus is the resolver for the budgetStatus field.
+func (r *queryResolver) BudgetStatus(ctx context.Context, period *model.BudgetPeriod, at *time.Time) ([]*model.BudgetStatus, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	now := time.Now().UTC()
+	if at != nil {
+		now = at.UTC()
+	}
+
+	budgets, err := r.Dao.BudgetListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list budgets: %w", err)
+	}
+
+	statuses := make([]*model.BudgetStatus, 0, len(budgets))
+	for _, budget := range budgets {
+		if period != nil && budget.Period != string(*period) {
+			continue
+		}
+
+		// Budgets of wallets no longer shared with user are skipped.
+		if budget.WalletID.Valid {
+			_, err = userWallet(ctx, r.Dao, user, budget.WalletID.String, model.WalletAccessViewer)
+			if errors.Is(err, ErrWalletNotFound) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+		}
+
+		status, err := budgetStatus(ctx, r.Dao, budget, now)
+		if err != nil {
+			return nil, err
+		}
+		if status != nil {
+			statuses = append(statuses, status)
+		}
+	}
+
+	return statuses, nil
+}
+
+// Budget returns BudgetResolver implementation.
+func (r *Resolver) Budget() BudgetResolver { return &budgetResolver{r} }
+
+type budgetResolver struct{ *Resolver }
@@ -0,0 +1,111 @@
+package graph
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestBudgetPeriod(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name      string
+		period    model.BudgetPeriod
+		startsAt  time.Time
+		at        time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+		wantN     int
+		wantOk    bool
+	}{
+		{
+			name: "first month", period: model.BudgetPeriodMonth, startsAt: date(2024, 1, 25), at: date(2024, 2, 1),
+			wantStart: date(2024, 1, 25), wantEnd: date(2024, 2, 25), wantN: 0, wantOk: true,
+		},
+		{
+			name: "period start is inclusive", period: model.BudgetPeriodMonth, startsAt: date(2024, 1, 25), at: date(2024, 3, 25),
+			wantStart: date(2024, 3, 25), wantEnd: date(2024, 4, 25), wantN: 2, wantOk: true,
+		},
+		{
+			name: "end of month", period: model.BudgetPeriodMonth, startsAt: date(2024, 1, 31), at: date(2024, 3, 1),
+			wantStart: date(2024, 2, 29), wantEnd: date(2024, 3, 31), wantN: 1, wantOk: true,
+		},
+		{
+			name: "week", period: model.BudgetPeriodWeek, startsAt: date(2024, 1, 1), at: date(2024, 1, 20),
+			wantStart: date(2024, 1, 15), wantEnd: date(2024, 1, 22), wantN: 2, wantOk: true,
+		},
+		{
+			name: "year", period: model.BudgetPeriodYear, startsAt: date(2024, 2, 29), at: date(2026, 1, 1),
+			wantStart: date(2025, 2, 28), wantEnd: date(2026, 2, 28), wantN: 1, wantOk: true,
+		},
+		{
+			name: "not started", period: model.BudgetPeriodMonth, startsAt: date(2024, 1, 1), at: date(2023, 12, 31),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget := &dao.Budget{Period: string(tt.period), StartsAt: tt.startsAt}
+			start, end, n, ok := budgetPeriod(budget, tt.at)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantEnd, end)
+			assert.Equal(t, tt.wantN, n)
+		})
+	}
+}
+
+func TestBudgetStatus(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: "u1", Currency: "PLN", CreatedAt: start}))
+	for i, amount := range []string{"-30", "-120", "10", "-45"} {
+		require.Nil(t, d.ExpenseInsert(ctx, &dao.ExpenseInsertParams{
+			ID: string(rune('a' + i)), WalletID: "w1", Amount: money.MustParse(amount), CreatedAt: start.AddDate(0, i, 1),
+		}))
+	}
+
+	budget := &dao.Budget{
+		WalletID: dao.NilStr("w1"), Currency: "PLN", Period: string(model.BudgetPeriodMonth),
+		Amount: money.MustParse("100"), StartsAt: start,
+	}
+	at := start.AddDate(0, 3, 10)
+
+	status, err := budgetStatus(ctx, d, budget, at)
+	require.Nil(t, err)
+	assert.Equal(t, start.AddDate(0, 3, 0), status.PeriodStart)
+	assert.Equal(t, money.MustParse("100"), status.Limit)
+	assert.Equal(t, money.MustParse("45"), status.Spent)
+	assert.Equal(t, money.MustParse("55"), status.Remaining)
+	assert.Equal(t, 45.0, status.PercentUsed)
+
+	// Three previous periods left 70, overspent 20 and left 110.
+	budget.Rollover = true
+	status, err = budgetStatus(ctx, d, budget, at)
+	require.Nil(t, err)
+	assert.Equal(t, money.MustParse("260"), status.Limit)
+	assert.Equal(t, money.MustParse("215"), status.Remaining)
+	assert.Equal(t, 17.31, status.PercentUsed)
+
+	status, err = budgetStatus(ctx, d, budget, start.AddDate(0, 0, -1))
+	require.Nil(t, err)
+	assert.Nil(t, status)
+}
+
+func TestPercentUsed(t *testing.T) {
+	assert.Equal(t, 50.0, percentUsed(money.MustParse("5"), money.MustParse("10")))
+	assert.Equal(t, 150.0, percentUsed(money.MustParse("15"), money.MustParse("10")))
+	assert.Equal(t, 0.0, percentUsed(0, 0))
+	assert.Equal(t, 100.0, percentUsed(money.MustParse("1"), 0))
+	assert.Equal(t, 100.0, percentUsed(0, money.MustParse("-1")))
+}
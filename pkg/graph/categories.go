@@ -0,0 +1,108 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"time"
+)
+
+// defaultCategory describes a category created for every user along with their first wallet.
+type defaultCategory struct {
+	name     string
+	children []defaultCategory
+}
+
+var defaultCategories = []defaultCategory{
+	{name: "Housing", children: []defaultCategory{{name: "Rent"}, {name: "Utilities"}, {name: "Maintenance"}}},
+	{name: "Food", children: []defaultCategory{{name: "Groceries"}, {name: "Restaurants"}}},
+	{name: "Transport", children: []defaultCategory{{name: "Fuel"}, {name: "Public transport"}}},
+	{name: "Health"},
+	{name: "Shopping", children: []defaultCategory{{name: "Clothing"}, {name: "Electronics"}}},
+	{name: "Entertainment"},
+	{name: "Other"},
+}
+
+// seedCategories creates the default category tree for a user who has no categories yet.
+func seedCategories(ctx context.Context, q dao.Querier, userID string) error {
+	count, err := q.CategoryCountByUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("cannot count user categories: %w", err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	return insertCategories(ctx, q, userID, sql.NullString{}, defaultCategories, time.Now().UTC())
+}
+
+func insertCategories(ctx context.Context, q dao.Querier, userID string, parentID sql.NullString, categories []defaultCategory, createdAt time.Time) error {
+	for _, category := range categories {
+		newCategory := &dao.CategoryInsertParams{
+			ID:        shortuuid.New(),
+			UserID:    userID,
+			ParentID:  parentID,
+			Name:      category.name,
+			CreatedAt: createdAt,
+		}
+		if err := q.CategoryInsert(ctx, newCategory); err != nil {
+			return fmt.Errorf("cannot create default category %s: %w", category.name, err)
+		}
+
+		if err := insertCategories(ctx, q, userID, dao.NilStr(newCategory.ID), category.children, createdAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sortCategoryTree orders categories so every parent is listed before its children, depth first. Siblings keep
+// their relative order.
+func sortCategoryTree(categories []*dao.Category) []*dao.Category {
+	known := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		known[category.ID] = true
+	}
+
+	children := make(map[string][]*dao.Category, len(categories))
+	roots := make([]*dao.Category, 0)
+	for _, category := range categories {
+		if category.ParentID.Valid && known[category.ParentID.String] {
+			children[category.ParentID.String] = append(children[category.ParentID.String], category)
+		} else {
+			roots = append(roots, category)
+		}
+	}
+
+	out := make([]*dao.Category, 0, len(categories))
+	var walk func(nodes []*dao.Category)
+	walk = func(nodes []*dao.Category) {
+		for _, node := range nodes {
+			out = append(out, node)
+			walk(children[node.ID])
+		}
+	}
+	walk(roots)
+
+	return out
+}
+
+// checkCategoryParent verifies that moving category under parentID would not create a cycle.
+func checkCategoryParent(ctx context.Context, q dao.Querier, categoryID string, parentID sql.NullString) error {
+	for parentID.Valid {
+		if parentID.String == categoryID {
+			return ErrCategoryCycle
+		}
+
+		parent, err := q.CategoryGetByID(ctx, parentID.String)
+		if err != nil {
+			return fmt.Errorf("cannot read parent category: %w", err)
+		}
+		parentID = parent.ParentID
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	shortuuid "github.com/lithammer/shortuuid/v4"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+)
+
+// ParentID is the resolver for the parentID field.
+func (r *categoryResolver) ParentID(ctx context.Context, obj *dao.Category) (*string, error) {
+	return dao.StrPtr(obj.ParentID), nil
+}
+
+// CreateCategory is the resolver for the createCategory field.
+func (r *mutationResolver) CreateCategory(ctx context.Context, input model.CreateCategoryInput) (*dao.Category, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create category: %w", err)
+	}
+	defer rollBacker()
+
+	parentID, err := userCategoryRef(ctx, q, user, input.ParentID.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	newCategory := &dao.CategoryInsertParams{
+		ID:        shortuuid.New(),
+		UserID:    user.ID,
+		ParentID:  parentID,
+		Name:      input.Name,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err = q.CategoryInsert(ctx, newCategory); err != nil {
+		return nil, fmt.Errorf("cannot create category: %w", err)
+	}
+
+	if err = q.Audit(ctx, user.Email, auditCategory, newCategory.ID, fmt.Sprintf("created as %q", newCategory.Name)); err != nil {
+		return nil, err
+	}
+
+	category, err := q.CategoryGetByID(ctx, newCategory.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read created category: %w", err)
+	}
+
+	return category, q.Commit(ctx)
+}
+
+// UpdateCategory is the resolver for the updateCategory field.
+func (r *mutationResolver) UpdateCategory(ctx context.Context, id string, input model.UpdateCategoryInput) (*dao.Category, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot update category: %w", err)
+	}
+	defer rollBacker()
+
+	category, err := userCategory(ctx, q, user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	name := category.Name
+	if input.Name.IsSet() && input.Name.Value() != nil {
+		name = *input.Name.Value()
+	}
+	parentID := category.ParentID
+	if input.ParentID.IsSet() {
+		if parentID, err = userCategoryRef(ctx, q, user, input.ParentID.Value()); err != nil {
+			return nil, err
+		}
+		if err = checkCategoryParent(ctx, q, category.ID, parentID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = q.CategoryUpdate(ctx, name, parentID, category.ID); err != nil {
+		return nil, fmt.Errorf("cannot update category: %w", err)
+	}
+
+	if err = q.Audit(ctx, user.Email, auditCategory, category.ID, fmt.Sprintf("updated as %q", name)); err != nil {
+		return nil, err
+	}
+
+	category, err = q.CategoryGetByID(ctx, category.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read updated category: %w", err)
+	}
+
+	return category, q.Commit(ctx)
+}
+
+// DeleteCategory is the resolver for the deleteCategory field.
+func (r *mutationResolver) DeleteCategory(ctx context.Context, id string) (*dao.Category, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot delete category: %w", err)
+	}
+	defer rollBacker()
+
+	category, err := userCategory(ctx, q, user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = q.CategorySetParent(ctx, category.ParentID, dao.NilStr(category.ID)); err != nil {
+		return nil, fmt.Errorf("cannot move subcategories: %w", err)
+	}
+
+	if err = q.ExpenseSetCategory(ctx, category.ParentID, dao.NilStr(category.ID)); err != nil {
+		return nil, fmt.Errorf("cannot move expenses: %w", err)
+	}
+
+	if err = q.RecurringSetCategory(ctx, category.ParentID, dao.NilStr(category.ID)); err != nil {
+		return nil, fmt.Errorf("cannot move recurring rules: %w", err)
+	}
+
+	// Budgets need a category to keep their meaning, those of top level categories are removed.
+	if category.ParentID.Valid {
+		err = q.BudgetSetCategory(ctx, category.ParentID, dao.NilStr(category.ID))
+	} else {
+		err = q.BudgetDeleteByCategory(ctx, dao.NilStr(category.ID))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot move budgets: %w", err)
+	}
+
+	if err = q.CategoryDelete(ctx, category.ID); err != nil {
+		return nil, fmt.Errorf("cannot delete category: %w", err)
+	}
+
+	if err = q.Audit(ctx, user.Email, auditCategory, category.ID, fmt.Sprintf("deleted %q", category.Name)); err != nil {
+		return nil, err
+	}
+
+	return category, q.Commit(ctx)
+}
+
+// ListCategories is the resolver for the listCategories field.
+func (r *queryResolver) ListCategories(ctx context.Context) ([]*dao.Category, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	categories, err := r.Dao.CategoryListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list categories: %w", err)
+	}
+
+	return sortCategoryTree(categories), nil
+}
+
+// Category returns CategoryResolver implementation.
+func (r *Resolver) Category() CategoryResolver { return &categoryResolver{r} }
+
+type categoryResolver struct{ *Resolver }
@@ -0,0 +1,40 @@
+package graph
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSortCategoryTree(t *testing.T) {
+	categories := []*dao.Category{
+		{ID: "apples", ParentID: dao.NilStr("fruit")},
+		{ID: "bread"},
+		{ID: "fruit", ParentID: dao.NilStr("food")},
+		{ID: "orphan", ParentID: dao.NilStr("unknown")},
+		{ID: "food"},
+		{ID: "pears", ParentID: dao.NilStr("fruit")},
+	}
+
+	got := sortCategoryTree(categories)
+
+	ids := make([]string, len(got))
+	for i := range got {
+		ids[i] = got[i].ID
+	}
+	assert.Equal(t, []string{"bread", "orphan", "food", "fruit", "apples", "pears"}, ids)
+}
+
+func TestDefaultCategories(t *testing.T) {
+	names := make(map[string]bool)
+	var walk func(categories []defaultCategory)
+	walk = func(categories []defaultCategory) {
+		for _, category := range categories {
+			assert.False(t, names[category.name], "duplicate default category %s", category.name)
+			names[category.name] = true
+			walk(category.children)
+		}
+	}
+	walk(defaultCategories)
+	assert.NotEmpty(t, names)
+}
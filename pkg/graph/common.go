@@ -1 +1,269 @@
 package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/access"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"sort"
+	"time"
+)
+
+// InvalidCurrencyCode is the code in extensions of errors reporting a currency which is not an ISO 4217 one.
+const InvalidCurrencyCode = "INVALID_CURRENCY"
+
+var (
+	ErrWalletNotFound   = access.ErrWalletNotFound
+	ErrExpenseNotFound  = fmt.Errorf("expense not found")
+	ErrIncomeNotFound   = fmt.Errorf("income not found")
+	ErrCategoryNotFound = fmt.Errorf("category not found")
+
+	ErrIncomeNotPositive   = fmt.Errorf("income amount must be positive")
+	ErrTransferNotPositive = fmt.Errorf("transfer amount must be positive")
+	ErrTransferSameWallet  = fmt.Errorf("cannot transfer to the same wallet")
+	ErrTransferRate        = fmt.Errorf("invalid exchange rate")
+	ErrCategoryCycle       = fmt.Errorf("category cannot be moved under itself")
+	ErrTagInvalid          = fmt.Errorf("tag name must not be empty nor longer than 128 characters")
+	ErrPageArgs            = fmt.Errorf("first and last cannot be used together")
+	ErrPageSize            = fmt.Errorf("page size must be between 0 and 500")
+	ErrInvalidCursor       = fmt.Errorf("invalid cursor")
+	ErrWalletAccess        = fmt.Errorf("insufficient access to wallet")
+	ErrShareOwner          = fmt.Errorf("wallet owner always has full access")
+	ErrShareNotFound       = fmt.Errorf("wallet is not shared with this user")
+
+	ErrHouseholdNotFound  = fmt.Errorf("household not found")
+	ErrHouseholdName      = fmt.Errorf("household name must not be empty")
+	ErrHouseholdAccess    = fmt.Errorf("insufficient role in household")
+	ErrHouseholdLastOwner = fmt.Errorf("household must keep at least one owner")
+	ErrHouseholdMember    = fmt.Errorf("user is already a member of household")
+	ErrMemberNotFound     = fmt.Errorf("household member not found")
+	ErrInvitationNotFound = fmt.Errorf("invitation not found")
+
+	ErrBudgetNotFound = fmt.Errorf("budget not found")
+	ErrBudgetTarget   = fmt.Errorf("budget needs a wallet or a category")
+	ErrBudgetCurrency = fmt.Errorf("invalid budget currency")
+	ErrBudgetLimit    = fmt.Errorf("budget limit must be positive")
+
+	ErrRecurringNotFound = fmt.Errorf("recurring rule not found")
+	ErrRecurringEnd      = fmt.Errorf("recurring rule must end after it starts")
+	ErrRecurringCategory = fmt.Errorf("only recurring expenses may have a category")
+
+	ErrTradeNotFound = fmt.Errorf("trade not found")
+	ErrTradeWallet   = fmt.Errorf("trades are allowed only in investment wallets")
+	ErrTradeQuantity = fmt.Errorf("buys and sells need a positive quantity and price, and no amount")
+	ErrTradeDividend = fmt.Errorf("dividends need a positive amount, and no quantity nor price")
+	ErrTradeFee      = fmt.Errorf("trade fee must not be negative")
+
+	ErrDebtNotFound   = fmt.Errorf("debt not found")
+	ErrDebtName       = fmt.Errorf("debt name must not be empty nor longer than 128 characters")
+	ErrDebtPrincipal  = fmt.Errorf("debt principal must be positive")
+	ErrDebtRate       = fmt.Errorf("debt interest rate must be between 0 and 100 percent")
+	ErrDebtTerm       = fmt.Errorf("debt term must be between 1 and 1200 months")
+	ErrDebtPaymentDay = fmt.Errorf("debt payment day must be between 1 and 31")
+	ErrDebtRepayment  = fmt.Errorf("only expenses with a negative amount in the currency of the debt can repay it")
+
+	ErrMergeNothing = fmt.Errorf("no expenses to merge")
+	ErrMergeSame    = fmt.Errorf("cannot merge expense into itself")
+	ErrMergeWallet  = fmt.Errorf("only expenses of the same wallet can be merged")
+
+	ErrReportWallets  = fmt.Errorf("at least one wallet is required")
+	ErrReportCurrency = fmt.Errorf("inCurrency is required for wallets in different currencies")
+
+	ErrHistoryRange = fmt.Errorf("invalid balance history range")
+	ErrHistoryRate  = fmt.Errorf("invalid exchange rate")
+)
+
+// userWallet returns the wallet identified by walletID if user has at least given access to it. Wallets the user has
+// no access to are reported as not found, so their existence is not disclosed.
+func userWallet(ctx context.Context, q dao.Querier, user *auth.User, walletID string, want model.WalletAccess) (*dao.Wallet, error) {
+	wallet, userAccess, err := access.Wallet(ctx, q, user.ID, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	if access.Rank[userAccess] < access.Rank[access.Level(want)] {
+		return nil, ErrWalletAccess
+	}
+
+	return wallet, nil
+}
+
+// userExpense returns the expense identified by expenseID if user has at least given access to its wallet.
+func userExpense(ctx context.Context, q dao.Querier, user *auth.User, expenseID string, access model.WalletAccess) (*dao.Expense, error) {
+	expense, err := q.ExpenseGetByID(ctx, expenseID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrExpenseNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read expense: %w", err)
+	}
+
+	if _, err = userWallet(ctx, q, user, expense.WalletID, access); errors.Is(err, ErrWalletNotFound) {
+		return nil, ErrExpenseNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return expense, nil
+}
+
+// userIncome returns the income identified by incomeID if user has at least given access to its wallet.
+func userIncome(ctx context.Context, q dao.Querier, user *auth.User, incomeID string, access model.WalletAccess) (*dao.Income, error) {
+	income, err := q.IncomeGetByID(ctx, incomeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrIncomeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read income: %w", err)
+	}
+
+	if _, err = userWallet(ctx, q, user, income.WalletID, access); errors.Is(err, ErrWalletNotFound) {
+		return nil, ErrIncomeNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return income, nil
+}
+
+// userTrade returns the trade identified by tradeID if user has at least given access to its wallet.
+func userTrade(ctx context.Context, q dao.Querier, user *auth.User, tradeID string, access model.WalletAccess) (*dao.Trade, error) {
+	trade, err := q.TradeGetByID(ctx, tradeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTradeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read trade: %w", err)
+	}
+
+	if _, err = userWallet(ctx, q, user, trade.WalletID, access); errors.Is(err, ErrWalletNotFound) {
+		return nil, ErrTradeNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return trade, nil
+}
+
+// userCategory returns the category identified by categoryID if it is owned by user.
+func userCategory(ctx context.Context, q dao.Querier, user *auth.User, categoryID string) (*dao.Category, error) {
+	category, err := q.CategoryGetByID(ctx, categoryID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && category.UserID != user.ID) {
+		return nil, ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read category: %w", err)
+	}
+
+	return category, nil
+}
+
+// userDebt returns the debt identified by debtID if it is owned by user.
+func userDebt(ctx context.Context, q dao.Querier, user *auth.User, debtID string) (*dao.Debt, error) {
+	found, err := q.DebtGetByID(ctx, debtID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && found.UserID != user.ID) {
+		return nil, ErrDebtNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read debt: %w", err)
+	}
+
+	return found, nil
+}
+
+// userCategoryRef validates an optional reference to a category of user, as used in expense inputs.
+func userCategoryRef(ctx context.Context, q dao.Querier, user *auth.User, categoryID *string) (sql.NullString, error) {
+	if categoryID == nil {
+		return sql.NullString{}, nil
+	}
+
+	category, err := userCategory(ctx, q, user, *categoryID)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	return dao.NilStr(category.ID), nil
+}
+
+// sortOperations orders operations by their creation time, oldest first. Operations created at the same time are
+// ordered by ID to keep the order stable between calls.
+func sortOperations(operations []model.Operation) []model.Operation {
+	sort.Slice(operations, func(i, j int) bool {
+		ti, tj := operations[i].GetCreatedAt(), operations[j].GetCreatedAt()
+		if ti.Equal(tj) {
+			return operations[i].GetID() < operations[j].GetID()
+		}
+		return ti.Before(tj)
+	})
+
+	return operations
+}
+
+// lookupCurrency returns the ISO 4217 currency of code. Unknown codes are reported with a GraphQL error having
+// InvalidCurrencyCode and the code in its extensions, so clients can tell them apart from other errors.
+func lookupCurrency(code string) (*money.Currency, error) {
+	currency, err := money.LookupCurrency(code)
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Err:        err,
+			Message:    err.Error(),
+			Extensions: map[string]interface{}{"code": InvalidCurrencyCode, "currency": code},
+		}
+	}
+	return currency, nil
+}
+
+// lookupOptionalCurrency is like lookupCurrency for an optional code. It returns the canonical code, or nil without one.
+func lookupOptionalCurrency(code *string) (*string, error) {
+	if code == nil {
+		return nil, nil
+	}
+	currency, err := lookupCurrency(*code)
+	if err != nil {
+		return nil, err
+	}
+	return &currency.Code, nil
+}
+
+// walletAmount rounds amount to minor units of the currency of a wallet.
+func walletAmount(ctx context.Context, q dao.Querier, walletID string, amount money.Decimal) (money.Decimal, error) {
+	wallet, err := q.WalletGetByID(ctx, walletID)
+	if err != nil {
+		return 0, fmt.Errorf("cannot read wallet: %w", err)
+	}
+	return money.RoundIn(amount, wallet.Currency), nil
+}
+
+// transferRate returns the exchange rate to use when transferring money from one wallet to another. Rate is required
+// only when wallet currencies differ, otherwise it may be omitted or set to 1.
+func transferRate(from, to *dao.Wallet, rate *float64) (float64, error) {
+	if from.Currency == to.Currency {
+		if rate != nil && *rate != 1 {
+			return 0, fmt.Errorf("%w: wallets share currency %s, rate must be 1", ErrTransferRate, from.Currency)
+		}
+		return 1, nil
+	}
+
+	if rate == nil {
+		return 0, fmt.Errorf("%w: rate is required to convert %s to %s", ErrTransferRate, from.Currency, to.Currency)
+	}
+	if *rate <= 0 {
+		return 0, fmt.Errorf("%w: rate must be positive", ErrTransferRate)
+	}
+
+	return *rate, nil
+}
+
+// earliest returns the earlier of two times.
+func earliest(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"errors"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"testing"
+	"time"
+)
+
+func TestSortOperations(t *testing.T) {
+	now := time.Now().UTC()
+	operations := []model.Operation{
+		&dao.Expense{ID: "e2", CreatedAt: now},
+		&dao.Income{ID: "i1", CreatedAt: now.Add(time.Hour)},
+		&dao.Expense{ID: "e1", CreatedAt: now},
+		&dao.Transfer{ID: "t1", CreatedAt: now.Add(-2 * time.Hour)},
+		&dao.Income{ID: "i2", CreatedAt: now.Add(-time.Hour)},
+	}
+
+	got := sortOperations(operations)
+
+	ids := make([]string, len(got))
+	for i := range got {
+		ids[i] = got[i].GetID()
+	}
+	assert.Equal(t, []string{"t1", "i2", "e1", "e2", "i1"}, ids)
+}
+
+func TestTransferRate(t *testing.T) {
+	usd, usd2, eur := &dao.Wallet{Currency: "USD"}, &dao.Wallet{Currency: "USD"}, &dao.Wallet{Currency: "EUR"}
+	one, half, negative := 1.0, 0.5, -2.0
+
+	tests := []struct {
+		name     string
+		from, to *dao.Wallet
+		rate     *float64
+		want     float64
+		wantErr  bool
+	}{
+		{name: "same currency, no rate", from: usd, to: usd2, rate: nil, want: 1},
+		{name: "same currency, rate of 1", from: usd, to: usd2, rate: &one, want: 1},
+		{name: "same currency, other rate", from: usd, to: usd2, rate: &half, wantErr: true},
+		{name: "other currency, no rate", from: usd, to: eur, rate: nil, wantErr: true},
+		{name: "other currency, negative rate", from: usd, to: eur, rate: &negative, wantErr: true},
+		{name: "other currency, valid rate", from: usd, to: eur, rate: &half, want: 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := transferRate(tt.from, tt.to, tt.rate)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrTransferRate))
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLookupCurrency(t *testing.T) {
+	currency, err := lookupCurrency("usd")
+	assert.Nil(t, err)
+	assert.Equal(t, "USD", currency.Code)
+
+	_, err = lookupCurrency("XYZ")
+	var gqlErr *gqlerror.Error
+	if assert.True(t, errors.As(err, &gqlErr)) {
+		assert.Equal(t, map[string]interface{}{"code": InvalidCurrencyCode, "currency": "XYZ"}, gqlErr.Extensions)
+	}
+	assert.ErrorIs(t, err, money.ErrUnknownCurrency)
+
+	code, err := lookupOptionalCurrency(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, code)
+	jpy := " jpy"
+	code, err = lookupOptionalCurrency(&jpy)
+	assert.Nil(t, err)
+	assert.Equal(t, "JPY", *code)
+}
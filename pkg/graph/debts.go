@@ -0,0 +1,100 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// maxDebtNameLength matches the size of debt.name and debt.lender columns.
+	maxDebtNameLength = 128
+	// maxDebtTerm allows mortgages taken for a hundred years at most.
+	maxDebtTerm = 1200
+)
+
+// debtParams validates a debt to be created by user. The principal is rounded to minor units of its currency.
+func debtParams(input model.CreateDebtInput, userID string) (*dao.DebtInsertParams, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxDebtNameLength {
+		return nil, ErrDebtName
+	}
+	var lender string
+	if l := input.Lender.Value(); l != nil {
+		lender = strings.TrimSpace(*l)
+	}
+	if utf8.RuneCountInString(lender) > maxDebtNameLength {
+		return nil, ErrDebtName
+	}
+
+	currency, err := lookupCurrency(input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	principal := currency.Round(input.Principal)
+	if !principal.IsPositive() {
+		return nil, ErrDebtPrincipal
+	}
+	if math.IsNaN(input.InterestRate) || input.InterestRate < 0 || input.InterestRate > 100 {
+		return nil, ErrDebtRate
+	}
+	if input.TermMonths < 1 || input.TermMonths > maxDebtTerm {
+		return nil, ErrDebtTerm
+	}
+
+	startsAt := input.StartsAt.UTC()
+	paymentDay := startsAt.Day()
+	if d := input.PaymentDay.Value(); d != nil {
+		paymentDay = *d
+	}
+	if paymentDay < 1 || paymentDay > 31 {
+		return nil, ErrDebtPaymentDay
+	}
+
+	return &dao.DebtInsertParams{
+		ID:           shortuuid.New(),
+		UserID:       userID,
+		Name:         name,
+		Lender:       dao.NilStr(lender),
+		Currency:     currency.Code,
+		Principal:    principal,
+		InterestRate: input.InterestRate,
+		TermMonths:   int32(input.TermMonths),
+		PaymentDay:   int32(paymentDay),
+		StartsAt:     startsAt,
+		CreatedAt:    time.Now().UTC(),
+	}, nil
+}
+
+// checkRepayment reports whether expense made in wallet can repay d.
+func checkRepayment(d *dao.Debt, wallet *dao.Wallet, expense *dao.Expense) error {
+	if wallet.Currency != d.Currency || expense.Amount >= 0 {
+		return ErrDebtRepayment
+	}
+	return nil
+}
+
+// checkLinkedRepayment runs checkRepayment for expense, linked to a debt, as if its amount was changed to amount. The
+// debt may be owned by another user sharing the wallet, so its owner is not checked.
+func checkLinkedRepayment(ctx context.Context, q dao.Querier, expense *dao.Expense, amount money.Decimal) error {
+	linked, err := q.DebtGetByID(ctx, expense.DebtID.String)
+	if err != nil {
+		return fmt.Errorf("cannot read debt: %w", err)
+	}
+	wallet, err := q.WalletGetByID(ctx, expense.WalletID)
+	if err != nil {
+		return fmt.Errorf("cannot read wallet: %w", err)
+	}
+
+	changed := *expense
+	changed.Amount = amount
+	return checkRepayment(linked, wallet, &changed)
+}
@@ -0,0 +1,230 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/debt"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+)
+
+// Lender is the resolver for the lender field.
+func (r *debtResolver) Lender(ctx context.Context, obj *dao.Debt) (*string, error) {
+	return dao.StrPtr(obj.Lender), nil
+}
+
+// Schedule is the resolver for the schedule field.
+func (r *debtResolver) Schedule(ctx context.Context, obj *dao.Debt) ([]*debt.Payment, error) {
+	return debt.Schedule(obj), nil
+}
+
+// Repayments is the resolver for the repayments field.
+func (r *debtResolver) Repayments(ctx context.Context, obj *dao.Debt) ([]*dao.Expense, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	expenses, err := r.Dao.ExpenseListByDebt(ctx, dao.NilStr(obj.ID))
+	if err != nil {
+		return nil, fmt.Errorf("cannot list repayments: %w", err)
+	}
+
+	// Repayments made in wallets the user can no longer see are left out.
+	visible := map[string]bool{}
+	repayments := make([]*dao.Expense, 0, len(expenses))
+	for _, expense := range expenses {
+		ok, checked := visible[expense.WalletID]
+		if !checked {
+			_, err = userWallet(ctx, r.Dao, user, expense.WalletID, model.WalletAccessViewer)
+			if err != nil && !errors.Is(err, ErrWalletNotFound) {
+				return nil, err
+			}
+			ok = err == nil
+			visible[expense.WalletID] = ok
+		}
+		if ok {
+			repayments = append(repayments, expense)
+		}
+	}
+
+	return repayments, nil
+}
+
+// DebtID is the resolver for the debtID field.
+func (r *expenseResolver) DebtID(ctx context.Context, obj *dao.Expense) (*string, error) {
+	return dao.StrPtr(obj.DebtID), nil
+}
+
+// CreateDebt is the resolver for the createDebt field.
+func (r *mutationResolver) CreateDebt(ctx context.Context, input model.CreateDebtInput) (*dao.Debt, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	newDebt, err := debtParams(input, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create debt: %w", err)
+	}
+	defer rollBacker()
+
+	if err = q.DebtInsert(ctx, newDebt); err != nil {
+		return nil, fmt.Errorf("cannot create debt: %w", err)
+	}
+
+	event := fmt.Sprintf("created with principal %s %s at %g%% for %d months", newDebt.Principal, newDebt.Currency,
+		newDebt.InterestRate, newDebt.TermMonths)
+	if err = q.Audit(ctx, user.Email, auditDebt, newDebt.ID, event); err != nil {
+		return nil, err
+	}
+
+	created, err := q.DebtGetByID(ctx, newDebt.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read created debt: %w", err)
+	}
+
+	return created, q.Commit(ctx)
+}
+
+// DeleteDebt is the resolver for the deleteDebt field.
+func (r *mutationResolver) DeleteDebt(ctx context.Context, id string) (*dao.Debt, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot delete debt: %w", err)
+	}
+	defer rollBacker()
+
+	deleted, err := userDebt(ctx, q, user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = q.ExpenseClearDebt(ctx, dao.NilStr(deleted.ID)); err != nil {
+		return nil, fmt.Errorf("cannot unlink repayments: %w", err)
+	}
+	if err = q.DebtDelete(ctx, deleted.ID); err != nil {
+		return nil, fmt.Errorf("cannot delete debt: %w", err)
+	}
+
+	if err = q.Audit(ctx, user.Email, auditDebt, deleted.ID, "deleted"); err != nil {
+		return nil, err
+	}
+
+	return deleted, q.Commit(ctx)
+}
+
+// SetExpenseDebt is the resolver for the setExpenseDebt field.
+func (r *mutationResolver) SetExpenseDebt(ctx context.Context, expenseID string, debtID *string) (*dao.Expense, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot link expense: %w", err)
+	}
+	defer rollBacker()
+
+	expense, err := userExpense(ctx, q, user, expenseID, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	event := fmt.Sprintf("unlinked from debt %s", expense.DebtID.String)
+	if debtID != nil {
+		repaid, err := userDebt(ctx, q, user, *debtID)
+		if err != nil {
+			return nil, err
+		}
+		wallet, err := q.WalletGetByID(ctx, expense.WalletID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read wallet: %w", err)
+		}
+		if err = checkRepayment(repaid, wallet, expense); err != nil {
+			return nil, err
+		}
+		event = fmt.Sprintf("linked to debt %s", repaid.ID)
+	} else if !expense.DebtID.Valid {
+		return expense, nil
+	}
+
+	if err = q.ExpenseSetDebt(ctx, dao.NilStrPtr(debtID), expense.ID); err != nil {
+		return nil, fmt.Errorf("cannot link expense: %w", err)
+	}
+
+	if err = q.Audit(ctx, user.Email, auditExpense, expense.ID, event); err != nil {
+		return nil, err
+	}
+
+	expense, err = q.ExpenseGetByID(ctx, expense.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read linked expense: %w", err)
+	}
+
+	return expense, q.Commit(ctx)
+}
+
+// ListDebts is the resolver for the listDebts field.
+func (r *queryResolver) ListDebts(ctx context.Context) ([]*dao.Debt, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	debts, err := r.Dao.DebtListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list debts: %w", err)
+	}
+
+	return debts, nil
+}
+
+// DebtStatus is the resolver for the debtStatus field.
+func (r *queryResolver) DebtStatus(ctx context.Context, debtID string, at *time.Time) (*debt.Status, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	owed, err := userDebt(ctx, r.Dao, user, debtID)
+	if err != nil {
+		return nil, err
+	}
+
+	statusAt := time.Now().UTC()
+	if at != nil {
+		statusAt = at.UTC()
+	}
+
+	repayments, err := r.Dao.ExpenseListByDebt(ctx, dao.NilStr(owed.ID))
+	if err != nil {
+		return nil, fmt.Errorf("cannot list repayments: %w", err)
+	}
+
+	return debt.Compute(owed, repayments, statusAt), nil
+}
+
+// Debt returns DebtResolver implementation.
+func (r *Resolver) Debt() DebtResolver { return &debtResolver{r} }
+
+type debtResolver struct{ *Resolver }
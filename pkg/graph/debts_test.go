@@ -0,0 +1,128 @@
+package graph
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDebtParams(t *testing.T) {
+	text := func(s string) graphql.Omittable[*string] {
+		return graphql.OmittableOf(&s)
+	}
+	day := func(d int) graphql.Omittable[*int] {
+		return graphql.OmittableOf(&d)
+	}
+	startsAt := time.Date(2024, 3, 20, 10, 0, 0, 0, time.FixedZone("CET", 3600))
+	valid := func() model.CreateDebtInput {
+		return model.CreateDebtInput{
+			Name: " Mortgage ", Lender: text(" Bank "), Currency: "eur",
+			Principal: money.MustParse("250000.005"), InterestRate: 4.5, TermMonths: 360, StartsAt: startsAt,
+		}
+	}
+
+	got, err := debtParams(valid(), "u1")
+	require.Nil(t, err)
+	assert.Equal(t, "u1", got.UserID)
+	assert.Equal(t, "Mortgage", got.Name)
+	assert.Equal(t, "Bank", got.Lender.String)
+	assert.Equal(t, "EUR", got.Currency)
+	assert.Equal(t, money.MustParse("250000.01"), got.Principal)
+	assert.Equal(t, int32(20), got.PaymentDay, "defaults to the day of start")
+	assert.Equal(t, startsAt.UTC(), got.StartsAt)
+
+	input := valid()
+	input.Lender = text(" ")
+	input.PaymentDay = day(31)
+	got, err = debtParams(input, "u1")
+	require.Nil(t, err)
+	assert.False(t, got.Lender.Valid)
+	assert.Equal(t, int32(31), got.PaymentDay)
+
+	invalid := map[error]func(*model.CreateDebtInput){
+		ErrDebtName:       func(i *model.CreateDebtInput) { i.Name = strings.Repeat("x", 129) },
+		ErrDebtPrincipal:  func(i *model.CreateDebtInput) { i.Principal = money.MustParse("0.004") },
+		ErrDebtRate:       func(i *model.CreateDebtInput) { i.InterestRate = math.NaN() },
+		ErrDebtTerm:       func(i *model.CreateDebtInput) { i.TermMonths = 0 },
+		ErrDebtPaymentDay: func(i *model.CreateDebtInput) { i.PaymentDay = day(32) },
+	}
+	for want, change := range invalid {
+		input := valid()
+		change(&input)
+		_, err = debtParams(input, "u1")
+		assert.ErrorIs(t, err, want)
+	}
+
+	input = valid()
+	input.Currency = "XYZ"
+	_, err = debtParams(input, "u1")
+	assert.ErrorIs(t, err, money.ErrUnknownCurrency)
+}
+
+func TestCheckRepayment(t *testing.T) {
+	debt := &dao.Debt{Currency: "PLN"}
+	wallet := &dao.Wallet{Currency: "PLN"}
+
+	assert.Nil(t, checkRepayment(debt, wallet, &dao.Expense{Amount: money.FromInt(-100)}))
+	assert.ErrorIs(t, checkRepayment(debt, wallet, &dao.Expense{Amount: money.FromInt(100)}), ErrDebtRepayment)
+	assert.ErrorIs(t, checkRepayment(debt, &dao.Wallet{Currency: "EUR"}, &dao.Expense{Amount: money.FromInt(-100)}), ErrDebtRepayment)
+}
+
+func TestUpdateExpenseRepayment(t *testing.T) {
+	d := daotest.New(t)
+	user := &auth.User{ID: "u1", Email: "one@example.com"}
+	ctx := context.WithValue(context.Background(), auth.CtxUserKey, user)
+	m := (&Resolver{Dao: d}).Mutation()
+	now := time.Now().UTC()
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: user.ID, Currency: "PLN", CreatedAt: now}))
+	require.Nil(t, d.DebtInsert(ctx, &dao.DebtInsertParams{
+		ID: "loan", UserID: user.ID, Name: "Loan", Currency: "PLN", Principal: money.FromInt(1000), TermMonths: 10,
+		PaymentDay: 1, StartsAt: now, CreatedAt: now,
+	}))
+	require.Nil(t, d.ExpenseInsert(ctx, &dao.ExpenseInsertParams{ID: "e1", WalletID: "w1", Amount: money.FromInt(-100), CreatedAt: now}))
+	require.Nil(t, d.ExpenseSetDebt(ctx, dao.NilStr("loan"), "e1"))
+
+	refund := money.FromInt(100)
+	_, err := m.UpdateExpense(ctx, "e1", model.UpdateExpenseInput{Amount: graphql.OmittableOf(&refund)})
+	assert.ErrorIs(t, err, ErrDebtRepayment)
+
+	repayment := money.FromInt(-120)
+	expense, err := m.UpdateExpense(ctx, "e1", model.UpdateExpenseInput{Amount: graphql.OmittableOf(&repayment)})
+	require.Nil(t, err)
+	assert.Equal(t, repayment, expense.Amount)
+	assert.Equal(t, "loan", expense.DebtID.String)
+}
+
+func TestDebtRepayments(t *testing.T) {
+	d := daotest.New(t)
+	user := &auth.User{ID: "u1", Email: "one@example.com"}
+	ctx := context.WithValue(context.Background(), auth.CtxUserKey, user)
+	now := time.Now().UTC()
+	require.Nil(t, d.DebtInsert(ctx, &dao.DebtInsertParams{
+		ID: "loan", UserID: user.ID, Name: "Loan", Currency: "PLN", Principal: money.FromInt(1000), TermMonths: 10,
+		PaymentDay: 1, StartsAt: now, CreatedAt: now,
+	}))
+	// The repayment in w2 was made while w2 was shared with the user.
+	for wallet, owner := range map[string]string{"w1": user.ID, "w2": "u2"} {
+		require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: wallet, UserID: owner, Currency: "PLN", CreatedAt: now}))
+		require.Nil(t, d.ExpenseInsert(ctx, &dao.ExpenseInsertParams{ID: "e" + wallet, WalletID: wallet, Amount: money.FromInt(-100), CreatedAt: now}))
+		require.Nil(t, d.ExpenseSetDebt(ctx, dao.NilStr("loan"), "e"+wallet))
+	}
+
+	loan, err := d.DebtGetByID(ctx, "loan")
+	require.Nil(t, err)
+	repayments, err := (&Resolver{Dao: d}).Debt().Repayments(ctx, loan)
+	require.Nil(t, err)
+	require.Len(t, repayments, 1)
+	assert.Equal(t, "ew1", repayments[0].ID)
+}
@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+)
+
+// mergeExpense deletes merged expense in favour of keep, which takes its tags, and its description, category,
+// external ID and debt if keep has none. The debt is taken only if it is owned by user and keep may repay it, otherwise
+// the link is dropped with merged. Wallet balance is updated and both expenses record the merge in history.
+func mergeExpense(ctx context.Context, q dao.DBInterface, user *auth.User, keep, merged *dao.Expense) error {
+	tags, err := q.TagListByExpense(ctx, merged.ID)
+	if err != nil {
+		return fmt.Errorf("cannot list expense tags: %w", err)
+	}
+	for _, tag := range tags {
+		if err = q.ExpenseTagInsert(ctx, keep.ID, tag.ID); err != nil {
+			return fmt.Errorf("cannot add tag: %w", err)
+		}
+	}
+
+	if err = q.ExpenseTagDeleteByExpense(ctx, merged.ID); err != nil {
+		return fmt.Errorf("cannot remove expense tags: %w", err)
+	}
+	if err = q.ExpenseDelete(ctx, merged.ID); err != nil {
+		return fmt.Errorf("cannot delete expense: %w", err)
+	}
+	if err = q.UpdateBalance(ctx, merged.WalletID, merged.Amount.Neg(), merged.CreatedAt); err != nil {
+		return err
+	}
+
+	if (!keep.Description.Valid && merged.Description.Valid) || (!keep.CategoryID.Valid && merged.CategoryID.Valid) {
+		if !keep.Description.Valid {
+			keep.Description = merged.Description
+		}
+		if !keep.CategoryID.Valid {
+			keep.CategoryID = merged.CategoryID
+		}
+		err = q.ExpenseUpdate(ctx, &dao.ExpenseUpdateParams{
+			Amount:      keep.Amount,
+			Description: keep.Description,
+			CategoryID:  keep.CategoryID,
+			CreatedAt:   keep.CreatedAt,
+			ID:          keep.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("cannot update expense: %w", err)
+		}
+	}
+
+	// The external ID is moved only after merged is deleted, as it is unique within the wallet.
+	if !keep.ExternalID.Valid && merged.ExternalID.Valid {
+		keep.ExternalID = merged.ExternalID
+		if err = q.ExpenseSetExternalID(ctx, keep.ExternalID, keep.ID); err != nil {
+			return fmt.Errorf("cannot update expense: %w", err)
+		}
+	}
+
+	if !keep.DebtID.Valid && merged.DebtID.Valid {
+		repays, err := repaysDebt(ctx, q, user, keep, merged.DebtID.String)
+		if err != nil {
+			return err
+		}
+		if repays {
+			keep.DebtID = merged.DebtID
+			if err = q.ExpenseSetDebt(ctx, keep.DebtID, keep.ID); err != nil {
+				return fmt.Errorf("cannot update expense: %w", err)
+			}
+		}
+	}
+
+	return q.Audit(ctx, user.Email, auditExpense, merged.ID, fmt.Sprintf("merged into expense %s", keep.ID))
+}
+
+// repaysDebt reports whether expense may be linked to the debt identified by debtID, as with setExpenseDebt: the debt
+// is owned by user and checkRepayment accepts expense.
+func repaysDebt(ctx context.Context, q dao.Querier, user *auth.User, expense *dao.Expense, debtID string) (bool, error) {
+	debt, err := userDebt(ctx, q, user, debtID)
+	if errors.Is(err, ErrDebtNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	wallet, err := q.WalletGetByID(ctx, expense.WalletID)
+	if err != nil {
+		return false, fmt.Errorf("cannot read wallet: %w", err)
+	}
+
+	return checkRepayment(debt, wallet, expense) == nil, nil
+}
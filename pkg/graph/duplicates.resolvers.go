@@ -0,0 +1,99 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/duplicate"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+)
+
+// MergeExpenses is the resolver for the mergeExpenses field.
+func (r *mutationResolver) MergeExpenses(ctx context.Context, keepID string, mergeIds []string) (*dao.Expense, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot merge expenses: %w", err)
+	}
+	defer rollBacker()
+
+	keep, err := userExpense(ctx, q, user, keepID, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	mergedIDs := make([]string, 0, len(mergeIds))
+	for _, id := range mergeIds {
+		if id == keep.ID {
+			return nil, ErrMergeSame
+		}
+		if slices.Contains(mergedIDs, id) {
+			continue
+		}
+
+		merged, err := userExpense(ctx, q, user, id, model.WalletAccessEditor)
+		if err != nil {
+			return nil, err
+		}
+		if merged.WalletID != keep.WalletID {
+			return nil, ErrMergeWallet
+		}
+
+		if err = mergeExpense(ctx, q, user, keep, merged); err != nil {
+			return nil, err
+		}
+		mergedIDs = append(mergedIDs, merged.ID)
+	}
+	if len(mergedIDs) == 0 {
+		return nil, ErrMergeNothing
+	}
+
+	event := fmt.Sprintf("merged expenses %s", strings.Join(mergedIDs, ", "))
+	if err = q.Audit(ctx, user.Email, auditExpense, keep.ID, event); err != nil {
+		return nil, err
+	}
+
+	keep, err = q.ExpenseGetByID(ctx, keep.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read merged expense: %w", err)
+	}
+
+	return keep, q.Commit(ctx)
+}
+
+// PossibleDuplicates is the resolver for the possibleDuplicates field.
+func (r *queryResolver) PossibleDuplicates(ctx context.Context, walletID string) ([]*model.DuplicateGroup, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	if _, err := userWallet(ctx, r.Dao, user, walletID, model.WalletAccessViewer); err != nil {
+		return nil, err
+	}
+
+	expenses, err := r.Dao.ExpenseListByWallet(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list expenses: %w", err)
+	}
+
+	groups := duplicate.Groups(expenses)
+	out := make([]*model.DuplicateGroup, len(groups))
+	for i, group := range groups {
+		out[i] = &model.DuplicateGroup{Expenses: group}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,110 @@
+package graph
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestMergeExpense(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	user := &auth.User{ID: "u1", Email: "one@example.com"}
+	now := time.Now().UTC()
+
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: user.ID, Currency: "PLN", Balance: money.FromInt(-25), CreatedAt: now}))
+	require.Nil(t, d.CategoryInsert(ctx, &dao.CategoryInsertParams{ID: "food", UserID: user.ID, Name: "Food", CreatedAt: now}))
+	require.Nil(t, d.TagInsert(ctx, "t1", user.ID, "coffee", now))
+	require.Nil(t, d.ExpenseInsert(ctx, &dao.ExpenseInsertParams{
+		ID: "manual", WalletID: "w1", Amount: money.MustParse("-12.5"), Description: dao.NilStr("Coffee"), CreatedAt: now,
+	}))
+	require.Nil(t, d.ExpenseInsert(ctx, &dao.ExpenseInsertParams{
+		ID: "imported", WalletID: "w1", Amount: money.MustParse("-12.5"), Description: dao.NilStr("CARD COFFEE"),
+		CategoryID: dao.NilStr("food"), CreatedAt: now, ExternalID: dao.NilStr("T1"),
+	}))
+	require.Nil(t, d.ExpenseTagInsert(ctx, "imported", "t1"))
+	require.Nil(t, d.DebtInsert(ctx, &dao.DebtInsertParams{
+		ID: "loan", UserID: user.ID, Name: "Loan", Currency: "PLN", Principal: money.FromInt(1000), TermMonths: 10,
+		PaymentDay: 1, StartsAt: now, CreatedAt: now,
+	}))
+	require.Nil(t, d.ExpenseSetDebt(ctx, dao.NilStr("loan"), "imported"))
+
+	keep, err := d.ExpenseGetByID(ctx, "manual")
+	require.Nil(t, err)
+	merged, err := d.ExpenseGetByID(ctx, "imported")
+	require.Nil(t, err)
+	require.Nil(t, mergeExpense(ctx, d, user, keep, merged))
+
+	keep, err = d.ExpenseGetByID(ctx, "manual")
+	require.Nil(t, err)
+	assert.Equal(t, "Coffee", keep.Description.String, "own description is kept")
+	assert.Equal(t, "food", keep.CategoryID.String)
+	assert.Equal(t, "T1", keep.ExternalID.String)
+	assert.Equal(t, "loan", keep.DebtID.String)
+
+	tags, err := d.TagListByExpense(ctx, "manual")
+	require.Nil(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "coffee", tags[0].Name)
+
+	expenses, err := d.ExpenseListByWallet(ctx, "w1")
+	require.Nil(t, err)
+	assert.Len(t, expenses, 1)
+
+	wallet, err := d.WalletGetByID(ctx, "w1")
+	require.Nil(t, err)
+	assert.Equal(t, money.MustParse("-12.5"), wallet.Balance)
+
+	history, err := d.HistoryList(ctx)
+	require.Nil(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, "imported", history[0].Reference)
+	assert.Equal(t, "merged into expense manual", history[0].Event)
+}
+
+func TestMergeExpenseDebt(t *testing.T) {
+	ctx := context.Background()
+	user := &auth.User{ID: "u1", Email: "one@example.com"}
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name       string
+		debtUserID string
+		keepAmount string
+		want       string
+	}{
+		{name: "repayment", debtUserID: user.ID, keepAmount: "-100", want: "loan"},
+		{name: "debt of another user", debtUserID: "u2", keepAmount: "-100"},
+		{name: "refund", debtUserID: user.ID, keepAmount: "100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := daotest.New(t)
+			require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: user.ID, Currency: "PLN", CreatedAt: now}))
+			require.Nil(t, d.DebtInsert(ctx, &dao.DebtInsertParams{
+				ID: "loan", UserID: tt.debtUserID, Name: "Loan", Currency: "PLN", Principal: money.FromInt(1000),
+				TermMonths: 10, PaymentDay: 1, StartsAt: now, CreatedAt: now,
+			}))
+			require.Nil(t, d.ExpenseInsert(ctx, &dao.ExpenseInsertParams{ID: "keep", WalletID: "w1", Amount: money.MustParse(tt.keepAmount), CreatedAt: now}))
+			require.Nil(t, d.ExpenseInsert(ctx, &dao.ExpenseInsertParams{ID: "merged", WalletID: "w1", Amount: money.FromInt(-100), CreatedAt: now}))
+			require.Nil(t, d.ExpenseSetDebt(ctx, dao.NilStr("loan"), "merged"))
+
+			keep, err := d.ExpenseGetByID(ctx, "keep")
+			require.Nil(t, err)
+			merged, err := d.ExpenseGetByID(ctx, "merged")
+			require.Nil(t, err)
+			require.Nil(t, mergeExpense(ctx, d, user, keep, merged))
+
+			keep, err = d.ExpenseGetByID(ctx, "keep")
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, keep.DebtID.String)
+			assert.Equal(t, tt.want != "", keep.DebtID.Valid)
+		})
+	}
+}
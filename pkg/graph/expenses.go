@@ -0,0 +1,50 @@
+package graph
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+)
+
+// expenseListParams converts listExpenses filter and order arguments to dao.ExpenseListParams.
+func expenseListParams(walletID string, filter *model.ExpenseFilter, order *model.ExpenseOrder) (*dao.ExpenseListParams, error) {
+	arg := &dao.ExpenseListParams{WalletID: walletID}
+
+	if order != nil {
+		if order.Field == model.ExpenseOrderFieldAmount {
+			arg.Sort = dao.ExpenseSortAmount
+		}
+		arg.Descending = order.Direction == model.OrderDirectionDesc
+	}
+
+	if filter == nil {
+		return arg, nil
+	}
+
+	arg.CreatedFrom = filter.CreatedFrom.Value()
+	arg.CreatedTo = filter.CreatedTo.Value()
+	arg.AmountMin = filter.AmountMin.Value()
+	arg.AmountMax = filter.AmountMax.Value()
+	arg.Description = filter.Description.Value()
+	arg.CategoryID = filter.CategoryID.Value()
+
+	if sign := filter.Sign.Value(); sign != nil {
+		arg.Sign = 1
+		if *sign == model.AmountSignNegative {
+			arg.Sign = -1
+		}
+	}
+
+	var err error
+	if arg.TagsAny, err = normalizeTags(filter.TagsAny.Value()); err != nil {
+		return nil, err
+	}
+	if arg.TagsAll, err = normalizeTags(filter.TagsAll.Value()); err != nil {
+		return nil, err
+	}
+
+	return arg, nil
+}
+
+func expenseCursor(e *dao.Expense) dao.Cursor {
+	return dao.Cursor{CreatedAt: e.CreatedAt, Amount: e.Amount, ID: e.ID}
+}
@@ -0,0 +1,54 @@
+package graph
+
+import (
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestExpenseListParams(t *testing.T) {
+	arg, err := expenseListParams("w1", nil, nil)
+	require.Nil(t, err)
+	assert.Equal(t, &dao.ExpenseListParams{WalletID: "w1"}, arg)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	amount := money.MustParse("-10")
+	description := "rent"
+	negative := model.AmountSignNegative
+	arg, err = expenseListParams("w1", &model.ExpenseFilter{
+		CreatedFrom: graphql.OmittableOf(&from),
+		AmountMax:   graphql.OmittableOf(&amount),
+		Description: graphql.OmittableOf(&description),
+		Sign:        graphql.OmittableOf(&negative),
+		TagsAll:     graphql.OmittableOf([]string{"Tax "}),
+	}, &model.ExpenseOrder{Field: model.ExpenseOrderFieldAmount, Direction: model.OrderDirectionDesc})
+	require.Nil(t, err)
+	assert.Equal(t, &dao.ExpenseListParams{
+		WalletID:    "w1",
+		CreatedFrom: &from,
+		AmountMax:   &amount,
+		Description: &description,
+		Sign:        -1,
+		TagsAny:     []string{},
+		TagsAll:     []string{"tax"},
+		Sort:        dao.ExpenseSortAmount,
+		Descending:  true,
+	}, arg)
+
+	_, err = expenseListParams("w1", &model.ExpenseFilter{TagsAny: graphql.OmittableOf([]string{""})}, nil)
+	assert.ErrorIs(t, err, ErrTagInvalid)
+}
+
+func TestExpenseCursor(t *testing.T) {
+	expense := &dao.Expense{ID: "e1", CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC), Amount: money.MustParse("-1.25")}
+	encoded := encodeCursor(expenseCursor(expense))
+
+	got, err := decodeCursor(&encoded)
+	require.Nil(t, err)
+	assert.Equal(t, &dao.Cursor{CreatedAt: expense.CreatedAt, Amount: expense.Amount, ID: "e1"}, got)
+}
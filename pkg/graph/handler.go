@@ -5,11 +5,13 @@ import (
 	"github.com/piotrekmonko/portfello/pkg/auth"
 	"github.com/piotrekmonko/portfello/pkg/conf"
 	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/logz"
 	"net/http"
 )
 
-func NewGraphHandler(conf *conf.Config, dbQuerier *dao.DAO, authService *auth.Service) http.Handler {
+func NewGraphHandler(log logz.Logger, conf *conf.Config, dbQuerier *dao.DAO, authService *auth.Service) http.Handler {
 	graphResolver := &Resolver{
+		Log:         log,
 		Conf:        conf,
 		Dao:         dbQuerier,
 		AuthService: authService,
@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+)
+
+// householdRank orders household roles, each role includes permissions of the lower ones.
+var householdRank = map[model.HouseholdRole]int{
+	model.HouseholdRoleViewer: 1,
+	model.HouseholdRoleMember: 2,
+	model.HouseholdRoleOwner:  3,
+}
+
+// userHousehold returns the household identified by householdID and membership of user in it, if user has at least
+// given role. Households the user is not a member of are reported as not found.
+func userHousehold(ctx context.Context, q dao.Querier, user *auth.User, householdID string, role model.HouseholdRole) (*dao.Household, *dao.HouseholdMember, error) {
+	member, err := q.HouseholdMemberGet(ctx, householdID, user.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, ErrHouseholdNotFound
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read household member: %w", err)
+	}
+
+	if householdRank[model.HouseholdRole(member.Role)] < householdRank[role] {
+		return nil, nil, ErrHouseholdAccess
+	}
+
+	household, err := q.HouseholdGetByID(ctx, householdID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read household: %w", err)
+	}
+
+	return household, member, nil
+}
+
+// householdMember returns a member of the household identified by householdID.
+func householdMember(ctx context.Context, q dao.Querier, householdID, userID string) (*dao.HouseholdMember, error) {
+	member, err := q.HouseholdMemberGet(ctx, householdID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrMemberNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read household member: %w", err)
+	}
+
+	return member, nil
+}
+
+// keepHouseholdOwner fails if member is the last owner of their household, so they cannot leave nor be demoted.
+func keepHouseholdOwner(ctx context.Context, q dao.Querier, member *dao.HouseholdMember) error {
+	if model.HouseholdRole(member.Role) != model.HouseholdRoleOwner {
+		return nil
+	}
+
+	owners, err := q.HouseholdMemberCountByRole(ctx, member.HouseholdID, string(model.HouseholdRoleOwner))
+	if err != nil {
+		return fmt.Errorf("cannot count household owners: %w", err)
+	}
+	if owners <= 1 {
+		return ErrHouseholdLastOwner
+	}
+
+	return nil
+}
+
+// invitation returns the invitation identified by id if it was sent to user, or if user is an owner of the household.
+func invitation(ctx context.Context, q dao.Querier, user *auth.User, id string) (*dao.HouseholdInvitation, error) {
+	inv, err := q.HouseholdInvitationGetByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInvitationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read invitation: %w", err)
+	}
+
+	if inv.UserID == user.ID {
+		return inv, nil
+	}
+
+	if _, _, err = userHousehold(ctx, q, user, inv.HouseholdID, model.HouseholdRoleOwner); err != nil {
+		return nil, ErrInvitationNotFound
+	}
+
+	return inv, nil
+}
@@ -0,0 +1,388 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	shortuuid "github.com/lithammer/shortuuid/v4"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+)
+
+// Role is the resolver for the role field.
+func (r *householdResolver) Role(ctx context.Context, obj *dao.Household) (*model.HouseholdRole, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	member, err := r.Dao.HouseholdMemberGet(ctx, obj.ID, user.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read household member: %w", err)
+	}
+
+	role := model.HouseholdRole(member.Role)
+	return &role, nil
+}
+
+// Members is the resolver for the members field.
+func (r *householdResolver) Members(ctx context.Context, obj *dao.Household) ([]*dao.HouseholdMember, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	// Members are only listed to other members, not to invited users.
+	if _, _, err := userHousehold(ctx, r.Dao, user, obj.ID, model.HouseholdRoleViewer); errors.Is(err, ErrHouseholdNotFound) {
+		return []*dao.HouseholdMember{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return r.Dao.HouseholdMemberListByHousehold(ctx, obj.ID)
+}
+
+// Household is the resolver for the household field.
+func (r *householdInvitationResolver) Household(ctx context.Context, obj *dao.HouseholdInvitation) (*dao.Household, error) {
+	return r.Dao.HouseholdGetByID(ctx, obj.HouseholdID)
+}
+
+// Role is the resolver for the role field.
+func (r *householdInvitationResolver) Role(ctx context.Context, obj *dao.HouseholdInvitation) (model.HouseholdRole, error) {
+	return model.HouseholdRole(obj.Role), nil
+}
+
+// Role is the resolver for the role field.
+func (r *householdMemberResolver) Role(ctx context.Context, obj *dao.HouseholdMember) (model.HouseholdRole, error) {
+	return model.HouseholdRole(obj.Role), nil
+}
+
+// CreateHousehold is the resolver for the createHousehold field.
+func (r *mutationResolver) CreateHousehold(ctx context.Context, name string) (*dao.Household, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrHouseholdName
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create household: %w", err)
+	}
+	defer rollBacker()
+
+	now := time.Now().UTC()
+	householdID := shortuuid.New()
+	if err = q.HouseholdInsert(ctx, householdID, name, now); err != nil {
+		return nil, fmt.Errorf("cannot create household: %w", err)
+	}
+
+	err = q.HouseholdMemberUpsert(ctx, &dao.HouseholdMemberUpsertParams{
+		HouseholdID: householdID,
+		UserID:      user.ID,
+		Email:       user.Email,
+		Role:        string(model.HouseholdRoleOwner),
+		CreatedAt:   now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot add household owner: %w", err)
+	}
+
+	if err = q.Audit(ctx, user.Email, auditHousehold, householdID, fmt.Sprintf("created as %q", name)); err != nil {
+		return nil, err
+	}
+
+	household, err := q.HouseholdGetByID(ctx, householdID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read created household: %w", err)
+	}
+
+	return household, q.Commit(ctx)
+}
+
+// InviteToHousehold is the resolver for the inviteToHousehold field.
+func (r *mutationResolver) InviteToHousehold(ctx context.Context, householdID string, email string, role model.HouseholdRole) (*dao.HouseholdInvitation, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	if !role.IsValid() {
+		return nil, fmt.Errorf("invalid household role: %s", role)
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot invite to household: %w", err)
+	}
+	defer rollBacker()
+
+	household, _, err := userHousehold(ctx, q, user, householdID, model.HouseholdRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	invitee, err := r.AuthService.GetUser(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find user: %w", err)
+	}
+
+	if _, err = q.HouseholdMemberGet(ctx, household.ID, invitee.ID); err == nil {
+		return nil, ErrHouseholdMember
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("cannot read household member: %w", err)
+	}
+
+	err = q.HouseholdInvitationUpsert(ctx, &dao.HouseholdInvitationUpsertParams{
+		ID:          shortuuid.New(),
+		HouseholdID: household.ID,
+		UserID:      invitee.ID,
+		Email:       invitee.Email,
+		Role:        string(role),
+		InvitedBy:   user.Email,
+		CreatedAt:   time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot invite to household: %w", err)
+	}
+
+	event := fmt.Sprintf("invited %s as %s", invitee.Email, role)
+	if err = q.Audit(ctx, user.Email, auditHousehold, household.ID, event); err != nil {
+		return nil, err
+	}
+
+	inv, err := q.HouseholdInvitationGetByUser(ctx, household.ID, invitee.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read invitation: %w", err)
+	}
+
+	return inv, q.Commit(ctx)
+}
+
+// AcceptHouseholdInvitation is the resolver for the acceptHouseholdInvitation field.
+func (r *mutationResolver) AcceptHouseholdInvitation(ctx context.Context, id string) (*dao.Household, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot accept invitation: %w", err)
+	}
+	defer rollBacker()
+
+	inv, err := invitation(ctx, q, user, id)
+	if err != nil {
+		return nil, err
+	}
+	if inv.UserID != user.ID {
+		return nil, ErrInvitationNotFound
+	}
+
+	err = q.HouseholdMemberUpsert(ctx, &dao.HouseholdMemberUpsertParams{
+		HouseholdID: inv.HouseholdID,
+		UserID:      user.ID,
+		Email:       user.Email,
+		Role:        inv.Role,
+		CreatedAt:   time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot join household: %w", err)
+	}
+
+	if err = q.Audit(ctx, user.Email, auditHousehold, inv.HouseholdID, "joined as "+inv.Role); err != nil {
+		return nil, err
+	}
+
+	if err = q.HouseholdInvitationDelete(ctx, inv.ID); err != nil {
+		return nil, fmt.Errorf("cannot remove invitation: %w", err)
+	}
+
+	household, err := q.HouseholdGetByID(ctx, inv.HouseholdID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read household: %w", err)
+	}
+
+	return household, q.Commit(ctx)
+}
+
+// DeclineHouseholdInvitation is the resolver for the declineHouseholdInvitation field.
+func (r *mutationResolver) DeclineHouseholdInvitation(ctx context.Context, id string) (*dao.HouseholdInvitation, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decline invitation: %w", err)
+	}
+	defer rollBacker()
+
+	inv, err := invitation(ctx, q, user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = q.HouseholdInvitationDelete(ctx, inv.ID); err != nil {
+		return nil, fmt.Errorf("cannot remove invitation: %w", err)
+	}
+
+	if err = q.Audit(ctx, user.Email, auditHousehold, inv.HouseholdID, "declined invitation of "+inv.Email); err != nil {
+		return nil, err
+	}
+
+	return inv, q.Commit(ctx)
+}
+
+// SetHouseholdMemberRole is the resolver for the setHouseholdMemberRole field.
+func (r *mutationResolver) SetHouseholdMemberRole(ctx context.Context, householdID string, userID string, role model.HouseholdRole) (*dao.HouseholdMember, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	if !role.IsValid() {
+		return nil, fmt.Errorf("invalid household role: %s", role)
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot change member role: %w", err)
+	}
+	defer rollBacker()
+
+	if _, _, err = userHousehold(ctx, q, user, householdID, model.HouseholdRoleOwner); err != nil {
+		return nil, err
+	}
+
+	member, err := householdMember(ctx, q, householdID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != model.HouseholdRoleOwner {
+		if err = keepHouseholdOwner(ctx, q, member); err != nil {
+			return nil, err
+		}
+	}
+
+	member.Role = string(role)
+	err = q.HouseholdMemberUpsert(ctx, &dao.HouseholdMemberUpsertParams{
+		HouseholdID: member.HouseholdID,
+		UserID:      member.UserID,
+		Email:       member.Email,
+		Role:        member.Role,
+		CreatedAt:   member.CreatedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot change member role: %w", err)
+	}
+
+	event := fmt.Sprintf("changed role of %s to %s", member.Email, member.Role)
+	if err = q.Audit(ctx, user.Email, auditHousehold, member.HouseholdID, event); err != nil {
+		return nil, err
+	}
+
+	return member, q.Commit(ctx)
+}
+
+// RemoveHouseholdMember is the resolver for the removeHouseholdMember field.
+func (r *mutationResolver) RemoveHouseholdMember(ctx context.Context, householdID string, userID string) (*dao.HouseholdMember, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot remove household member: %w", err)
+	}
+	defer rollBacker()
+
+	// Users may always leave a household on their own.
+	role := model.HouseholdRoleOwner
+	if userID == user.ID {
+		role = model.HouseholdRoleViewer
+	}
+
+	if _, _, err = userHousehold(ctx, q, user, householdID, role); err != nil {
+		return nil, err
+	}
+
+	member, err := householdMember(ctx, q, householdID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = keepHouseholdOwner(ctx, q, member); err != nil {
+		return nil, err
+	}
+
+	if err = q.HouseholdMemberDelete(ctx, member.HouseholdID, member.UserID); err != nil {
+		return nil, fmt.Errorf("cannot remove household member: %w", err)
+	}
+
+	if err = q.Audit(ctx, user.Email, auditHousehold, member.HouseholdID, "removed member "+member.Email); err != nil {
+		return nil, err
+	}
+
+	return member, q.Commit(ctx)
+}
+
+// ListHouseholds is the resolver for the listHouseholds field.
+func (r *queryResolver) ListHouseholds(ctx context.Context) ([]*dao.Household, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	return r.Dao.HouseholdListByUser(ctx, user.ID)
+}
+
+// ListHouseholdInvitations is the resolver for the listHouseholdInvitations field.
+func (r *queryResolver) ListHouseholdInvitations(ctx context.Context) ([]*dao.HouseholdInvitation, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	return r.Dao.HouseholdInvitationListByUser(ctx, user.ID)
+}
+
+// HouseholdID is the resolver for the householdID field.
+func (r *walletResolver) HouseholdID(ctx context.Context, obj *dao.Wallet) (*string, error) {
+	return dao.StrPtr(obj.HouseholdID), nil
+}
+
+// Household returns HouseholdResolver implementation.
+func (r *Resolver) Household() HouseholdResolver { return &householdResolver{r} }
+
+// HouseholdInvitation returns HouseholdInvitationResolver implementation.
+func (r *Resolver) HouseholdInvitation() HouseholdInvitationResolver {
+	return &householdInvitationResolver{r}
+}
+
+// HouseholdMember returns HouseholdMemberResolver implementation.
+func (r *Resolver) HouseholdMember() HouseholdMemberResolver { return &householdMemberResolver{r} }
+
+type householdResolver struct{ *Resolver }
+type householdInvitationResolver struct{ *Resolver }
+type householdMemberResolver struct{ *Resolver }
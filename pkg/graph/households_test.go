@@ -0,0 +1,41 @@
+package graph
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestUserHousehold(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	now := time.Now().UTC()
+
+	require.Nil(t, d.HouseholdInsert(ctx, "h1", "Family", now))
+	for user, role := range map[string]model.HouseholdRole{"parent": model.HouseholdRoleOwner, "child": model.HouseholdRoleViewer} {
+		require.Nil(t, d.HouseholdMemberUpsert(ctx, &dao.HouseholdMemberUpsertParams{
+			HouseholdID: "h1", UserID: user, Email: user + "@example.com", Role: string(role), CreatedAt: now,
+		}))
+	}
+
+	household, member, err := userHousehold(ctx, d, &auth.User{ID: "parent"}, "h1", model.HouseholdRoleOwner)
+	require.Nil(t, err)
+	assert.Equal(t, "Family", household.Name)
+	assert.ErrorIs(t, keepHouseholdOwner(ctx, d, member), ErrHouseholdLastOwner)
+
+	_, member, err = userHousehold(ctx, d, &auth.User{ID: "child"}, "h1", model.HouseholdRoleViewer)
+	require.Nil(t, err)
+	assert.Nil(t, keepHouseholdOwner(ctx, d, member))
+
+	_, _, err = userHousehold(ctx, d, &auth.User{ID: "child"}, "h1", model.HouseholdRoleMember)
+	assert.ErrorIs(t, err, ErrHouseholdAccess)
+
+	_, _, err = userHousehold(ctx, d, &auth.User{ID: "stranger"}, "h1", model.HouseholdRoleViewer)
+	assert.ErrorIs(t, err, ErrHouseholdNotFound)
+}
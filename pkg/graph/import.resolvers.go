@@ -0,0 +1,57 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/importer"
+)
+
+// ImportExpenses is the resolver for the importExpenses field.
+func (r *mutationResolver) ImportExpenses(ctx context.Context, walletID string, file graphql.Upload, format model.ImportFormat, profile *string, allowDuplicates bool) (*importer.Result, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	_, err := userWallet(ctx, r.Dao, user, walletID, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	imp := importer.NewImporter(r.Conf, r.Dao, r.AuthService)
+	var statement importer.Reader
+	switch format {
+	case model.ImportFormatOfx:
+		statement, err = imp.OFX(file.File)
+	case model.ImportFormatQif:
+		statement, err = imp.QIF(file.File)
+	case model.ImportFormatCamt053:
+		statement, err = imp.Camt053(file.File)
+	case model.ImportFormatMt940:
+		statement, err = imp.MT940(file.File)
+	default:
+		var profileName string
+		if profile != nil {
+			profileName = *profile
+		}
+		statement, err = imp.CSV(profileName, file.File)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := imp.Import(ctx, user, walletID, statement, allowDuplicates)
+	if err != nil {
+		return nil, fmt.Errorf("cannot import %s: %w", file.Filename, err)
+	}
+
+	return result, nil
+}
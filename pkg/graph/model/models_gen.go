@@ -3,22 +3,218 @@
 package model
 
 import (
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/money"
 )
 
 type Operation interface {
 	IsOperation()
 	GetID() string
 	GetWalletID() string
-	GetAmount() float64
+	GetAmount() money.Decimal
 	GetDescription() *string
 	GetCreatedAt() time.Time
 }
 
+// AuditLogFilter selects entries of the audit log. Omitted fields do not filter, given fields must all match.
+type AuditLogFilter struct {
+	Namespace graphql.Omittable[*string] `json:"namespace,omitempty"`
+	Reference graphql.Omittable[*string] `json:"reference,omitempty"`
+	Email     graphql.Omittable[*string] `json:"email,omitempty"`
+	// Entries recorded at or after this time.
+	CreatedFrom graphql.Omittable[*time.Time] `json:"createdFrom,omitempty"`
+	// Entries recorded before this time.
+	CreatedTo graphql.Omittable[*time.Time] `json:"createdTo,omitempty"`
+}
+
+type BalanceHistory struct {
+	// Currency of the total.
+	Currency string                  `json:"currency"`
+	Interval dao.BalanceInterval     `json:"interval"`
+	Wallets  []*WalletBalanceHistory `json:"wallets"`
+	// Sum of balances of all wallets, converted into currency.
+	Total []*BalancePoint `json:"total"`
+}
+
+// BalancePoint is the balance at the end of a period, or at the end of the history for its last period.
+type BalancePoint struct {
+	// Start of the day, week or month in UTC.
+	Period  time.Time     `json:"period"`
+	Balance money.Decimal `json:"balance"`
+	// Net amount of operations in the period.
+	Change money.Decimal `json:"change"`
+}
+
+// BudgetStatus is the spending progress of a budget in its current period.
+type BudgetStatus struct {
+	Budget      *dao.Budget `json:"budget"`
+	PeriodStart time.Time   `json:"periodStart"`
+	PeriodEnd   time.Time   `json:"periodEnd"`
+	// Budget limit, including amount carried over from previous periods when rollover is on.
+	Limit money.Decimal `json:"limit"`
+	// Amount of expenses in the period. Negative expenses are spending, positive ones, such as refunds, reduce it.
+	Spent money.Decimal `json:"spent"`
+	// Limit less spent, negative when the budget is overspent.
+	Remaining money.Decimal `json:"remaining"`
+	// Spent as percent of limit.
+	PercentUsed float64 `json:"percentUsed"`
+}
+
+// CreateBudgetInput needs a wallet, a category, or both. Currency is required for budgets of a category in all wallets,
+// otherwise it is the currency of the wallet.
+type CreateBudgetInput struct {
+	WalletID   graphql.Omittable[*string] `json:"walletId,omitempty"`
+	CategoryID graphql.Omittable[*string] `json:"categoryId,omitempty"`
+	Currency   graphql.Omittable[*string] `json:"currency,omitempty"`
+	Period     BudgetPeriod               `json:"period"`
+	Limit      money.Decimal              `json:"limit"`
+	Rollover   bool                       `json:"rollover"`
+	// Defaults to the start of current day.
+	StartsAt graphql.Omittable[*time.Time] `json:"startsAt,omitempty"`
+}
+
+type CreateCategoryInput struct {
+	Name     string                     `json:"name"`
+	ParentID graphql.Omittable[*string] `json:"parentId,omitempty"`
+}
+
+type CreateDebtInput struct {
+	Name      string                     `json:"name"`
+	Lender    graphql.Omittable[*string] `json:"lender,omitempty"`
+	Currency  string                     `json:"currency"`
+	Principal money.Decimal              `json:"principal"`
+	// Annual nominal interest rate in percent, 0 for interest-free loans.
+	InterestRate float64 `json:"interestRate"`
+	TermMonths   int     `json:"termMonths"`
+	// Defaults to the day of month of startsAt.
+	PaymentDay graphql.Omittable[*int] `json:"paymentDay,omitempty"`
+	StartsAt   time.Time               `json:"startsAt"`
+}
+
+type CreateExpenseInput struct {
+	WalletID    string                     `json:"walletId"`
+	Amount      money.Decimal              `json:"amount"`
+	Description graphql.Omittable[*string] `json:"description,omitempty"`
+	CategoryID  graphql.Omittable[*string] `json:"categoryId,omitempty"`
+	// Defaults to current time when omitted.
+	CreatedAt graphql.Omittable[*time.Time] `json:"createdAt,omitempty"`
+}
+
+type CreateIncomeInput struct {
+	WalletID    string                     `json:"walletId"`
+	Amount      money.Decimal              `json:"amount"`
+	Description graphql.Omittable[*string] `json:"description,omitempty"`
+	// Defaults to current time when omitted.
+	CreatedAt graphql.Omittable[*time.Time] `json:"createdAt,omitempty"`
+}
+
+type CreateRecurringRuleInput struct {
+	WalletID string        `json:"walletId"`
+	Kind     RecurringKind `json:"kind"`
+	// Income amounts must be positive.
+	Amount      money.Decimal              `json:"amount"`
+	Description graphql.Omittable[*string] `json:"description,omitempty"`
+	// Only expenses may have a category.
+	CategoryID graphql.Omittable[*string]    `json:"categoryId,omitempty"`
+	Rrule      string                        `json:"rrule"`
+	StartsAt   time.Time                     `json:"startsAt"`
+	EndsAt     graphql.Omittable[*time.Time] `json:"endsAt,omitempty"`
+}
+
+type CreateTradeInput struct {
+	WalletID string    `json:"walletId"`
+	Kind     TradeKind `json:"kind"`
+	Ticker   string    `json:"ticker"`
+	// Units bought or sold, required for buys and sells.
+	Quantity graphql.Omittable[*money.Decimal] `json:"quantity,omitempty"`
+	// Price of a unit in the currency of the Wallet, required for buys and sells.
+	Price graphql.Omittable[*money.Decimal] `json:"price,omitempty"`
+	// Dividend received before fee, required for dividends.
+	Amount graphql.Omittable[*money.Decimal] `json:"amount,omitempty"`
+	// Fees and taxes paid on the trade, defaults to 0.
+	Fee         graphql.Omittable[*money.Decimal] `json:"fee,omitempty"`
+	Description graphql.Omittable[*string]        `json:"description,omitempty"`
+	// Defaults to current time when omitted.
+	CreatedAt graphql.Omittable[*time.Time] `json:"createdAt,omitempty"`
+}
+
 type CreateWalletInput struct {
-	Currency string `json:"currency"`
+	Currency string     `json:"currency"`
+	Kind     WalletKind `json:"kind"`
+	// Creates a Wallet owned by this Household, needs member role in it.
+	HouseholdID graphql.Omittable[*string] `json:"householdId,omitempty"`
+}
+
+// DuplicateGroup holds expenses which may record the same purchase: their amounts are equal, their dates are at most
+// three days apart and their descriptions agree once digits and punctuation are dropped.
+type DuplicateGroup struct {
+	// Expenses of the group, oldest first.
+	Expenses []*dao.Expense `json:"expenses"`
+}
+
+// ExchangeRateInput converts amounts in currency into the currency of a report: one unit of currency is worth rate units.
+// It replaces stored exchange rates of currency at every point.
+type ExchangeRateInput struct {
+	Currency string  `json:"currency"`
+	Rate     float64 `json:"rate"`
+}
+
+type ExpenseConnection struct {
+	Edges    []*ExpenseEdge `json:"edges"`
+	PageInfo *PageInfo      `json:"pageInfo"`
+	// Number of all expenses matching the filters, regardless of paging.
+	TotalCount int `json:"totalCount"`
+}
+
+type ExpenseEdge struct {
+	Node   *dao.Expense `json:"node"`
+	Cursor string       `json:"cursor"`
+}
+
+// ExpenseFilter selects expenses by their properties. Omitted fields do not filter, given fields must all match.
+type ExpenseFilter struct {
+	// Matches expenses created at or after this time.
+	CreatedFrom graphql.Omittable[*time.Time] `json:"createdFrom,omitempty"`
+	// Matches expenses created before this time.
+	CreatedTo graphql.Omittable[*time.Time] `json:"createdTo,omitempty"`
+	// Matches expenses with amount greater or equal to this one.
+	AmountMin graphql.Omittable[*money.Decimal] `json:"amountMin,omitempty"`
+	// Matches expenses with amount less or equal to this one.
+	AmountMax graphql.Omittable[*money.Decimal] `json:"amountMax,omitempty"`
+	// Matches expenses whose description contains this text, regardless of case.
+	Description graphql.Omittable[*string] `json:"description,omitempty"`
+	// Matches expenses in this category or any of its subcategories.
+	CategoryID graphql.Omittable[*string]     `json:"categoryId,omitempty"`
+	Sign       graphql.Omittable[*AmountSign] `json:"sign,omitempty"`
+	// Matches expenses having any of these tags.
+	TagsAny graphql.Omittable[[]string] `json:"tagsAny,omitempty"`
+	// Matches expenses having all of these tags.
+	TagsAll graphql.Omittable[[]string] `json:"tagsAll,omitempty"`
+}
+
+// ExpenseOrder sorts expenses. Expenses with equal values are sorted by their ID.
+type ExpenseOrder struct {
+	Field     ExpenseOrderField `json:"field"`
+	Direction OrderDirection    `json:"direction"`
+}
+
+type HistoryConnection struct {
+	Edges    []*HistoryEdge `json:"edges"`
+	PageInfo *PageInfo      `json:"pageInfo"`
+	// Number of all entries matching the filter, regardless of paging.
+	TotalCount int `json:"totalCount"`
+}
+
+type HistoryEdge struct {
+	Node   *dao.History `json:"node"`
+	Cursor string       `json:"cursor"`
 }
 
 type Mutation struct {
@@ -29,6 +225,15 @@ type NewUser struct {
 	DisplayName string `json:"displayName"`
 }
 
+// PageInfo describes a page of a connection, following the Relay cursor connections specification. Lists are paged
+// forward with first and after, or backward with last and before.
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+}
+
 type Query struct {
 }
 
@@ -36,3 +241,495 @@ type Role struct {
 	UserID string      `json:"userId"`
 	Role   auth.RoleID `json:"role"`
 }
+
+type SpendingReport struct {
+	// Currency of reported amounts.
+	Currency string              `json:"currency"`
+	GroupBy  dao.SpendingGroupBy `json:"groupBy"`
+	// Totals of all reported expenses, each counted once.
+	Summary *dao.SpendingGroup `json:"summary"`
+	// Periods are sorted by time and only those having expenses are listed. Categories and tags are sorted by total,
+	// largest spending first.
+	Groups []*dao.SpendingGroup `json:"groups"`
+}
+
+type UpdateBudgetInput struct {
+	Period   graphql.Omittable[*BudgetPeriod]  `json:"period,omitempty"`
+	Limit    graphql.Omittable[*money.Decimal] `json:"limit,omitempty"`
+	Rollover graphql.Omittable[*bool]          `json:"rollover,omitempty"`
+	StartsAt graphql.Omittable[*time.Time]     `json:"startsAt,omitempty"`
+}
+
+type UpdateCategoryInput struct {
+	Name graphql.Omittable[*string] `json:"name,omitempty"`
+	// Set to null to make this a top level category.
+	ParentID graphql.Omittable[*string] `json:"parentId,omitempty"`
+}
+
+type UpdateExpenseInput struct {
+	Amount      graphql.Omittable[*money.Decimal] `json:"amount,omitempty"`
+	Description graphql.Omittable[*string]        `json:"description,omitempty"`
+	CategoryID  graphql.Omittable[*string]        `json:"categoryId,omitempty"`
+	CreatedAt   graphql.Omittable[*time.Time]     `json:"createdAt,omitempty"`
+}
+
+type UpdateIncomeInput struct {
+	Amount      graphql.Omittable[*money.Decimal] `json:"amount,omitempty"`
+	Description graphql.Omittable[*string]        `json:"description,omitempty"`
+	CreatedAt   graphql.Omittable[*time.Time]     `json:"createdAt,omitempty"`
+}
+
+type WalletBalanceHistory struct {
+	Wallet *dao.Wallet `json:"wallet"`
+	// Balances in the currency of the wallet.
+	Points []*BalancePoint `json:"points"`
+}
+
+type WalletConnection struct {
+	Edges    []*WalletEdge `json:"edges"`
+	PageInfo *PageInfo     `json:"pageInfo"`
+	// Number of all wallets in the list, regardless of paging.
+	TotalCount int `json:"totalCount"`
+}
+
+type WalletEdge struct {
+	Node   *dao.Wallet `json:"node"`
+	Cursor string      `json:"cursor"`
+}
+
+type AmountSign string
+
+const (
+	AmountSignPositive AmountSign = "positive"
+	AmountSignNegative AmountSign = "negative"
+)
+
+var AllAmountSign = []AmountSign{
+	AmountSignPositive,
+	AmountSignNegative,
+}
+
+func (e AmountSign) IsValid() bool {
+	switch e {
+	case AmountSignPositive, AmountSignNegative:
+		return true
+	}
+	return false
+}
+
+func (e AmountSign) String() string {
+	return string(e)
+}
+
+func (e *AmountSign) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AmountSign(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AmountSign", str)
+	}
+	return nil
+}
+
+func (e AmountSign) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type BudgetPeriod string
+
+const (
+	BudgetPeriodWeek  BudgetPeriod = "week"
+	BudgetPeriodMonth BudgetPeriod = "month"
+	BudgetPeriodYear  BudgetPeriod = "year"
+)
+
+var AllBudgetPeriod = []BudgetPeriod{
+	BudgetPeriodWeek,
+	BudgetPeriodMonth,
+	BudgetPeriodYear,
+}
+
+func (e BudgetPeriod) IsValid() bool {
+	switch e {
+	case BudgetPeriodWeek, BudgetPeriodMonth, BudgetPeriodYear:
+		return true
+	}
+	return false
+}
+
+func (e BudgetPeriod) String() string {
+	return string(e)
+}
+
+func (e *BudgetPeriod) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BudgetPeriod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BudgetPeriod", str)
+	}
+	return nil
+}
+
+func (e BudgetPeriod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ExpenseOrderField string
+
+const (
+	ExpenseOrderFieldCreatedAt ExpenseOrderField = "createdAt"
+	ExpenseOrderFieldAmount    ExpenseOrderField = "amount"
+)
+
+var AllExpenseOrderField = []ExpenseOrderField{
+	ExpenseOrderFieldCreatedAt,
+	ExpenseOrderFieldAmount,
+}
+
+func (e ExpenseOrderField) IsValid() bool {
+	switch e {
+	case ExpenseOrderFieldCreatedAt, ExpenseOrderFieldAmount:
+		return true
+	}
+	return false
+}
+
+func (e ExpenseOrderField) String() string {
+	return string(e)
+}
+
+func (e *ExpenseOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ExpenseOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ExpenseOrderField", str)
+	}
+	return nil
+}
+
+func (e ExpenseOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// HouseholdRole is the role of a member in a Household. Members get access to Household Wallets matching their role:
+// owners have owner access, members have editor access and viewers have viewer access.
+type HouseholdRole string
+
+const (
+	// May also invite and remove members, and change their roles.
+	HouseholdRoleOwner HouseholdRole = "owner"
+	// May also create Household Wallets.
+	HouseholdRoleMember HouseholdRole = "member"
+	HouseholdRoleViewer HouseholdRole = "viewer"
+)
+
+var AllHouseholdRole = []HouseholdRole{
+	HouseholdRoleOwner,
+	HouseholdRoleMember,
+	HouseholdRoleViewer,
+}
+
+func (e HouseholdRole) IsValid() bool {
+	switch e {
+	case HouseholdRoleOwner, HouseholdRoleMember, HouseholdRoleViewer:
+		return true
+	}
+	return false
+}
+
+func (e HouseholdRole) String() string {
+	return string(e)
+}
+
+func (e *HouseholdRole) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = HouseholdRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid HouseholdRole", str)
+	}
+	return nil
+}
+
+func (e HouseholdRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ImportFormat string
+
+const (
+	ImportFormatCSV ImportFormat = "csv"
+	// OFX or QFX, as exported by banks and Quicken.
+	ImportFormatOfx ImportFormat = "ofx"
+	// QIF, as exported by Quicken, GnuCash and other personal finance programs.
+	ImportFormatQif ImportFormat = "qif"
+	// ISO 20022 camt.053 bank to customer statement.
+	ImportFormatCamt053 ImportFormat = "camt053"
+	// SWIFT MT940 customer statement.
+	ImportFormatMt940 ImportFormat = "mt940"
+)
+
+var AllImportFormat = []ImportFormat{
+	ImportFormatCSV,
+	ImportFormatOfx,
+	ImportFormatQif,
+	ImportFormatCamt053,
+	ImportFormatMt940,
+}
+
+func (e ImportFormat) IsValid() bool {
+	switch e {
+	case ImportFormatCSV, ImportFormatOfx, ImportFormatQif, ImportFormatCamt053, ImportFormatMt940:
+		return true
+	}
+	return false
+}
+
+func (e ImportFormat) String() string {
+	return string(e)
+}
+
+func (e *ImportFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ImportFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ImportFormat", str)
+	}
+	return nil
+}
+
+func (e ImportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OrderDirection string
+
+const (
+	OrderDirectionAsc  OrderDirection = "asc"
+	OrderDirectionDesc OrderDirection = "desc"
+)
+
+var AllOrderDirection = []OrderDirection{
+	OrderDirectionAsc,
+	OrderDirectionDesc,
+}
+
+func (e OrderDirection) IsValid() bool {
+	switch e {
+	case OrderDirectionAsc, OrderDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e OrderDirection) String() string {
+	return string(e)
+}
+
+func (e *OrderDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDirection", str)
+	}
+	return nil
+}
+
+func (e OrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RecurringKind string
+
+const (
+	RecurringKindExpense RecurringKind = "expense"
+	RecurringKindIncome  RecurringKind = "income"
+)
+
+var AllRecurringKind = []RecurringKind{
+	RecurringKindExpense,
+	RecurringKindIncome,
+}
+
+func (e RecurringKind) IsValid() bool {
+	switch e {
+	case RecurringKindExpense, RecurringKindIncome:
+		return true
+	}
+	return false
+}
+
+func (e RecurringKind) String() string {
+	return string(e)
+}
+
+func (e *RecurringKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RecurringKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RecurringKind", str)
+	}
+	return nil
+}
+
+func (e RecurringKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TradeKind string
+
+const (
+	TradeKindBuy      TradeKind = "buy"
+	TradeKindSell     TradeKind = "sell"
+	TradeKindDividend TradeKind = "dividend"
+)
+
+var AllTradeKind = []TradeKind{
+	TradeKindBuy,
+	TradeKindSell,
+	TradeKindDividend,
+}
+
+func (e TradeKind) IsValid() bool {
+	switch e {
+	case TradeKindBuy, TradeKindSell, TradeKindDividend:
+		return true
+	}
+	return false
+}
+
+func (e TradeKind) String() string {
+	return string(e)
+}
+
+func (e *TradeKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TradeKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TradeKind", str)
+	}
+	return nil
+}
+
+func (e TradeKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// WalletAccess is the level of access a user has to a Wallet. Each level includes permissions of the lower ones.
+type WalletAccess string
+
+const (
+	// May list operations of the Wallet.
+	WalletAccessViewer WalletAccess = "viewer"
+	// May also add, change and remove operations of the Wallet.
+	WalletAccessEditor WalletAccess = "editor"
+	// May also share the Wallet with other users.
+	WalletAccessOwner WalletAccess = "owner"
+)
+
+var AllWalletAccess = []WalletAccess{
+	WalletAccessViewer,
+	WalletAccessEditor,
+	WalletAccessOwner,
+}
+
+func (e WalletAccess) IsValid() bool {
+	switch e {
+	case WalletAccessViewer, WalletAccessEditor, WalletAccessOwner:
+		return true
+	}
+	return false
+}
+
+func (e WalletAccess) String() string {
+	return string(e)
+}
+
+func (e *WalletAccess) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WalletAccess(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WalletAccess", str)
+	}
+	return nil
+}
+
+func (e WalletAccess) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Cash wallets hold money only, investment wallets hold securities too, bought and sold with trades.
+type WalletKind string
+
+const (
+	WalletKindCash       WalletKind = "cash"
+	WalletKindInvestment WalletKind = "investment"
+)
+
+var AllWalletKind = []WalletKind{
+	WalletKindCash,
+	WalletKindInvestment,
+}
+
+func (e WalletKind) IsValid() bool {
+	switch e {
+	case WalletKindCash, WalletKindInvestment:
+		return true
+	}
+	return false
+}
+
+func (e WalletKind) String() string {
+	return string(e)
+}
+
+func (e *WalletKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WalletKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WalletKind", str)
+	}
+	return nil
+}
+
+func (e WalletKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
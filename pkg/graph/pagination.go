@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"encoding/base64"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// newPage converts relay connection arguments into a dao.Page. One row more than requested is selected, so pageInfo
+// can tell whether another page follows.
+func newPage(first *int, after *string, last *int, before *string) (*dao.Page, error) {
+	if first != nil && last != nil {
+		return nil, ErrPageArgs
+	}
+
+	page := &dao.Page{Limit: defaultPageSize}
+	switch {
+	case first != nil:
+		page.Limit = *first
+	case last != nil:
+		page.Limit = *last
+		page.FromEnd = true
+	case before != nil && after == nil:
+		page.FromEnd = true
+	}
+	if page.Limit < 0 || page.Limit > maxPageSize {
+		return nil, ErrPageSize
+	}
+	page.Limit++
+
+	var err error
+	if page.After, err = decodeCursor(after); err != nil {
+		return nil, err
+	}
+	if page.Before, err = decodeCursor(before); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// pageInfo drops the extra row selected for page and describes what is left. Items must be in ascending order.
+func pageInfo[T any](items []T, page *dao.Page, cursor func(T) dao.Cursor) ([]T, *model.PageInfo) {
+	info := &model.PageInfo{}
+	if len(items) >= page.Limit {
+		if page.FromEnd {
+			items = items[1:]
+			info.HasPreviousPage = true
+		} else {
+			items = items[:len(items)-1]
+			info.HasNextPage = true
+		}
+	}
+
+	if len(items) > 0 {
+		start, end := encodeCursor(cursor(items[0])), encodeCursor(cursor(items[len(items)-1]))
+		info.StartCursor, info.EndCursor = &start, &end
+	}
+
+	return items, info
+}
+
+// encodeCursor returns an opaque cursor string for c.
+func encodeCursor(c dao.Cursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(
+		c.CreatedAt.UTC().Format(time.RFC3339Nano) + " " + c.Amount.String() + " " + c.ID,
+	))
+}
+
+// decodeCursor reads a cursor made by encodeCursor. Empty s is returned as nil cursor.
+func decodeCursor(s *string) (*dao.Cursor, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(*s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	parts := strings.SplitN(string(raw), " ", 3)
+	if len(parts) != 3 || parts[2] == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	amount, err := money.Parse(parts[1])
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &dao.Cursor{CreatedAt: createdAt.UTC(), Amount: amount, ID: parts[2]}, nil
+}
+
+func walletCursor(w *dao.Wallet) dao.Cursor {
+	return dao.Cursor{CreatedAt: w.CreatedAt, ID: w.ID}
+}
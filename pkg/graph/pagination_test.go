@@ -0,0 +1,69 @@
+package graph
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewPage(t *testing.T) {
+	one, tooMany, negative := 1, maxPageSize+1, -1
+	cursor := encodeCursor(dao.Cursor{CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC), ID: "abc"})
+	invalid := "not a cursor"
+
+	page, err := newPage(nil, nil, nil, nil)
+	require.Nil(t, err)
+	assert.Equal(t, &dao.Page{Limit: defaultPageSize + 1}, page)
+
+	page, err = newPage(&one, &cursor, nil, nil)
+	require.Nil(t, err)
+	assert.Equal(t, &dao.Page{Limit: 2, After: &dao.Cursor{CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC), ID: "abc"}}, page)
+
+	page, err = newPage(nil, nil, &one, nil)
+	require.Nil(t, err)
+	assert.Equal(t, &dao.Page{Limit: 2, FromEnd: true}, page)
+
+	page, err = newPage(nil, nil, nil, &cursor)
+	require.Nil(t, err)
+	assert.True(t, page.FromEnd)
+
+	_, err = newPage(&one, nil, &one, nil)
+	assert.ErrorIs(t, err, ErrPageArgs)
+	_, err = newPage(&tooMany, nil, nil, nil)
+	assert.ErrorIs(t, err, ErrPageSize)
+	_, err = newPage(nil, nil, &negative, nil)
+	assert.ErrorIs(t, err, ErrPageSize)
+	_, err = newPage(nil, &invalid, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestPageInfo(t *testing.T) {
+	cursor := func(id string) dao.Cursor { return dao.Cursor{ID: id} }
+	encoded := func(id string) *string {
+		s := encodeCursor(cursor(id))
+		return &s
+	}
+
+	items, info := pageInfo([]string{"a", "b", "c"}, &dao.Page{Limit: 3}, cursor)
+	assert.Equal(t, []string{"a", "b"}, items)
+	assert.True(t, info.HasNextPage)
+	assert.False(t, info.HasPreviousPage)
+	assert.Equal(t, encoded("a"), info.StartCursor)
+	assert.Equal(t, encoded("b"), info.EndCursor)
+
+	items, info = pageInfo([]string{"a", "b", "c"}, &dao.Page{Limit: 3, FromEnd: true}, cursor)
+	assert.Equal(t, []string{"b", "c"}, items)
+	assert.False(t, info.HasNextPage)
+	assert.True(t, info.HasPreviousPage)
+
+	items, info = pageInfo([]string{"a"}, &dao.Page{Limit: 3}, cursor)
+	assert.Equal(t, []string{"a"}, items)
+	assert.False(t, info.HasNextPage)
+
+	items, info = pageInfo([]string{}, &dao.Page{Limit: 3}, cursor)
+	assert.Empty(t, items)
+	assert.Nil(t, info.StartCursor)
+	assert.Nil(t, info.EndCursor)
+}
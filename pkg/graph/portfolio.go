@@ -0,0 +1,89 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/piotrekmonko/portfello/pkg/portfolio"
+	"time"
+)
+
+// tradeParams validates a trade to be made in wallet and computes its amount. Fees, dividends and the value of units are
+// rounded to minor units of the wallet currency, prices of a unit are kept as given.
+func tradeParams(input model.CreateTradeInput, wallet *dao.Wallet) (*dao.TradeInsertParams, error) {
+	if wallet.Kind != string(model.WalletKindInvestment) {
+		return nil, ErrTradeWallet
+	}
+
+	ticker, err := portfolio.NormalizeTicker(input.Ticker)
+	if err != nil {
+		return nil, err
+	}
+
+	var fee money.Decimal
+	if f := input.Fee.Value(); f != nil {
+		fee = money.RoundIn(*f, wallet.Currency)
+	}
+	if fee < 0 {
+		return nil, ErrTradeFee
+	}
+
+	createdAt := time.Now().UTC()
+	if t := input.CreatedAt.Value(); t != nil {
+		createdAt = t.UTC()
+	}
+
+	trade := &dao.TradeInsertParams{
+		ID:          shortuuid.New(),
+		WalletID:    wallet.ID,
+		Ticker:      ticker,
+		Kind:        string(input.Kind),
+		Fee:         fee,
+		Description: dao.NilStrPtr(input.Description.Value()),
+		CreatedAt:   createdAt,
+	}
+
+	quantity, price, amount := input.Quantity.Value(), input.Price.Value(), input.Amount.Value()
+	switch input.Kind {
+	case model.TradeKindBuy, model.TradeKindSell:
+		if quantity == nil || price == nil || amount != nil || !quantity.IsPositive() || !price.IsPositive() {
+			return nil, ErrTradeQuantity
+		}
+		trade.Quantity, trade.Price = *quantity, *price
+
+		value := money.RoundIn(quantity.Mul(*price), wallet.Currency)
+		if input.Kind == model.TradeKindBuy {
+			trade.Amount = (value + fee).Neg()
+		} else {
+			trade.Amount = value - fee
+		}
+	case model.TradeKindDividend:
+		if quantity != nil || price != nil || amount == nil {
+			return nil, ErrTradeDividend
+		}
+		dividend := money.RoundIn(*amount, wallet.Currency)
+		if !dividend.IsPositive() {
+			return nil, ErrTradeDividend
+		}
+		trade.Amount = dividend - fee
+	default:
+		return nil, fmt.Errorf("invalid trade kind: %s", input.Kind)
+	}
+
+	return trade, nil
+}
+
+// checkTrades replays trades of a wallet, failing with portfolio.ErrOversold when any of them sells more units than
+// held at its time. It must be called with the querier of the transaction changing trades, after the change.
+func checkTrades(ctx context.Context, q dao.Querier, walletID string) error {
+	trades, err := q.TradeListByWallet(ctx, walletID)
+	if err != nil {
+		return fmt.Errorf("cannot list trades: %w", err)
+	}
+
+	_, err = portfolio.Holdings(trades)
+	return err
+}
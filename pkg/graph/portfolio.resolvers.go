@@ -0,0 +1,164 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/exchange"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/portfolio"
+)
+
+// CreateTrade is the resolver for the createTrade field.
+func (r *mutationResolver) CreateTrade(ctx context.Context, input model.CreateTradeInput) (*dao.Trade, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create trade: %w", err)
+	}
+	defer rollBacker()
+
+	wallet, err := userWallet(ctx, q, user, input.WalletID, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	newTrade, err := tradeParams(input, wallet)
+	if err != nil {
+		return nil, err
+	}
+	if err = q.TradeInsert(ctx, newTrade); err != nil {
+		return nil, fmt.Errorf("cannot create trade: %w", err)
+	}
+
+	if err = checkTrades(ctx, q, newTrade.WalletID); err != nil {
+		return nil, err
+	}
+
+	if err = q.UpdateBalance(ctx, newTrade.WalletID, newTrade.Amount, newTrade.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	event := fmt.Sprintf("created %s of %s in wallet %s with quantity %s, price %s and amount %s", newTrade.Kind,
+		newTrade.Ticker, newTrade.WalletID, newTrade.Quantity, newTrade.Price, newTrade.Amount)
+	if err = q.Audit(ctx, user.Email, auditTrade, newTrade.ID, event); err != nil {
+		return nil, err
+	}
+
+	trade, err := q.TradeGetByID(ctx, newTrade.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read created trade: %w", err)
+	}
+
+	return trade, q.Commit(ctx)
+}
+
+// DeleteTrade is the resolver for the deleteTrade field.
+func (r *mutationResolver) DeleteTrade(ctx context.Context, id string) (*dao.Trade, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot delete trade: %w", err)
+	}
+	defer rollBacker()
+
+	trade, err := userTrade(ctx, q, user, id, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = q.TradeDelete(ctx, trade.ID); err != nil {
+		return nil, fmt.Errorf("cannot delete trade: %w", err)
+	}
+
+	if err = checkTrades(ctx, q, trade.WalletID); err != nil {
+		return nil, err
+	}
+
+	if err = q.UpdateBalance(ctx, trade.WalletID, trade.Amount.Neg(), trade.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	event := fmt.Sprintf("deleted %s of %s from wallet %s with amount %s", trade.Kind, trade.Ticker, trade.WalletID,
+		trade.Amount)
+	if err = q.Audit(ctx, user.Email, auditTrade, trade.ID, event); err != nil {
+		return nil, err
+	}
+
+	return trade, q.Commit(ctx)
+}
+
+// ListTrades is the resolver for the listTrades field.
+func (r *queryResolver) ListTrades(ctx context.Context, walletID string) ([]*dao.Trade, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	if _, err := userWallet(ctx, r.Dao, user, walletID, model.WalletAccessViewer); err != nil {
+		return nil, err
+	}
+
+	trades, err := r.Dao.TradeListByWallet(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list trades: %w", err)
+	}
+
+	return trades, nil
+}
+
+// Portfolio is the resolver for the portfolio field.
+func (r *queryResolver) Portfolio(ctx context.Context, walletID string, at *time.Time) (*portfolio.Portfolio, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	wallet, err := userWallet(ctx, r.Dao, user, walletID, model.WalletAccessViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	valuedAt := time.Now().UTC()
+	if at != nil {
+		valuedAt = at.UTC()
+	}
+
+	trades, err := r.Dao.TradeListByWallet(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list trades: %w", err)
+	}
+
+	pricer := portfolio.NewPricer(r.Dao, exchange.NewConverter(r.Dao))
+	return portfolio.Value(ctx, wallet, trades, valuedAt, pricer.Price)
+}
+
+// Description is the resolver for the description field.
+func (r *tradeResolver) Description(ctx context.Context, obj *dao.Trade) (*string, error) {
+	return obj.GetDescription(), nil
+}
+
+// Kind is the resolver for the kind field.
+func (r *tradeResolver) Kind(ctx context.Context, obj *dao.Trade) (model.TradeKind, error) {
+	return model.TradeKind(obj.Kind), nil
+}
+
+// Trade returns TradeResolver implementation.
+func (r *Resolver) Trade() TradeResolver { return &tradeResolver{r} }
+
+type tradeResolver struct{ *Resolver }
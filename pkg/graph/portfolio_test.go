@@ -0,0 +1,129 @@
+package graph
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/piotrekmonko/portfello/pkg/portfolio"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestTradeParams(t *testing.T) {
+	decimal := func(s string) graphql.Omittable[*money.Decimal] {
+		d := money.MustParse(s)
+		return graphql.OmittableOf(&d)
+	}
+	investment := &dao.Wallet{ID: "w1", Currency: "JPY", Kind: string(model.WalletKindInvestment)}
+
+	tests := []struct {
+		name         string
+		wallet       *dao.Wallet
+		input        model.CreateTradeInput
+		wantAmount   money.Decimal
+		wantQuantity money.Decimal
+		wantErr      error
+	}{
+		{
+			name:   "buy",
+			wallet: investment,
+			input: model.CreateTradeInput{
+				Kind: model.TradeKindBuy, Ticker: " 7203.t", Quantity: decimal("10.5"), Price: decimal("3600.25"), Fee: decimal("99.6"),
+			},
+			wantAmount: money.MustParse("-37903"), wantQuantity: money.MustParse("10.5"),
+		},
+		{
+			name:       "sell",
+			wallet:     investment,
+			input:      model.CreateTradeInput{Kind: model.TradeKindSell, Ticker: "7203.T", Quantity: decimal("2"), Price: decimal("3700")},
+			wantAmount: money.MustParse("7400"), wantQuantity: money.MustParse("2"),
+		},
+		{
+			name:       "dividend",
+			wallet:     investment,
+			input:      model.CreateTradeInput{Kind: model.TradeKindDividend, Ticker: "7203.T", Amount: decimal("500.4"), Fee: decimal("75")},
+			wantAmount: money.MustParse("425"),
+		},
+		{
+			name:    "cash wallet",
+			wallet:  &dao.Wallet{ID: "w2", Currency: "JPY", Kind: string(model.WalletKindCash)},
+			input:   model.CreateTradeInput{Kind: model.TradeKindBuy, Ticker: "7203.T", Quantity: decimal("1"), Price: decimal("1")},
+			wantErr: ErrTradeWallet,
+		},
+		{
+			name:    "buy without price",
+			wallet:  investment,
+			input:   model.CreateTradeInput{Kind: model.TradeKindBuy, Ticker: "7203.T", Quantity: decimal("1")},
+			wantErr: ErrTradeQuantity,
+		},
+		{
+			name:    "sell with amount",
+			wallet:  investment,
+			input:   model.CreateTradeInput{Kind: model.TradeKindSell, Ticker: "7203.T", Quantity: decimal("1"), Price: decimal("1"), Amount: decimal("1")},
+			wantErr: ErrTradeQuantity,
+		},
+		{
+			name:    "dividend rounded to zero",
+			wallet:  investment,
+			input:   model.CreateTradeInput{Kind: model.TradeKindDividend, Ticker: "7203.T", Amount: decimal("0.4")},
+			wantErr: ErrTradeDividend,
+		},
+		{
+			name:    "dividend with quantity",
+			wallet:  investment,
+			input:   model.CreateTradeInput{Kind: model.TradeKindDividend, Ticker: "7203.T", Amount: decimal("1"), Quantity: decimal("1")},
+			wantErr: ErrTradeDividend,
+		},
+		{
+			name:    "negative fee",
+			wallet:  investment,
+			input:   model.CreateTradeInput{Kind: model.TradeKindDividend, Ticker: "7203.T", Amount: decimal("1"), Fee: decimal("-1")},
+			wantErr: ErrTradeFee,
+		},
+		{
+			name:    "no ticker",
+			wallet:  investment,
+			input:   model.CreateTradeInput{Kind: model.TradeKindDividend, Ticker: " ", Amount: decimal("1")},
+			wantErr: portfolio.ErrInvalidTicker,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tradeParams(tt.input, tt.wallet)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, "7203.T", got.Ticker)
+			assert.Equal(t, tt.wantAmount, got.Amount)
+			assert.Equal(t, tt.wantQuantity, got.Quantity)
+		})
+	}
+}
+
+func TestCheckTrades(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	require.Nil(t, d.WalletInsertKind(ctx, &dao.WalletInsertKindParams{ID: "w1", UserID: "u1", Currency: "USD", Kind: "investment", CreatedAt: start}))
+
+	insert := func(id, kind string, quantity int64, at time.Time) {
+		require.Nil(t, d.TradeInsert(ctx, &dao.TradeInsertParams{
+			ID: id, WalletID: "w1", Ticker: "AAPL", Kind: kind, Quantity: money.FromInt(quantity), CreatedAt: at,
+		}))
+	}
+
+	// A buy and a sell made at the same time are replayed buy first.
+	insert("b", portfolio.KindSell, 5, start)
+	insert("a", portfolio.KindBuy, 5, start)
+	assert.Nil(t, checkTrades(ctx, d, "w1"))
+
+	insert("c", portfolio.KindSell, 1, start.Add(-time.Hour))
+	assert.ErrorIs(t, checkTrades(ctx, d, "w1"), portfolio.ErrOversold)
+}
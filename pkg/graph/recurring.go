@@ -0,0 +1,23 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+)
+
+// userRecurring returns the recurring rule identified by ruleID if it is owned by user.
+func userRecurring(ctx context.Context, q dao.Querier, user *auth.User, ruleID string) (*dao.Recurring, error) {
+	rule, err := q.RecurringGetByID(ctx, ruleID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && rule.UserID != user.ID) {
+		return nil, ErrRecurringNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read recurring rule: %w", err)
+	}
+
+	return rule, nil
+}
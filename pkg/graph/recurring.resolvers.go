@@ -0,0 +1,176 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	shortuuid "github.com/lithammer/shortuuid/v4"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/piotrekmonko/portfello/pkg/recurring"
+)
+
+// CreateRecurringRule is the resolver for the createRecurringRule field.
+func (r *mutationResolver) CreateRecurringRule(ctx context.Context, input model.CreateRecurringRuleInput) (*dao.Recurring, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	if !input.Kind.IsValid() {
+		return nil, fmt.Errorf("invalid recurring kind: %s", input.Kind)
+	}
+	if input.Kind == model.RecurringKindIncome && input.CategoryID.Value() != nil {
+		return nil, ErrRecurringCategory
+	}
+
+	rrule, err := recurring.ParseRule(input.Rrule)
+	if err != nil {
+		return nil, err
+	}
+
+	startsAt := input.StartsAt.UTC()
+	var endsAt sql.NullTime
+	if t := input.EndsAt.Value(); t != nil {
+		if !t.After(startsAt) {
+			return nil, ErrRecurringEnd
+		}
+		endsAt = dao.NilTime(t.UTC())
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create recurring rule: %w", err)
+	}
+	defer rollBacker()
+
+	wallet, err := userWallet(ctx, q, user, input.WalletID, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := money.RoundIn(input.Amount, wallet.Currency)
+	if input.Kind == model.RecurringKindIncome && !amount.IsPositive() {
+		return nil, ErrIncomeNotPositive
+	}
+
+	categoryID, err := userCategoryRef(ctx, q, user, input.CategoryID.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	next, _ := rrule.Next(startsAt, endsAt, 0)
+	newRule := &dao.RecurringInsertParams{
+		ID:          shortuuid.New(),
+		UserID:      user.ID,
+		Email:       user.Email,
+		WalletID:    input.WalletID,
+		Kind:        string(input.Kind),
+		Amount:      amount,
+		Description: dao.NilStrPtr(input.Description.Value()),
+		CategoryID:  categoryID,
+		Rrule:       rrule.String(),
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+		NextAt:      dao.NilTime(next),
+		CreatedAt:   time.Now().UTC(),
+	}
+	if err = q.RecurringInsert(ctx, newRule); err != nil {
+		return nil, fmt.Errorf("cannot create recurring rule: %w", err)
+	}
+
+	event := fmt.Sprintf("created %s in wallet %s with amount %s repeating %s", newRule.Kind, newRule.WalletID,
+		newRule.Amount, newRule.Rrule)
+	if err = q.Audit(ctx, user.Email, auditRecurring, newRule.ID, event); err != nil {
+		return nil, err
+	}
+
+	rule, err := q.RecurringGetByID(ctx, newRule.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read created recurring rule: %w", err)
+	}
+
+	return rule, q.Commit(ctx)
+}
+
+// DeleteRecurringRule is the resolver for the deleteRecurringRule field.
+func (r *mutationResolver) DeleteRecurringRule(ctx context.Context, id string) (*dao.Recurring, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot delete recurring rule: %w", err)
+	}
+	defer rollBacker()
+
+	rule, err := userRecurring(ctx, q, user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = q.RecurringDelete(ctx, rule.ID); err != nil {
+		return nil, fmt.Errorf("cannot delete recurring rule: %w", err)
+	}
+
+	if err = q.Audit(ctx, user.Email, auditRecurring, rule.ID, "deleted"); err != nil {
+		return nil, err
+	}
+
+	return rule, q.Commit(ctx)
+}
+
+// ListRecurringRules is the resolver for the listRecurringRules field.
+func (r *queryResolver) ListRecurringRules(ctx context.Context) ([]*dao.Recurring, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	rules, err := r.Dao.RecurringListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list recurring rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// Kind is the resolver for the kind field.
+func (r *recurringRuleResolver) Kind(ctx context.Context, obj *dao.Recurring) (model.RecurringKind, error) {
+	return model.RecurringKind(obj.Kind), nil
+}
+
+// Description is the resolver for the description field.
+func (r *recurringRuleResolver) Description(ctx context.Context, obj *dao.Recurring) (*string, error) {
+	return dao.StrPtr(obj.Description), nil
+}
+
+// CategoryID is the resolver for the categoryID field.
+func (r *recurringRuleResolver) CategoryID(ctx context.Context, obj *dao.Recurring) (*string, error) {
+	return dao.StrPtr(obj.CategoryID), nil
+}
+
+// EndsAt is the resolver for the endsAt field.
+func (r *recurringRuleResolver) EndsAt(ctx context.Context, obj *dao.Recurring) (*time.Time, error) {
+	return dao.TimePtr(obj.EndsAt), nil
+}
+
+// NextAt is the resolver for the nextAt field.
+func (r *recurringRuleResolver) NextAt(ctx context.Context, obj *dao.Recurring) (*time.Time, error) {
+	return dao.TimePtr(obj.NextAt), nil
+}
+
+// RecurringRule returns RecurringRuleResolver implementation.
+func (r *Resolver) RecurringRule() RecurringRuleResolver { return &recurringRuleResolver{r} }
+
+type recurringRuleResolver struct{ *Resolver }
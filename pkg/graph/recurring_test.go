@@ -0,0 +1,34 @@
+package graph
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCreateRecurringRuleRounding(t *testing.T) {
+	d := daotest.New(t)
+	user := &auth.User{ID: "u1", Email: "one@example.com"}
+	ctx := context.WithValue(context.Background(), auth.CtxUserKey, user)
+	m := (&Resolver{Dao: d}).Mutation()
+	now := time.Now().UTC()
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: user.ID, Currency: "JPY", CreatedAt: now}))
+
+	rule, err := m.CreateRecurringRule(ctx, model.CreateRecurringRuleInput{
+		WalletID: "w1", Kind: model.RecurringKindExpense, Amount: money.MustParse("-999.5"), Rrule: "FREQ=MONTHLY", StartsAt: now,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, money.FromInt(-1000), rule.Amount, "yen have no minor units")
+
+	_, err = m.CreateRecurringRule(ctx, model.CreateRecurringRuleInput{
+		WalletID: "w1", Kind: model.RecurringKindIncome, Amount: money.MustParse("0.4"), Rrule: "FREQ=MONTHLY", StartsAt: now,
+	})
+	assert.ErrorIs(t, err, ErrIncomeNotPositive)
+}
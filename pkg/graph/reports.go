@@ -0,0 +1,128 @@
+package graph
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/exchange"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"sort"
+	"time"
+)
+
+// amountConverter converts an amount in currency into the currency of a report at given time.
+type amountConverter func(ctx context.Context, amount money.Decimal, currency string, at time.Time) (money.Decimal, error)
+
+// reportConverter returns an amountConverter into currency. Given rates, keyed by currency, take precedence over rates
+// stored for the time of conversion.
+func reportConverter(converter *exchange.Converter, currency string, rates map[string]float64) amountConverter {
+	return func(ctx context.Context, amount money.Decimal, from string, at time.Time) (money.Decimal, error) {
+		if from == currency {
+			return amount, nil
+		}
+		if rate, ok := rates[from]; ok {
+			return exchange.Convert(amount, rate, currency), nil
+		}
+		return converter.Convert(ctx, amount, from, currency, at)
+	}
+}
+
+// convertSpendingGroups converts amounts of groups of expenses in currency. Groups of a period are converted at its
+// end, others at the end of the report.
+func convertSpendingGroups(ctx context.Context, groups []*dao.SpendingGroup, currency string, end time.Time, groupBy dao.SpendingGroupBy, convert amountConverter) error {
+	for _, group := range groups {
+		at := end.Add(-time.Nanosecond)
+		if group.Period != nil {
+			at = pointTime(*group.Period, end, dao.BalanceInterval(groupBy))
+		}
+
+		for _, amount := range []*money.Decimal{&group.Total, &group.Average, &group.Min, &group.Max} {
+			converted, err := convert(ctx, *amount, currency, at)
+			if err != nil {
+				return err
+			}
+			*amount = converted
+		}
+	}
+	return nil
+}
+
+// mergeSpendingGroups merges groups of expenses of wallets in different currencies, converted into one, which share a
+// period, a category or a tag. Merged groups are sorted like groups of a single report.
+func mergeSpendingGroups(parts [][]*dao.SpendingGroup, groupBy dao.SpendingGroupBy) []*dao.SpendingGroup {
+	var merged []*dao.SpendingGroup
+	byKey := map[string]*dao.SpendingGroup{}
+	for _, groups := range parts {
+		for _, group := range groups {
+			key := spendingGroupKey(group)
+			into, ok := byKey[key]
+			if !ok {
+				copied := *group
+				byKey[key] = &copied
+				merged = append(merged, &copied)
+				continue
+			}
+
+			// Empty groups, such as the summary of wallets having no expenses, have no minimum nor maximum.
+			if group.Count == 0 {
+				continue
+			}
+			if into.Count == 0 || group.Min < into.Min {
+				into.Min = group.Min
+			}
+			if into.Count == 0 || group.Max > into.Max {
+				into.Max = group.Max
+			}
+			into.Total += group.Total
+			into.Count += group.Count
+			into.Average = into.Total.Div(into.Count)
+		}
+	}
+
+	switch groupBy {
+	case dao.SpendingGroupByDay, dao.SpendingGroupByWeek, dao.SpendingGroupByMonth:
+		sort.SliceStable(merged, func(i, j int) bool {
+			return merged[i].Period.Before(*merged[j].Period)
+		})
+	case dao.SpendingGroupByCategory, dao.SpendingGroupByTag:
+		// Largest spending first, groups without a category or a tag last among equal totals.
+		sort.SliceStable(merged, func(i, j int) bool {
+			if merged[i].Total != merged[j].Total {
+				return merged[i].Total < merged[j].Total
+			}
+			a, b := spendingGroupName(merged[i]), spendingGroupName(merged[j])
+			if (a == nil) != (b == nil) {
+				return b == nil
+			}
+			return a != nil && *a < *b
+		})
+	}
+	return merged
+}
+
+// roundSpendingAverages rounds averages of groups to minor units of currency. Other amounts are sums of amounts in the
+// currency, or are rounded when converted.
+func roundSpendingAverages(groups []*dao.SpendingGroup, currency string) {
+	for _, group := range groups {
+		group.Average = money.RoundIn(group.Average, currency)
+	}
+}
+
+// spendingGroupKey identifies the period, category or tag of a group.
+func spendingGroupKey(group *dao.SpendingGroup) string {
+	switch {
+	case group.Period != nil:
+		return group.Period.Format(time.DateOnly)
+	case group.CategoryID != nil:
+		return "category:" + *group.CategoryID
+	case group.Tag != nil:
+		return "tag:" + *group.Tag
+	}
+	return ""
+}
+
+func spendingGroupName(group *dao.SpendingGroup) *string {
+	if group.CategoryName != nil {
+		return group.CategoryName
+	}
+	return group.Tag
+}
@@ -0,0 +1,101 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/exchange"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+)
+
+// SpendingReport is the resolver for the spendingReport field.
+func (r *queryResolver) SpendingReport(ctx context.Context, walletIds []string, from *time.Time, to *time.Time, groupBy dao.SpendingGroupBy, inCurrency *string) (*model.SpendingReport, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	inCurrency, err := lookupOptionalCurrency(inCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	walletIds = slices.Clone(walletIds)
+	slices.Sort(walletIds)
+	walletIds = slices.Compact(walletIds)
+	if len(walletIds) == 0 {
+		return nil, ErrReportWallets
+	}
+
+	// Wallets are reported together per currency, totals of each currency are then converted and merged.
+	var currencies []string
+	walletsByCurrency := map[string][]string{}
+	for _, id := range walletIds {
+		wallet, err := userWallet(ctx, r.Dao, user, id, model.WalletAccessViewer)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := walletsByCurrency[wallet.Currency]; !ok {
+			currencies = append(currencies, wallet.Currency)
+		}
+		walletsByCurrency[wallet.Currency] = append(walletsByCurrency[wallet.Currency], wallet.ID)
+	}
+
+	currency := currencies[0]
+	if inCurrency != nil {
+		currency = *inCurrency
+	} else if len(currencies) > 1 {
+		return nil, ErrReportCurrency
+	}
+
+	end := time.Now().UTC()
+	if to != nil {
+		end = to.UTC()
+	}
+	convert := reportConverter(exchange.NewConverter(r.Dao), currency, nil)
+
+	var summaries, groupings [][]*dao.SpendingGroup
+	for _, walletCurrency := range currencies {
+		arg := &dao.SpendingReportParams{WalletIDs: walletsByCurrency[walletCurrency], CreatedFrom: from, CreatedTo: to}
+		summary, err := r.Dao.SpendingReport(ctx, arg)
+		if err != nil {
+			return nil, err
+		}
+		if err = convertSpendingGroups(ctx, summary, walletCurrency, end, "", convert); err != nil {
+			return nil, err
+		}
+
+		arg.GroupBy = groupBy
+		groups, err := r.Dao.SpendingReport(ctx, arg)
+		if err != nil {
+			return nil, err
+		}
+		if err = convertSpendingGroups(ctx, groups, walletCurrency, end, groupBy, convert); err != nil {
+			return nil, err
+		}
+
+		summaries, groupings = append(summaries, summary), append(groupings, groups)
+	}
+
+	summary, groups := summaries[0], groupings[0]
+	if len(currencies) > 1 {
+		summary, groups = mergeSpendingGroups(summaries, ""), mergeSpendingGroups(groupings, groupBy)
+	}
+
+	roundSpendingAverages(summary, currency)
+	roundSpendingAverages(groups, currency)
+
+	return &model.SpendingReport{
+		Currency: currency,
+		GroupBy:  groupBy,
+		Summary:  summary[0],
+		Groups:   groups,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestConvertSpendingGroups(t *testing.T) {
+	march, april := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC)
+	groups := []*dao.SpendingGroup{
+		{Period: &march, Total: money.MustParse("-10"), Count: 2, Average: money.MustParse("-5"), Min: money.MustParse("-8"), Max: money.MustParse("-2")},
+		{Period: &april, Total: money.MustParse("-4"), Count: 1, Average: money.MustParse("-4"), Min: money.MustParse("-4"), Max: money.MustParse("-4")},
+	}
+
+	var times []time.Time
+	double := func(_ context.Context, amount money.Decimal, currency string, at time.Time) (money.Decimal, error) {
+		assert.Equal(t, "PLN", currency)
+		times = append(times, at)
+		return amount * 2, nil
+	}
+	require.Nil(t, convertSpendingGroups(context.Background(), groups, "PLN", end, dao.SpendingGroupByMonth, double))
+
+	assert.Equal(t, money.MustParse("-20"), groups[0].Total)
+	assert.Equal(t, money.MustParse("-10"), groups[0].Average)
+	assert.Equal(t, money.MustParse("-16"), groups[0].Min)
+	assert.Equal(t, money.MustParse("-4"), groups[0].Max)
+	assert.Equal(t, int64(2), groups[0].Count)
+	// March is converted at its end, April at the end of the report.
+	assert.Equal(t, april.Add(-time.Nanosecond), times[0])
+	assert.Equal(t, end.Add(-time.Nanosecond), times[len(times)-1])
+}
+
+func TestMergeSpendingGroups(t *testing.T) {
+	group := func(total string, count int64, average, min, max string) *dao.SpendingGroup {
+		return &dao.SpendingGroup{
+			Total: money.MustParse(total), Count: count, Average: money.MustParse(average),
+			Min: money.MustParse(min), Max: money.MustParse(max),
+		}
+	}
+	str := func(s string) *string { return &s }
+	march, april := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	summary := mergeSpendingGroups([][]*dao.SpendingGroup{
+		{group("-10", 2, "-5", "-8", "-2")}, {group("0", 0, "0", "0", "0")}, {group("-2", 2, "-1", "-3", "1")},
+	}, "")
+	assert.Equal(t, []*dao.SpendingGroup{group("-12", 4, "-3", "-8", "1")}, summary)
+
+	inMarch, inApril := group("-1", 1, "-1", "-1", "-1"), group("-2", 1, "-2", "-2", "-2")
+	inMarch.Period, inApril.Period = &march, &april
+	alsoInMarch := group("-3", 1, "-3", "-3", "-3")
+	alsoInMarch.Period = &march
+	byMonth := mergeSpendingGroups([][]*dao.SpendingGroup{{inApril}, {inMarch, alsoInMarch}}, dao.SpendingGroupByMonth)
+	require.Len(t, byMonth, 2)
+	assert.Equal(t, &march, byMonth[0].Period)
+	assert.Equal(t, money.MustParse("-4"), byMonth[0].Total)
+	assert.Equal(t, &april, byMonth[1].Period)
+
+	food, travel, none := group("-5", 1, "-5", "-5", "-5"), group("-5", 1, "-5", "-5", "-5"), group("-5", 1, "-5", "-5", "-5")
+	food.CategoryID, food.CategoryName = str("food"), str("Food")
+	travel.CategoryID, travel.CategoryName = str("travel"), str("Travel")
+	moreFood := group("-1", 1, "-1", "-1", "-1")
+	moreFood.CategoryID, moreFood.CategoryName = str("food"), str("Food")
+	byCategory := mergeSpendingGroups([][]*dao.SpendingGroup{{none, travel}, {food}, {moreFood}}, dao.SpendingGroupByCategory)
+	require.Len(t, byCategory, 3)
+	assert.Equal(t, []string{"Food", "Travel"}, []string{*byCategory[0].CategoryName, *byCategory[1].CategoryName})
+	assert.Equal(t, money.MustParse("-6"), byCategory[0].Total)
+	assert.Equal(t, money.MustParse("-3"), byCategory[0].Average)
+	assert.Nil(t, byCategory[2].CategoryID)
+}
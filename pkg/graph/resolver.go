@@ -4,6 +4,7 @@ import (
 	"github.com/piotrekmonko/portfello/pkg/auth"
 	"github.com/piotrekmonko/portfello/pkg/conf"
 	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/logz"
 )
 
 // This file will not be regenerated automatically.
@@ -11,6 +12,7 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
+	Log         logz.Logger
 	Conf        *conf.Config
 	Dao         *dao.DAO
 	AuthService *auth.Service
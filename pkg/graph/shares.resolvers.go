@@ -0,0 +1,157 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/piotrekmonko/portfello/pkg/access"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+)
+
+// ShareWallet is the resolver for the shareWallet field.
+func (r *mutationResolver) ShareWallet(ctx context.Context, walletID string, email string, access model.WalletAccess) (*dao.WalletGrant, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	if !access.IsValid() {
+		return nil, fmt.Errorf("invalid wallet access: %s", access)
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot share wallet: %w", err)
+	}
+	defer rollBacker()
+
+	wallet, err := userWallet(ctx, q, user, walletID, model.WalletAccessOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := r.AuthService.GetUser(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find user: %w", err)
+	}
+	if grantee.ID == wallet.UserID && !wallet.HouseholdID.Valid {
+		return nil, ErrShareOwner
+	}
+
+	err = q.WalletGrantUpsert(ctx, &dao.WalletGrantUpsertParams{
+		WalletID:  wallet.ID,
+		UserID:    grantee.ID,
+		Email:     grantee.Email,
+		Access:    string(access),
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot share wallet: %w", err)
+	}
+
+	event := fmt.Sprintf("shared with %s as %s", grantee.Email, access)
+	if err = q.Audit(ctx, user.Email, auditWallet, wallet.ID, event); err != nil {
+		return nil, err
+	}
+
+	grant, err := q.WalletGrantGet(ctx, wallet.ID, grantee.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read wallet grant: %w", err)
+	}
+
+	return grant, q.Commit(ctx)
+}
+
+// RevokeWalletShare is the resolver for the revokeWalletShare field.
+func (r *mutationResolver) RevokeWalletShare(ctx context.Context, walletID string, userID string) (*dao.WalletGrant, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot revoke wallet share: %w", err)
+	}
+	defer rollBacker()
+
+	// Users may always give up their own access.
+	access := model.WalletAccessOwner
+	if userID == user.ID {
+		access = model.WalletAccessViewer
+	}
+
+	if _, err = userWallet(ctx, q, user, walletID, access); err != nil {
+		return nil, err
+	}
+
+	grant, err := q.WalletGrantGet(ctx, walletID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrShareNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read wallet grant: %w", err)
+	}
+
+	if err = q.WalletGrantDelete(ctx, grant.WalletID, grant.UserID); err != nil {
+		return nil, fmt.Errorf("cannot revoke wallet share: %w", err)
+	}
+
+	if err = q.Audit(ctx, user.Email, auditWallet, grant.WalletID, "unshared with "+grant.Email); err != nil {
+		return nil, err
+	}
+
+	return grant, q.Commit(ctx)
+}
+
+// ListWalletShares is the resolver for the listWalletShares field.
+func (r *queryResolver) ListWalletShares(ctx context.Context, walletID string) ([]*dao.WalletGrant, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	if _, err := userWallet(ctx, r.Dao, user, walletID, model.WalletAccessOwner); err != nil {
+		return nil, err
+	}
+
+	return r.Dao.WalletGrantListByWallet(ctx, walletID)
+}
+
+// Access is the resolver for the access field.
+func (r *walletResolver) Access(ctx context.Context, obj *dao.Wallet) (*model.WalletAccess, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	_, userAccess, err := access.Wallet(ctx, r.Dao, user.ID, obj.ID)
+	if errors.Is(err, ErrWalletNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	walletAccess := model.WalletAccess(userAccess)
+	return &walletAccess, nil
+}
+
+// Access is the resolver for the access field.
+func (r *walletGrantResolver) Access(ctx context.Context, obj *dao.WalletGrant) (model.WalletAccess, error) {
+	return model.WalletAccess(obj.Access), nil
+}
+
+// WalletGrant returns WalletGrantResolver implementation.
+func (r *Resolver) WalletGrant() WalletGrantResolver { return &walletGrantResolver{r} }
+
+type walletGrantResolver struct{ *Resolver }
@@ -0,0 +1,58 @@
+package graph
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestUserWallet(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	now := time.Now().UTC()
+
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: "owner", Currency: "PLN", CreatedAt: now}))
+	for user, access := range map[string]model.WalletAccess{
+		"viewer":  model.WalletAccessViewer,
+		"editor":  model.WalletAccessEditor,
+		"coowner": model.WalletAccessOwner,
+	} {
+		require.Nil(t, d.WalletGrantUpsert(ctx, &dao.WalletGrantUpsertParams{
+			WalletID: "w1", UserID: user, Email: user + "@example.com", Access: string(access), CreatedAt: now,
+		}))
+	}
+
+	tests := []struct {
+		user    string
+		access  model.WalletAccess
+		wantErr error
+	}{
+		{user: "owner", access: model.WalletAccessOwner},
+		{user: "coowner", access: model.WalletAccessOwner},
+		{user: "editor", access: model.WalletAccessEditor},
+		{user: "editor", access: model.WalletAccessOwner, wantErr: ErrWalletAccess},
+		{user: "viewer", access: model.WalletAccessViewer},
+		{user: "viewer", access: model.WalletAccessEditor, wantErr: ErrWalletAccess},
+		{user: "stranger", access: model.WalletAccessViewer, wantErr: ErrWalletNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.user+" "+string(tt.access), func(t *testing.T) {
+			wallet, err := userWallet(ctx, d, &auth.User{ID: tt.user}, "w1", tt.access)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, "w1", wallet.ID)
+		})
+	}
+
+	_, err := userWallet(ctx, d, &auth.User{ID: "owner"}, "missing", model.WalletAccessViewer)
+	assert.ErrorIs(t, err, ErrWalletNotFound)
+}
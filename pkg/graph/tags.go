@@ -0,0 +1,57 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxTagLength matches the size of tag.name column.
+const maxTagLength = 128
+
+// normalizeTags trims and lower-cases tag names, so they match regardless of case. Duplicates are dropped.
+func normalizeTags(tags []string) ([]string, error) {
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || utf8.RuneCountInString(tag) > maxTagLength {
+			return nil, ErrTagInvalid
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			out = append(out, tag)
+		}
+	}
+
+	return out, nil
+}
+
+// userTag returns the tag of given name owned by user, creating it when it does not exist yet.
+func userTag(ctx context.Context, q dao.Querier, userID, name string) (*dao.Tag, error) {
+	tag, err := q.TagGetByName(ctx, userID, name)
+	if err == nil {
+		return tag, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("cannot read tag: %w", err)
+	}
+
+	tag = &dao.Tag{
+		ID:        shortuuid.New(),
+		UserID:    userID,
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err = q.TagInsert(ctx, tag.ID, tag.UserID, tag.Name, tag.CreatedAt); err != nil {
+		return nil, fmt.Errorf("cannot create tag: %w", err)
+	}
+
+	return tag, nil
+}
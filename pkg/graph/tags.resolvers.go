@@ -0,0 +1,127 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+)
+
+// Tags is the resolver for the tags field.
+func (r *expenseResolver) Tags(ctx context.Context, obj *dao.Expense) ([]string, error) {
+	tags, err := r.Dao.TagListByExpense(ctx, obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list expense tags: %w", err)
+	}
+
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+
+	return names, nil
+}
+
+// AddTags is the resolver for the addTags field.
+func (r *mutationResolver) AddTags(ctx context.Context, expenseID string, tags []string) (*dao.Expense, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	names, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot add tags: %w", err)
+	}
+	defer rollBacker()
+
+	expense, err := userExpense(ctx, q, user, expenseID, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		tag, err := userTag(ctx, q, user.ID, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = q.ExpenseTagInsert(ctx, expense.ID, tag.ID); err != nil {
+			return nil, fmt.Errorf("cannot add tag: %w", err)
+		}
+	}
+
+	if err = q.Audit(ctx, user.Email, auditExpense, expense.ID, "tagged "+strings.Join(names, ", ")); err != nil {
+		return nil, err
+	}
+
+	return expense, q.Commit(ctx)
+}
+
+// RemoveTags is the resolver for the removeTags field.
+func (r *mutationResolver) RemoveTags(ctx context.Context, expenseID string, tags []string) (*dao.Expense, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	names, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot remove tags: %w", err)
+	}
+	defer rollBacker()
+
+	expense, err := userExpense(ctx, q, user, expenseID, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		if err = q.ExpenseTagDeleteByName(ctx, expense.ID, user.ID, name); err != nil {
+			return nil, fmt.Errorf("cannot remove tag: %w", err)
+		}
+	}
+
+	if err = q.Audit(ctx, user.Email, auditExpense, expense.ID, "untagged "+strings.Join(names, ", ")); err != nil {
+		return nil, err
+	}
+
+	return expense, q.Commit(ctx)
+}
+
+// ListTags is the resolver for the listTags field.
+func (r *queryResolver) ListTags(ctx context.Context) ([]string, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	tags, err := r.Dao.TagListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list tags: %w", err)
+	}
+
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+
+	return names, nil
+}
@@ -0,0 +1,23 @@
+package graph
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	got, err := normalizeTags([]string{" Vacation-2026", "tax-deductible", "vacation-2026 "})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"vacation-2026", "tax-deductible"}, got)
+
+	got, err = normalizeTags(nil)
+	assert.Nil(t, err)
+	assert.Empty(t, got)
+
+	_, err = normalizeTags([]string{"ok", "  "})
+	assert.ErrorIs(t, err, ErrTagInvalid)
+
+	_, err = normalizeTags([]string{strings.Repeat("x", maxTagLength+1)})
+	assert.ErrorIs(t, err, ErrTagInvalid)
+}
@@ -14,14 +14,23 @@ import (
 
 // UserSetPassword is the resolver for the userSetPassword field.
 func (r *mutationResolver) UserSetPassword(ctx context.Context, userID string, newPassword string) (*auth.User, error) {
-	user, err := r.AuthService.GetUser(ctx, userID)
+	var user *auth.User
+	err := r.changeUser(ctx, func(users *auth.Service) (*auth.User, string, error) {
+		var err error
+		user, err = users.GetUser(ctx, userID)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid user: %w", err)
+		}
+
+		err = users.SetPassword(ctx, user, newPassword)
+		if err != nil {
+			return nil, "", fmt.Errorf("cannot change password: %w", err)
+		}
+
+		return user, "changed password", nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("invalid user: %w", err)
-	}
-
-	err = r.AuthService.SetPassword(ctx, user, newPassword)
-	if err != nil {
-		return nil, fmt.Errorf("cannot change password: %w", err)
+		return nil, err
 	}
 
 	return user, nil
@@ -29,12 +38,40 @@ func (r *mutationResolver) UserSetPassword(ctx context.Context, userID string, n
 
 // UserCreate is the resolver for the userCreate field.
 func (r *mutationResolver) UserCreate(ctx context.Context, newUser model.NewUser) (*auth.User, error) {
-	return r.AuthService.CreateUser(ctx, newUser.Email, newUser.DisplayName, auth.Roles{auth.RoleUser})
+	var user *auth.User
+	err := r.changeUser(ctx, func(users *auth.Service) (*auth.User, string, error) {
+		var err error
+		user, err = users.CreateUser(ctx, newUser.Email, newUser.DisplayName, auth.Roles{auth.RoleUser})
+		if err != nil {
+			return nil, "", err
+		}
+
+		return user, "created with roles " + user.Roles.ToString(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
 }
 
 // AdminCreate is the resolver for the adminCreate field.
 func (r *mutationResolver) AdminCreate(ctx context.Context, newAdmin model.NewUser) (*auth.User, error) {
-	return r.AuthService.CreateUser(ctx, newAdmin.Email, newAdmin.DisplayName, auth.Roles{auth.RoleSuperAdmin})
+	var admin *auth.User
+	err := r.changeUser(ctx, func(users *auth.Service) (*auth.User, string, error) {
+		var err error
+		admin, err = users.CreateUser(ctx, newAdmin.Email, newAdmin.DisplayName, auth.Roles{auth.RoleSuperAdmin})
+		if err != nil {
+			return nil, "", err
+		}
+
+		return admin, "created with roles " + admin.Roles.ToString(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return admin, nil
 }
 
 // UserAssignRoles is the resolver for the userAssignRoles field.
@@ -53,7 +90,25 @@ func (r *mutationResolver) UserAssignRoles(ctx context.Context, email string, ne
 		return nil, fmt.Errorf("only super administrators may grant super admin role")
 	}
 
-	return r.AuthService.AssignRoles(ctx, email, newRoles)
+	var roles []auth.RoleID
+	err := r.changeUser(ctx, func(users *auth.Service) (*auth.User, string, error) {
+		target, err := users.GetUser(ctx, email)
+		if err != nil {
+			return nil, "", fmt.Errorf("cannot find user: %w", err)
+		}
+
+		roles, err = users.AssignRoles(ctx, email, newRoles)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return target, "assigned roles " + auth.Roles(roles).ToString(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
 }
 
 // Login is the resolver for the login field.
@@ -6,13 +6,16 @@ package graph
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
 	shortuuid "github.com/lithammer/shortuuid/v4"
 	"github.com/piotrekmonko/portfello/pkg/auth"
 	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/exchange"
 	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
 )
 
 // Description is the resolver for the description field.
@@ -20,6 +23,16 @@ func (r *expenseResolver) Description(ctx context.Context, obj *dao.Expense) (*s
 	return obj.GetDescription(), nil
 }
 
+// CategoryID is the resolver for the categoryID field.
+func (r *expenseResolver) CategoryID(ctx context.Context, obj *dao.Expense) (*string, error) {
+	return dao.StrPtr(obj.CategoryID), nil
+}
+
+// Description is the resolver for the description field.
+func (r *incomeResolver) Description(ctx context.Context, obj *dao.Income) (*string, error) {
+	return obj.GetDescription(), nil
+}
+
 // CreateWallet is the resolver for the createWallet field.
 func (r *mutationResolver) CreateWallet(ctx context.Context, input model.CreateWalletInput) ([]*dao.Wallet, error) {
 	user := auth.GetCtxUser(ctx)
@@ -27,24 +40,54 @@ func (r *mutationResolver) CreateWallet(ctx context.Context, input model.CreateW
 		return nil, auth.ErrNotAuthorized
 	}
 
+	currency, err := lookupCurrency(input.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if !input.Kind.IsValid() {
+		return nil, fmt.Errorf("invalid wallet kind: %s", input.Kind)
+	}
+
 	q, rollBacker, err := r.Dao.BeginTx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("cannot crate new wallet: %w", err)
 	}
 	defer rollBacker()
 
-	err = q.WalletInsert(ctx, &dao.WalletInsertParams{
-		ID:        shortuuid.New(),
-		UserID:    user.ID,
-		Balance:   0,
-		Currency:  input.Currency,
-		CreatedAt: time.Time{},
-	})
-	if err != nil {
+	var householdID sql.NullString
+	if id := input.HouseholdID.Value(); id != nil {
+		household, _, err := userHousehold(ctx, q, user, *id, model.HouseholdRoleMember)
+		if err != nil {
+			return nil, err
+		}
+		householdID = dao.NilStr(household.ID)
+	}
+
+	newWallet := &dao.WalletInsertKindParams{
+		ID:          shortuuid.New(),
+		UserID:      user.ID,
+		HouseholdID: householdID,
+		Currency:    currency.Code,
+		Kind:        string(input.Kind),
+		CreatedAt:   time.Now().UTC(),
+	}
+	if err = q.WalletInsertKind(ctx, newWallet); err != nil {
 		return nil, fmt.Errorf("cannot crate new wallet: %w", err)
 	}
 
-	wallets, err := q.WalletsByUser(ctx, user.ID)
+	event := "created with currency " + newWallet.Currency
+	if input.Kind != model.WalletKindCash {
+		event = fmt.Sprintf("created %s wallet with currency %s", newWallet.Kind, newWallet.Currency)
+	}
+	if err = q.Audit(ctx, user.Email, auditWallet, newWallet.ID, event); err != nil {
+		return nil, err
+	}
+
+	if err = seedCategories(ctx, q, user.ID); err != nil {
+		return nil, err
+	}
+
+	wallets, err := q.WalletList(ctx, dao.NilStr(user.ID), &dao.Page{})
 	if err != nil {
 		return nil, fmt.Errorf("cannot list user wallets: %w", err)
 	}
@@ -52,18 +95,448 @@ func (r *mutationResolver) CreateWallet(ctx context.Context, input model.CreateW
 	return wallets, q.Commit(ctx)
 }
 
+// CreateExpense is the resolver for the createExpense field.
+func (r *mutationResolver) CreateExpense(ctx context.Context, input model.CreateExpenseInput) (*dao.Expense, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create expense: %w", err)
+	}
+	defer rollBacker()
+
+	wallet, err := userWallet(ctx, q, user, input.WalletID, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryID, err := userCategoryRef(ctx, q, user, input.CategoryID.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := time.Now().UTC()
+	if t := input.CreatedAt.Value(); t != nil {
+		createdAt = t.UTC()
+	}
+
+	newExpense := &dao.ExpenseInsertParams{
+		ID:          shortuuid.New(),
+		WalletID:    input.WalletID,
+		Amount:      money.RoundIn(input.Amount, wallet.Currency),
+		Description: dao.NilStrPtr(input.Description.Value()),
+		CategoryID:  categoryID,
+		CreatedAt:   createdAt,
+	}
+	if err = q.ExpenseInsert(ctx, newExpense); err != nil {
+		return nil, fmt.Errorf("cannot create expense: %w", err)
+	}
+
+	if err = q.UpdateBalance(ctx, newExpense.WalletID, newExpense.Amount, newExpense.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	event := fmt.Sprintf("created in wallet %s with amount %s", newExpense.WalletID, newExpense.Amount)
+	if err = q.Audit(ctx, user.Email, auditExpense, newExpense.ID, event); err != nil {
+		return nil, err
+	}
+
+	expense, err := q.ExpenseGetByID(ctx, newExpense.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read created expense: %w", err)
+	}
+
+	return expense, q.Commit(ctx)
+}
+
+// UpdateExpense is the resolver for the updateExpense field.
+func (r *mutationResolver) UpdateExpense(ctx context.Context, id string, input model.UpdateExpenseInput) (*dao.Expense, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot update expense: %w", err)
+	}
+	defer rollBacker()
+
+	expense, err := userExpense(ctx, q, user, id, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := expense.Amount
+	if input.Amount.IsSet() && input.Amount.Value() != nil {
+		if amount, err = walletAmount(ctx, q, expense.WalletID, *input.Amount.Value()); err != nil {
+			return nil, err
+		}
+	}
+	// Repayments of a debt must remain valid, as its status is computed from them.
+	if expense.DebtID.Valid && amount != expense.Amount {
+		if err = checkLinkedRepayment(ctx, q, expense, amount); err != nil {
+			return nil, err
+		}
+	}
+	description := expense.Description
+	if input.Description.IsSet() {
+		description = dao.NilStrPtr(input.Description.Value())
+	}
+	categoryID := expense.CategoryID
+	if input.CategoryID.IsSet() {
+		if categoryID, err = userCategoryRef(ctx, q, user, input.CategoryID.Value()); err != nil {
+			return nil, err
+		}
+	}
+	createdAt := expense.CreatedAt
+	if input.CreatedAt.IsSet() && input.CreatedAt.Value() != nil {
+		createdAt = input.CreatedAt.Value().UTC()
+	}
+
+	err = q.ExpenseUpdate(ctx, &dao.ExpenseUpdateParams{
+		Amount:      amount,
+		Description: description,
+		CategoryID:  categoryID,
+		CreatedAt:   createdAt,
+		ID:          expense.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot update expense: %w", err)
+	}
+
+	if delta := amount - expense.Amount; delta != 0 || !createdAt.Equal(expense.CreatedAt) {
+		if err = q.UpdateBalance(ctx, expense.WalletID, delta, earliest(createdAt, expense.CreatedAt)); err != nil {
+			return nil, err
+		}
+	}
+
+	event := fmt.Sprintf("updated with amount %s, was %s", amount, expense.Amount)
+	if err = q.Audit(ctx, user.Email, auditExpense, expense.ID, event); err != nil {
+		return nil, err
+	}
+
+	expense, err = q.ExpenseGetByID(ctx, expense.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read updated expense: %w", err)
+	}
+
+	return expense, q.Commit(ctx)
+}
+
+// DeleteExpense is the resolver for the deleteExpense field.
+func (r *mutationResolver) DeleteExpense(ctx context.Context, id string) (*dao.Expense, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot delete expense: %w", err)
+	}
+	defer rollBacker()
+
+	expense, err := userExpense(ctx, q, user, id, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = q.ExpenseTagDeleteByExpense(ctx, expense.ID); err != nil {
+		return nil, fmt.Errorf("cannot remove expense tags: %w", err)
+	}
+
+	if err = q.ExpenseDelete(ctx, expense.ID); err != nil {
+		return nil, fmt.Errorf("cannot delete expense: %w", err)
+	}
+
+	if err = q.UpdateBalance(ctx, expense.WalletID, expense.Amount.Neg(), expense.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	event := fmt.Sprintf("deleted from wallet %s with amount %s", expense.WalletID, expense.Amount)
+	if err = q.Audit(ctx, user.Email, auditExpense, expense.ID, event); err != nil {
+		return nil, err
+	}
+
+	return expense, q.Commit(ctx)
+}
+
+// CreateIncome is the resolver for the createIncome field.
+func (r *mutationResolver) CreateIncome(ctx context.Context, input model.CreateIncomeInput) (*dao.Income, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create income: %w", err)
+	}
+	defer rollBacker()
+
+	wallet, err := userWallet(ctx, q, user, input.WalletID, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := money.RoundIn(input.Amount, wallet.Currency)
+	if !amount.IsPositive() {
+		return nil, ErrIncomeNotPositive
+	}
+
+	createdAt := time.Now().UTC()
+	if t := input.CreatedAt.Value(); t != nil {
+		createdAt = t.UTC()
+	}
+
+	newIncome := &dao.IncomeInsertParams{
+		ID:          shortuuid.New(),
+		WalletID:    input.WalletID,
+		Amount:      amount,
+		Description: dao.NilStrPtr(input.Description.Value()),
+		CreatedAt:   createdAt,
+	}
+	if err = q.IncomeInsert(ctx, newIncome); err != nil {
+		return nil, fmt.Errorf("cannot create income: %w", err)
+	}
+
+	if err = q.UpdateBalance(ctx, newIncome.WalletID, newIncome.Amount, newIncome.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	event := fmt.Sprintf("created in wallet %s with amount %s", newIncome.WalletID, newIncome.Amount)
+	if err = q.Audit(ctx, user.Email, auditIncome, newIncome.ID, event); err != nil {
+		return nil, err
+	}
+
+	income, err := q.IncomeGetByID(ctx, newIncome.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read created income: %w", err)
+	}
+
+	return income, q.Commit(ctx)
+}
+
+// UpdateIncome is the resolver for the updateIncome field.
+func (r *mutationResolver) UpdateIncome(ctx context.Context, id string, input model.UpdateIncomeInput) (*dao.Income, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot update income: %w", err)
+	}
+	defer rollBacker()
+
+	income, err := userIncome(ctx, q, user, id, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := income.Amount
+	if input.Amount.IsSet() && input.Amount.Value() != nil {
+		if amount, err = walletAmount(ctx, q, income.WalletID, *input.Amount.Value()); err != nil {
+			return nil, err
+		}
+	}
+	if !amount.IsPositive() {
+		return nil, ErrIncomeNotPositive
+	}
+	description := income.Description
+	if input.Description.IsSet() {
+		description = dao.NilStrPtr(input.Description.Value())
+	}
+	createdAt := income.CreatedAt
+	if input.CreatedAt.IsSet() && input.CreatedAt.Value() != nil {
+		createdAt = input.CreatedAt.Value().UTC()
+	}
+
+	if err = q.IncomeUpdate(ctx, amount, description, createdAt, income.ID); err != nil {
+		return nil, fmt.Errorf("cannot update income: %w", err)
+	}
+
+	if delta := amount - income.Amount; delta != 0 || !createdAt.Equal(income.CreatedAt) {
+		if err = q.UpdateBalance(ctx, income.WalletID, delta, earliest(createdAt, income.CreatedAt)); err != nil {
+			return nil, err
+		}
+	}
+
+	event := fmt.Sprintf("updated with amount %s, was %s", amount, income.Amount)
+	if err = q.Audit(ctx, user.Email, auditIncome, income.ID, event); err != nil {
+		return nil, err
+	}
+
+	income, err = q.IncomeGetByID(ctx, income.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read updated income: %w", err)
+	}
+
+	return income, q.Commit(ctx)
+}
+
+// DeleteIncome is the resolver for the deleteIncome field.
+func (r *mutationResolver) DeleteIncome(ctx context.Context, id string) (*dao.Income, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot delete income: %w", err)
+	}
+	defer rollBacker()
+
+	income, err := userIncome(ctx, q, user, id, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = q.IncomeDelete(ctx, income.ID); err != nil {
+		return nil, fmt.Errorf("cannot delete income: %w", err)
+	}
+
+	if err = q.UpdateBalance(ctx, income.WalletID, income.Amount.Neg(), income.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	event := fmt.Sprintf("deleted from wallet %s with amount %s", income.WalletID, income.Amount)
+	if err = q.Audit(ctx, user.Email, auditIncome, income.ID, event); err != nil {
+		return nil, err
+	}
+
+	return income, q.Commit(ctx)
+}
+
+// CreateTransfer is the resolver for the createTransfer field.
+func (r *mutationResolver) CreateTransfer(ctx context.Context, fromWalletID string, toWalletID string, amount money.Decimal, rate *float64, description *string) ([]*dao.Transfer, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	if fromWalletID == toWalletID {
+		return nil, ErrTransferSameWallet
+	}
+
+	q, rollBacker, err := r.Dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create transfer: %w", err)
+	}
+	defer rollBacker()
+
+	fromWallet, err := userWallet(ctx, q, user, fromWalletID, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	toWallet, err := userWallet(ctx, q, user, toWalletID, model.WalletAccessEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	amount = money.RoundIn(amount, fromWallet.Currency)
+	if !amount.IsPositive() {
+		return nil, ErrTransferNotPositive
+	}
+
+	exchangeRate, err := transferRate(fromWallet, toWallet, rate)
+	if err != nil {
+		return nil, err
+	}
+
+	transferID := shortuuid.New()
+	createdAt := time.Now().UTC()
+	sides := []*dao.TransferInsertParams{
+		{
+			ID:                  shortuuid.New(),
+			TransferID:          transferID,
+			WalletID:            fromWallet.ID,
+			CounterpartWalletID: toWallet.ID,
+			Amount:              amount.Neg(),
+			Rate:                exchangeRate,
+			Description:         dao.NilStrPtr(description),
+			CreatedAt:           createdAt,
+		},
+		{
+			ID:                  shortuuid.New(),
+			TransferID:          transferID,
+			WalletID:            toWallet.ID,
+			CounterpartWalletID: fromWallet.ID,
+			Amount:              exchange.Convert(amount, exchangeRate, toWallet.Currency),
+			Rate:                exchangeRate,
+			Description:         dao.NilStrPtr(description),
+			CreatedAt:           createdAt,
+		},
+	}
+	for _, side := range sides {
+		if err = q.TransferInsert(ctx, side); err != nil {
+			return nil, fmt.Errorf("cannot create transfer: %w", err)
+		}
+
+		if err = q.UpdateBalance(ctx, side.WalletID, side.Amount, side.CreatedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	event := fmt.Sprintf("created from wallet %s to wallet %s with amount %s at rate %g",
+		fromWallet.ID, toWallet.ID, amount, exchangeRate)
+	if err = q.Audit(ctx, user.Email, auditTransfer, transferID, event); err != nil {
+		return nil, err
+	}
+
+	transfers, err := q.TransferListByTransferID(ctx, transferID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read created transfer: %w", err)
+	}
+
+	return transfers, q.Commit(ctx)
+}
+
 // ListWallets is the resolver for the listWallets field.
-func (r *queryResolver) ListWallets(ctx context.Context) ([]*dao.Wallet, error) {
+func (r *queryResolver) ListWallets(ctx context.Context, first *int, after *string, last *int, before *string) (*model.WalletConnection, error) {
 	user := auth.GetCtxUser(ctx)
 	if user == nil {
 		return nil, auth.ErrNotAuthorized
 	}
 
+	page, err := newPage(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := dao.NilStr(user.ID)
 	if user.Roles.Has(auth.RoleAdmin) {
-		return r.Dao.WalletsByAdmin(ctx)
+		userID = sql.NullString{}
+	}
+
+	wallets, err := r.Dao.WalletList(ctx, userID, page)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list wallets: %w", err)
 	}
 
-	return r.Dao.WalletsByUser(ctx, user.ID)
+	count, err := r.Dao.WalletCount(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot count wallets: %w", err)
+	}
+
+	wallets, info := pageInfo(wallets, page, walletCursor)
+	connection := &model.WalletConnection{
+		Edges:      make([]*model.WalletEdge, len(wallets)),
+		PageInfo:   info,
+		TotalCount: int(count),
+	}
+	for i, wallet := range wallets {
+		connection.Edges[i] = &model.WalletEdge{Node: wallet, Cursor: encodeCursor(walletCursor(wallet))}
+	}
+
+	return connection, nil
 }
 
 // ListWalletsByUserID is the resolver for the listWalletsByUserId field.
@@ -72,13 +545,48 @@ func (r *queryResolver) ListWalletsByUserID(ctx context.Context, userID string)
 }
 
 // ListExpenses is the resolver for the listExpenses field.
-func (r *queryResolver) ListExpenses(ctx context.Context, walletID string) ([]*dao.Expense, error) {
+func (r *queryResolver) ListExpenses(ctx context.Context, walletID string, filter *model.ExpenseFilter, orderBy *model.ExpenseOrder, first *int, after *string, last *int, before *string) (*model.ExpenseConnection, error) {
 	user := auth.GetCtxUser(ctx)
 	if user == nil {
 		return nil, auth.ErrNotAuthorized
 	}
 
-	return r.Dao.ExpenseListByWalletByUser(ctx, walletID, user.ID)
+	page, err := newPage(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = userWallet(ctx, r.Dao, user, walletID, model.WalletAccessViewer); err != nil {
+		return nil, err
+	}
+
+	arg, err := expenseListParams(walletID, filter, orderBy)
+	if err != nil {
+		return nil, err
+	}
+	arg.TagUserID = user.ID
+
+	expenses, err := r.Dao.ExpenseList(ctx, arg, page)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := r.Dao.ExpenseCount(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, info := pageInfo(expenses, page, expenseCursor)
+	connection := &model.ExpenseConnection{
+		Edges:      make([]*model.ExpenseEdge, len(expenses)),
+		PageInfo:   info,
+		TotalCount: int(count),
+	}
+	for i, expense := range expenses {
+		connection.Edges[i] = &model.ExpenseEdge{Node: expense, Cursor: encodeCursor(expenseCursor(expense))}
+	}
+
+	return connection, nil
 }
 
 // ListExpensesByUserID is the resolver for the listExpensesByUserId field.
@@ -86,7 +594,87 @@ func (r *queryResolver) ListExpensesByUserID(ctx context.Context, userID string,
 	return r.Dao.ExpenseListByWalletByUser(ctx, walletID, userID)
 }
 
+// ListOperations is the resolver for the listOperations field.
+func (r *queryResolver) ListOperations(ctx context.Context, walletID string) ([]model.Operation, error) {
+	user := auth.GetCtxUser(ctx)
+	if user == nil {
+		return nil, auth.ErrNotAuthorized
+	}
+
+	if _, err := userWallet(ctx, r.Dao, user, walletID, model.WalletAccessViewer); err != nil {
+		return nil, err
+	}
+
+	expenses, err := r.Dao.ExpenseListByWallet(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list expenses: %w", err)
+	}
+
+	incomes, err := r.Dao.IncomeListByWallet(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list incomes: %w", err)
+	}
+
+	transfers, err := r.Dao.TransferListByWallet(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list transfers: %w", err)
+	}
+
+	trades, err := r.Dao.TradeListByWallet(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list trades: %w", err)
+	}
+
+	operations := make([]model.Operation, 0, len(expenses)+len(incomes)+len(transfers)+len(trades))
+	for _, expense := range expenses {
+		operations = append(operations, expense)
+	}
+	for _, income := range incomes {
+		operations = append(operations, income)
+	}
+	for _, transfer := range transfers {
+		operations = append(operations, transfer)
+	}
+	for _, trade := range trades {
+		operations = append(operations, trade)
+	}
+
+	return sortOperations(operations), nil
+}
+
+// Description is the resolver for the description field.
+func (r *transferResolver) Description(ctx context.Context, obj *dao.Transfer) (*string, error) {
+	return obj.GetDescription(), nil
+}
+
+// Kind is the resolver for the kind field.
+func (r *walletResolver) Kind(ctx context.Context, obj *dao.Wallet) (model.WalletKind, error) {
+	return model.WalletKind(obj.Kind), nil
+}
+
+// Balance is the resolver for the balance field.
+func (r *walletResolver) Balance(ctx context.Context, obj *dao.Wallet, inCurrency *string) (money.Decimal, error) {
+	currency, err := lookupOptionalCurrency(inCurrency)
+	if err != nil || currency == nil {
+		return obj.Balance, err
+	}
+
+	return exchange.NewConverter(r.Dao).Convert(ctx, obj.Balance, obj.Currency, *currency, time.Now())
+}
+
 // Expense returns ExpenseResolver implementation.
 func (r *Resolver) Expense() ExpenseResolver { return &expenseResolver{r} }
 
+// Income returns IncomeResolver implementation.
+func (r *Resolver) Income() IncomeResolver { return &incomeResolver{r} }
+
+// Transfer returns TransferResolver implementation.
+func (r *Resolver) Transfer() TransferResolver { return &transferResolver{r} }
+
+// Wallet returns WalletResolver implementation.
+func (r *Resolver) Wallet() WalletResolver { return &walletResolver{r} }
+
 type expenseResolver struct{ *Resolver }
+type incomeResolver struct{ *Resolver }
+type transferResolver struct{ *Resolver }
+type walletResolver struct{ *Resolver }
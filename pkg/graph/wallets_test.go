@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/graph/model"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// assertBalance checks that the stored balance of a wallet equals the sum of its operations.
+func assertBalance(t *testing.T, d *dao.DAO, walletID, want string) {
+	t.Helper()
+	ctx := context.Background()
+
+	wallet, err := d.WalletGetByID(ctx, walletID)
+	require.Nil(t, err)
+	assert.Equal(t, money.MustParse(want), wallet.Balance)
+
+	sum, err := d.OperationSum(ctx, walletID, nil, nil)
+	require.Nil(t, err)
+	assert.Equal(t, wallet.Balance, sum, "balance must equal the sum of operations")
+}
+
+func TestExpenseBalance(t *testing.T) {
+	d := daotest.New(t)
+	user := &auth.User{ID: "u1", Email: "one@example.com"}
+	ctx := context.WithValue(context.Background(), auth.CtxUserKey, user)
+	m := (&Resolver{Dao: d}).Mutation()
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: user.ID, Currency: "PLN", CreatedAt: time.Now().UTC()}))
+
+	expense, err := m.CreateExpense(ctx, model.CreateExpenseInput{WalletID: "w1", Amount: money.MustParse("-12.5")})
+	require.Nil(t, err)
+	assertBalance(t, d, "w1", "-12.5")
+
+	amount := money.MustParse("-20.255")
+	_, err = m.UpdateExpense(ctx, expense.ID, model.UpdateExpenseInput{Amount: graphql.OmittableOf(&amount)})
+	require.Nil(t, err)
+	assertBalance(t, d, "w1", "-20.26")
+
+	_, err = m.DeleteExpense(ctx, expense.ID)
+	require.Nil(t, err)
+	assertBalance(t, d, "w1", "0")
+
+	// Failed changes leave the balance untouched.
+	_, err = m.DeleteExpense(ctx, expense.ID)
+	assert.ErrorIs(t, err, ErrExpenseNotFound)
+	assertBalance(t, d, "w1", "0")
+}
+
+func TestCreateTransferBalance(t *testing.T) {
+	d := daotest.New(t)
+	user := &auth.User{ID: "u1", Email: "one@example.com"}
+	ctx := context.WithValue(context.Background(), auth.CtxUserKey, user)
+	m := (&Resolver{Dao: d}).Mutation()
+	now := time.Now().UTC()
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "eur", UserID: user.ID, Currency: "EUR", Balance: money.FromInt(100), CreatedAt: now}))
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "pln", UserID: user.ID, Currency: "PLN", CreatedAt: now}))
+	require.Nil(t, d.IncomeInsert(ctx, &dao.IncomeInsertParams{ID: "i1", WalletID: "eur", Amount: money.FromInt(100), CreatedAt: now}))
+
+	rate := 4.3
+	_, err := m.CreateTransfer(ctx, "eur", "pln", money.MustParse("10.5"), &rate, nil)
+	require.Nil(t, err)
+	assertBalance(t, d, "eur", "89.5")
+	assertBalance(t, d, "pln", "45.15")
+
+	_, err = m.CreateTransfer(ctx, "eur", "pln", money.FromInt(-1), &rate, nil)
+	assert.ErrorIs(t, err, ErrTransferNotPositive)
+	assertBalance(t, d, "eur", "89.5")
+	assertBalance(t, d, "pln", "45.15")
+}
+
+func TestCreateWalletLists(t *testing.T) {
+	d := daotest.New(t)
+	user := &auth.User{ID: "former", Email: "former@example.com"}
+	ctx := context.WithValue(context.Background(), auth.CtxUserKey, user)
+	now := time.Now().UTC()
+	// The user created a wallet of a household and has left it since.
+	require.Nil(t, d.HouseholdInsert(ctx, "h1", "Family", now))
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{
+		ID: "household", UserID: user.ID, HouseholdID: dao.NilStr("h1"), Currency: "PLN", CreatedAt: now,
+	}))
+
+	wallets, err := (&Resolver{Dao: d}).Mutation().CreateWallet(ctx, model.CreateWalletInput{Currency: "EUR", Kind: model.WalletKindCash})
+	require.Nil(t, err)
+	require.Len(t, wallets, 1, "household wallets are listed to members only")
+	assert.Equal(t, "EUR", wallets[0].Currency)
+}
@@ -0,0 +1,285 @@
+package importer
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"io"
+	"strings"
+	"time"
+)
+
+// Camt053 returns a Reader of an ISO 20022 camt.053 bank to customer statement. Entries are read as they are decoded,
+// so statements of any size are read in constant memory. Debit entries are read as expenses, credit entries as
+// incomes, and entries not booked yet are skipped.
+func (i *Importer) Camt053(r io.Reader) (Reader, error) {
+	return &camtReader{decoder: xml.NewDecoder(r), ids: newIDDeriver("camt")}, nil
+}
+
+type camtReader struct {
+	decoder    *xml.Decoder
+	started    bool
+	statements []*Statement
+	current    *Statement
+	ids        *idDeriver
+}
+
+// Element names are matched without namespaces, which differ between versions of camt.053.
+
+type camtAmount struct {
+	Value string `xml:",chardata"`
+	Ccy   string `xml:"Ccy,attr"`
+}
+
+type camtDate struct {
+	Dt   string `xml:"Dt"`
+	DtTm string `xml:"DtTm"`
+}
+
+type camtBalance struct {
+	Code      string     `xml:"Tp>CdOrPrtry>Cd"`
+	Amt       camtAmount `xml:"Amt"`
+	CdtDbtInd string     `xml:"CdtDbtInd"`
+}
+
+type camtParty struct {
+	// Nm is nested in Pty since camt.053.001.08.
+	Nm    string `xml:"Nm"`
+	PtyNm string `xml:"Pty>Nm"`
+}
+
+func (p camtParty) name() string {
+	if p.Nm != "" {
+		return p.Nm
+	}
+	return p.PtyNm
+}
+
+type camtTransaction struct {
+	AcctSvcrRef string    `xml:"Refs>AcctSvcrRef"`
+	EndToEndID  string    `xml:"Refs>EndToEndId"`
+	Cdtr        camtParty `xml:"RltdPties>Cdtr"`
+	Dbtr        camtParty `xml:"RltdPties>Dbtr"`
+	Ustrd       []string  `xml:"RmtInf>Ustrd"`
+	AddtlTxInf  string    `xml:"AddtlTxInf"`
+}
+
+// camtStatus holds the status of an entry as text, or in Cd since camt.053.001.08.
+type camtStatus struct {
+	Text string `xml:",chardata"`
+	Cd   string `xml:"Cd"`
+}
+
+type camtEntry struct {
+	NtryRef      string            `xml:"NtryRef"`
+	Amt          camtAmount        `xml:"Amt"`
+	CdtDbtInd    string            `xml:"CdtDbtInd"`
+	Sts          camtStatus        `xml:"Sts"`
+	BookgDt      camtDate          `xml:"BookgDt"`
+	ValDt        camtDate          `xml:"ValDt"`
+	AcctSvcrRef  string            `xml:"AcctSvcrRef"`
+	AddtlNtryInf string            `xml:"AddtlNtryInf"`
+	TxDtls       []camtTransaction `xml:"NtryDtls>TxDtls"`
+}
+
+func (r *camtReader) Statements() []*Statement {
+	return r.statements
+}
+
+func (r *camtReader) Read() (*Record, error) {
+	for {
+		token, err := r.decoder.Token()
+		if errors.Is(err, io.EOF) {
+			if !r.started {
+				return nil, fmt.Errorf("%w: Document element is missing", ErrInvalidFile)
+			}
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if !r.started {
+			if start.Name.Local != "Document" {
+				return nil, fmt.Errorf("%w: expected Document element, got %s", ErrInvalidFile, start.Name.Local)
+			}
+			r.started = true
+			continue
+		}
+
+		switch start.Name.Local {
+		case "Stmt":
+			r.current = &Statement{}
+			r.statements = append(r.statements, r.current)
+		case "Id":
+			// Statement Id precedes its account, whose identification is nested deeper.
+			if r.current != nil && r.current.ID == "" {
+				if err = r.decoder.DecodeElement(&r.current.ID, &start); err != nil {
+					return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+				}
+			}
+		case "Bal":
+			if err = r.balance(&start); err != nil {
+				return nil, err
+			}
+		case "Ntry":
+			line, _ := r.decoder.InputPos()
+			var entry camtEntry
+			if err = r.decoder.DecodeElement(&entry, &start); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+			}
+			record, err := r.record(&entry)
+			if err != nil {
+				return nil, &RowError{Line: line, Err: err}
+			}
+			if record == nil {
+				return nil, errSkipRow
+			}
+			record.Line = line
+			return record, nil
+		}
+	}
+}
+
+// balance reads an opening or a closing balance of the current statement.
+func (r *camtReader) balance(start *xml.StartElement) error {
+	var bal camtBalance
+	if err := r.decoder.DecodeElement(&bal, start); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+	if r.current == nil {
+		return nil
+	}
+
+	amount, err := camtSigned(bal.Amt.Value, bal.CdtDbtInd)
+	if err != nil {
+		return fmt.Errorf("%w: balance %s: %w", ErrInvalidFile, bal.Code, err)
+	}
+
+	switch bal.Code {
+	// Previously closed booked balance stands for the opening one in some statements.
+	case "OPBD", "PRCD":
+		if r.current.Opening == nil || bal.Code == "OPBD" {
+			r.current.Opening = &amount
+		}
+	case "CLBD":
+		r.current.Closing = &amount
+	default:
+		return nil
+	}
+	if r.current.Currency == "" {
+		r.current.Currency = bal.Amt.Ccy
+	}
+
+	return nil
+}
+
+// record converts a booked entry to a Record. Entries which are not booked give nil.
+func (r *camtReader) record(entry *camtEntry) (*Record, error) {
+	if status := entry.Sts.Cd + strings.TrimSpace(entry.Sts.Text); status != "BOOK" {
+		return nil, nil
+	}
+
+	amount, err := camtSigned(entry.Amt.Value, entry.CdtDbtInd)
+	if err != nil {
+		return nil, err
+	}
+	if r.current != nil {
+		r.current.Booked += amount
+		if r.current.Currency != "" && entry.Amt.Ccy != "" && entry.Amt.Ccy != r.current.Currency {
+			return nil, fmt.Errorf("entry currency %s differs from statement currency %s", entry.Amt.Ccy, r.current.Currency)
+		}
+	}
+
+	date := entry.BookgDt
+	if date.Dt == "" && date.DtTm == "" {
+		date = entry.ValDt
+	}
+	at, err := parseCamtDate(date)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx camtTransaction
+	if len(entry.TxDtls) > 0 {
+		tx = entry.TxDtls[0]
+	}
+
+	// The counterparty is the creditor of money going out and the debtor of money coming in.
+	party := tx.Cdtr.name()
+	if amount > 0 {
+		party = tx.Dbtr.name()
+	}
+	description := strings.Join(append([]string{party}, tx.Ustrd...), " ")
+	if strings.TrimSpace(description) == "" {
+		description = tx.AddtlTxInf
+	}
+	if description == "" {
+		description = entry.AddtlNtryInf
+	}
+
+	id := firstOf(entry.AcctSvcrRef, tx.AcctSvcrRef, entry.NtryRef)
+	if id == "" && tx.EndToEndID != "NOTPROVIDED" {
+		id = tx.EndToEndID
+	}
+	if id == "" {
+		id = r.ids.id(at.Format(time.RFC3339), amount.String(), description)
+	}
+
+	return &Record{
+		Date:        at,
+		Amount:      amount,
+		Description: strings.Join(strings.Fields(description), " "),
+		ExternalID:  id,
+		Income:      amount > 0,
+	}, nil
+}
+
+// camtSigned reads an amount, which is negative when marked as debit.
+func camtSigned(value, creditDebit string) (money.Decimal, error) {
+	amount, err := money.Parse(strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+
+	switch creditDebit {
+	case "DBIT":
+		return amount.Neg(), nil
+	case "CRDT":
+		return amount, nil
+	default:
+		return 0, fmt.Errorf("invalid credit or debit indicator %q", creditDebit)
+	}
+}
+
+// parseCamtDate reads an ISO date or datetime. Datetimes without offset are in UTC.
+func parseCamtDate(d camtDate) (time.Time, error) {
+	if d.Dt != "" {
+		t, err := time.Parse(time.DateOnly, d.Dt)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date %q", d.Dt)
+		}
+		return t, nil
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"} {
+		if t, err := time.Parse(layout, d.DtTm); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", d.DtTm)
+}
+
+func firstOf(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+package importer
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+const camtStatement = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">
+  <BkToCstmrStmt>
+    <GrpHdr><MsgId>MSG1</MsgId><CreDtTm>2024-03-04T08:00:00</CreDtTm></GrpHdr>
+    <Stmt>
+      <Id>STMT-2024-03</Id>
+      <Acct><Id><IBAN>DE89370400440532013000</IBAN></Id><Ccy>EUR</Ccy></Acct>
+      <Bal>
+        <Tp><CdOrPrtry><Cd>OPBD</Cd></CdOrPrtry></Tp>
+        <Amt Ccy="EUR">100.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Dt><Dt>2024-03-01</Dt></Dt>
+      </Bal>
+      <Bal>
+        <Tp><CdOrPrtry><Cd>CLBD</Cd></CdOrPrtry></Tp>
+        <Amt Ccy="EUR">1087.50</Amt><CdtDbtInd>CRDT</CdtDbtInd><Dt><Dt>2024-03-03</Dt></Dt>
+      </Bal>
+      <Ntry>
+        <Amt Ccy="EUR">12.50</Amt><CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><Dt>2024-03-01</Dt></BookgDt>
+        <AcctSvcrRef>REF1</AcctSvcrRef>
+        <NtryDtls><TxDtls>
+          <RltdPties><Cdtr><Pty><Nm>Coffee Shop</Nm></Pty></Cdtr></RltdPties>
+          <RmtInf><Ustrd>Card payment</Ustrd></RmtInf>
+        </TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">1000.00</Amt><CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><DtTm>2024-03-02T10:00:00+01:00</DtTm></BookgDt>
+        <NtryDtls><TxDtls>
+          <Refs><EndToEndId>SALARY-03</EndToEndId></Refs>
+          <RltdPties><Dbtr><Nm>Employer</Nm></Dbtr></RltdPties>
+        </TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">5.00</Amt><CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>PDNG</Cd></Sts>
+        <BookgDt><Dt>2024-03-03</Dt></BookgDt>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">1.00</Amt><CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><Dt>03.03.2024</Dt></BookgDt>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>
+`
+
+func TestImporter_Camt053(t *testing.T) {
+	imp := &Importer{conf: &conf.Config{}}
+	r, err := imp.Camt053(strings.NewReader(camtStatement))
+	require.Nil(t, err)
+
+	records, rowErrs, skipped := readAll(t, r)
+	assert.Equal(t, []*Record{
+		{
+			Line:        16,
+			Date:        time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			Amount:      money.MustParse("-12.5"),
+			Description: "Coffee Shop Card payment",
+			ExternalID:  "REF1",
+		},
+		{
+			Line:        26,
+			Date:        time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC),
+			Amount:      money.MustParse("1000"),
+			Description: "Employer",
+			ExternalID:  "SALARY-03",
+			Income:      true,
+		},
+	}, records)
+	assert.Equal(t, []string{`line 40: invalid date "03.03.2024"`}, rowErrs)
+	assert.Equal(t, 1, skipped, "pending entry")
+
+	opening, closing := money.MustParse("100"), money.MustParse("1087.5")
+	assert.Equal(t, []*Statement{{
+		ID:       "STMT-2024-03",
+		Currency: "EUR",
+		Opening:  &opening,
+		Closing:  &closing,
+		Booked:   money.MustParse("988.5"),
+	}}, r.(StatementReader).Statements())
+
+	r, err = imp.Camt053(strings.NewReader("<OFX></OFX>"))
+	require.Nil(t, err)
+	_, err = r.Read()
+	assert.ErrorIs(t, err, ErrInvalidFile)
+}
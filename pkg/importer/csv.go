@@ -0,0 +1,321 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DefaultProfile is used when no profile is named. Unless configured otherwise, it reads statements with a header
+// row naming date, amount and description columns.
+const DefaultProfile = "default"
+
+var defaultCSVProfile = conf.CSVProfile{
+	Header:             true,
+	DateColumn:         "date",
+	AmountColumn:       "amount",
+	DescriptionColumns: []string{"description"},
+}
+
+// CSV returns a Reader of a CSV statement laid out as described by the named profile.
+func (i *Importer) CSV(profileName string, r io.Reader) (Reader, error) {
+	profile, err := i.csvProfile(profileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCSVReader(profile, r)
+}
+
+// csvProfile returns the configured profile of given name, filling in the defaults.
+func (i *Importer) csvProfile(name string) (*conf.CSVProfile, error) {
+	if name == "" {
+		name = DefaultProfile
+	}
+
+	// Configuration keys are case-insensitive, so are profile names.
+	profile, ok := i.conf.Import.CSV[strings.ToLower(name)]
+	switch {
+	case !ok && name == DefaultProfile:
+		profile = defaultCSVProfile
+	case !ok:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProfile, name)
+	}
+
+	if profile.Delimiter == "" {
+		profile.Delimiter = ","
+	}
+	if profile.DateFormat == "" {
+		profile.DateFormat = time.DateOnly
+	}
+	if profile.DecimalSeparator == "" {
+		profile.DecimalSeparator = "."
+	}
+	if profile.Sign == "" {
+		profile.Sign = conf.SignExpensesNegative
+	}
+
+	switch {
+	case utf8.RuneCountInString(profile.Delimiter) != 1:
+		return nil, fmt.Errorf("%w %s: delimiter must be a single character", ErrInvalidProfile, name)
+	case profile.DateColumn == "":
+		return nil, fmt.Errorf("%w %s: date column is required", ErrInvalidProfile, name)
+	case profile.AmountColumn == "" && profile.DebitColumn == "" && profile.CreditColumn == "":
+		return nil, fmt.Errorf("%w %s: amount column or debit and credit columns are required", ErrInvalidProfile, name)
+	case profile.AmountColumn != "" && (profile.DebitColumn != "" || profile.CreditColumn != ""):
+		return nil, fmt.Errorf("%w %s: amount column excludes debit and credit columns", ErrInvalidProfile, name)
+	case profile.Sign != conf.SignExpensesNegative && profile.Sign != conf.SignExpensesPositive:
+		return nil, fmt.Errorf("%w %s: unknown sign convention %s", ErrInvalidProfile, name, profile.Sign)
+	}
+
+	return &profile, nil
+}
+
+// csvReader reads records of a CSV statement. Columns are resolved on first read, after the header row.
+type csvReader struct {
+	profile *conf.CSVProfile
+	csv     *csv.Reader
+	// skipped is the number of lines read before csv.
+	skipped int
+	started bool
+
+	date, amount, debit, credit int
+	description                 []int
+}
+
+func newCSVReader(profile *conf.CSVProfile, r io.Reader) (*csvReader, error) {
+	buf := bufio.NewReader(r)
+	// Spreadsheets often begin UTF-8 files with a byte order mark.
+	if bom, err := buf.Peek(3); err == nil && string(bom) == "\xef\xbb\xbf" {
+		_, _ = buf.Discard(3)
+	}
+
+	skipped := 0
+	for ; skipped < profile.SkipRows; skipped++ {
+		if _, err := buf.ReadString('\n'); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+		}
+	}
+
+	reader := csv.NewReader(buf)
+	reader.Comma, _ = utf8.DecodeRuneInString(profile.Delimiter)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	return &csvReader{
+		profile: profile,
+		csv:     reader,
+		skipped: skipped,
+		date:    -1,
+		amount:  -1,
+		debit:   -1,
+		credit:  -1,
+	}, nil
+}
+
+func (r *csvReader) Read() (*Record, error) {
+	if !r.started {
+		r.started = true
+		if err := r.resolveColumns(); err != nil {
+			return nil, err
+		}
+	}
+
+	row, err := r.csv.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, &RowError{Line: r.skipped + parseErr.Line, Err: parseErr.Err}
+		}
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+
+	if isBlank(row) {
+		return nil, errSkipRow
+	}
+
+	record, err := r.record(row)
+	if err != nil {
+		return nil, &RowError{Line: r.line(), Err: err}
+	}
+	if record == nil {
+		return nil, errSkipRow
+	}
+	record.Line = r.line()
+
+	return record, nil
+}
+
+// line returns the line number of the last row read.
+func (r *csvReader) line() int {
+	line, _ := r.csv.FieldPos(0)
+	return r.skipped + line
+}
+
+// resolveColumns reads the header row, if there is one, and finds the columns of the profile.
+func (r *csvReader) resolveColumns() error {
+	var header []string
+	if r.profile.Header {
+		var err error
+		if header, err = r.csv.Read(); errors.Is(err, io.EOF) {
+			return fmt.Errorf("%w: header row is missing", ErrInvalidFile)
+		} else if err != nil {
+			return fmt.Errorf("%w: cannot read header row: %w", ErrInvalidFile, err)
+		}
+	}
+
+	column := func(name string) (int, error) {
+		if name == "" {
+			return -1, nil
+		}
+		if n, err := strconv.Atoi(name); err == nil && n > 0 {
+			return n - 1, nil
+		}
+		for i, h := range header {
+			if strings.EqualFold(strings.TrimSpace(h), name) {
+				return i, nil
+			}
+		}
+		return -1, fmt.Errorf("%w: column %s not found in header row", ErrInvalidFile, name)
+	}
+
+	var err error
+	if r.date, err = column(r.profile.DateColumn); err != nil {
+		return err
+	}
+	if r.amount, err = column(r.profile.AmountColumn); err != nil {
+		return err
+	}
+	if r.debit, err = column(r.profile.DebitColumn); err != nil {
+		return err
+	}
+	if r.credit, err = column(r.profile.CreditColumn); err != nil {
+		return err
+	}
+	for _, name := range r.profile.DescriptionColumns {
+		i, err := column(name)
+		if err != nil {
+			return err
+		}
+		r.description = append(r.description, i)
+	}
+
+	return nil
+}
+
+// record converts a row to a Record. Rows without amount, or with zero amount, hold no operation and give nil.
+func (r *csvReader) record(row []string) (*Record, error) {
+	field := func(i int) (string, error) {
+		if i < 0 {
+			return "", nil
+		}
+		if i >= len(row) {
+			return "", fmt.Errorf("row has %d columns, expected at least %d", len(row), i+1)
+		}
+		return strings.TrimSpace(row[i]), nil
+	}
+
+	dateField, err := field(r.date)
+	if err != nil {
+		return nil, err
+	}
+	date, err := time.Parse(r.profile.DateFormat, dateField)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q, expected format %s", dateField, r.profile.DateFormat)
+	}
+
+	var amount money.Decimal
+	if r.amount >= 0 {
+		if amount, err = r.parseAmount(field(r.amount)); err != nil {
+			return nil, err
+		}
+		if r.profile.Sign == conf.SignExpensesPositive {
+			amount = amount.Neg()
+		}
+	} else {
+		debit, err := r.parseAmount(field(r.debit))
+		if err != nil {
+			return nil, err
+		}
+		credit, err := r.parseAmount(field(r.credit))
+		if err != nil {
+			return nil, err
+		}
+		amount = abs(credit) - abs(debit)
+	}
+	if amount == 0 {
+		return nil, nil
+	}
+
+	var description []string
+	for _, i := range r.description {
+		s, err := field(i)
+		if err != nil {
+			return nil, err
+		}
+		if s != "" {
+			description = append(description, s)
+		}
+	}
+
+	return &Record{
+		Date:        date.UTC(),
+		Amount:      amount,
+		Description: strings.Join(description, " "),
+	}, nil
+}
+
+// parseAmount reads an amount written with separators of the profile. Empty fields are zero.
+func (r *csvReader) parseAmount(s string, err error) (money.Decimal, error) {
+	if err != nil || s == "" {
+		return 0, err
+	}
+
+	clean := strings.Map(func(c rune) rune {
+		// Spaces, including non-breaking ones, are common thousands separators.
+		if c == ' ' || c == '\u00a0' || c == '\u202f' {
+			return -1
+		}
+		return c
+	}, s)
+	if r.profile.ThousandsSeparator != "" {
+		clean = strings.ReplaceAll(clean, r.profile.ThousandsSeparator, "")
+	}
+	clean = strings.Replace(clean, r.profile.DecimalSeparator, ".", 1)
+
+	amount, err := money.Parse(clean)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+
+	return amount, nil
+}
+
+func isBlank(row []string) bool {
+	for _, field := range row {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(d money.Decimal) money.Decimal {
+	if d < 0 {
+		return d.Neg()
+	}
+	return d
+}
@@ -0,0 +1,156 @@
+package importer
+
+import (
+	"errors"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// readAll reads all records of r, collecting row errors and counting skipped rows.
+func readAll(t *testing.T, r Reader) (records []*Record, rowErrs []string, skipped int) {
+	for {
+		record, err := r.Read()
+		var rowErr *RowError
+		switch {
+		case errors.Is(err, io.EOF):
+			return
+		case errors.Is(err, errSkipRow):
+			skipped++
+		case errors.As(err, &rowErr):
+			rowErrs = append(rowErrs, rowErr.Error())
+		default:
+			require.Nil(t, err)
+			records = append(records, record)
+		}
+	}
+}
+
+func TestImporter_CSV(t *testing.T) {
+	imp := &Importer{conf: &conf.Config{Import: conf.Import{CSV: map[string]conf.CSVProfile{
+		"mbank": {
+			Delimiter:          ";",
+			SkipRows:           2,
+			Header:             true,
+			DateFormat:         "02.01.2006",
+			DateColumn:         "Data operacji",
+			AmountColumn:       "Kwota",
+			DescriptionColumns: []string{"Opis", "Tytuł"},
+			DecimalSeparator:   ",",
+			ThousandsSeparator: ".",
+		},
+		"card": {
+			DateColumn:         "1",
+			AmountColumn:       "3",
+			DescriptionColumns: []string{"2"},
+			Sign:               conf.SignExpensesPositive,
+		},
+		"split": {
+			Header:       true,
+			DateColumn:   "booked",
+			DebitColumn:  "out",
+			CreditColumn: "in",
+		},
+	}}}}
+	day := func(d int) time.Time {
+		return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name        string
+		profile     string
+		in          string
+		wantRecords []*Record
+		wantErrs    []string
+		wantSkipped int
+	}{
+		{
+			name:    "default profile",
+			profile: "",
+			in:      "\xef\xbb\xbfDate,Description,Amount\n2024-03-01,Coffee,-3.50\n\n2024-03-02,Refund,10\n2024-03-03,Nothing,0\n",
+			wantRecords: []*Record{
+				{Line: 2, Date: day(1), Amount: money.MustParse("-3.5"), Description: "Coffee"},
+				{Line: 4, Date: day(2), Amount: money.MustParse("10"), Description: "Refund"},
+			},
+			wantSkipped: 1,
+		},
+		{
+			name:    "separators and skipped rows",
+			profile: "MBank",
+			in: "Account statement\n;\n" +
+				"Data operacji;Opis;Tytuł;Kwota\n" +
+				"01.03.2024;Przelew;Czynsz;-1.234,56\n" +
+				"02.03.2024;Karta;;\"-12,00\"\n" +
+				"31.02.2024;Karta;;-1,00\n" +
+				"03.03.2024;Karta;;abc\n" +
+				"04.03.2024;Karta\n",
+			wantRecords: []*Record{
+				{Line: 4, Date: day(1), Amount: money.MustParse("-1234.56"), Description: "Przelew Czynsz"},
+				{Line: 5, Date: day(2), Amount: money.MustParse("-12"), Description: "Karta"},
+			},
+			wantErrs: []string{
+				`line 6: invalid date "31.02.2024", expected format 02.01.2006`,
+				`line 7: invalid amount "abc"`,
+				`line 8: row has 2 columns, expected at least 4`,
+			},
+		},
+		{
+			name:    "positive expenses without header",
+			profile: "card",
+			in:      "2024-03-05,Groceries,1 020.10\n2024-03-06,Cashback,-5\n",
+			wantRecords: []*Record{
+				{Line: 1, Date: day(5), Amount: money.MustParse("-1020.1"), Description: "Groceries"},
+				{Line: 2, Date: day(6), Amount: money.MustParse("5"), Description: "Cashback"},
+			},
+		},
+		{
+			name:    "debit and credit columns",
+			profile: "split",
+			in:      "booked,out,in\n2024-03-07,20,\n2024-03-08,,-30\n2024-03-09,,\n",
+			wantRecords: []*Record{
+				{Line: 2, Date: day(7), Amount: money.MustParse("-20")},
+				{Line: 3, Date: day(8), Amount: money.MustParse("30")},
+			},
+			wantSkipped: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := imp.CSV(tt.profile, strings.NewReader(tt.in))
+			require.Nil(t, err)
+
+			records, rowErrs, skipped := readAll(t, r)
+			assert.Equal(t, tt.wantRecords, records)
+			assert.Equal(t, tt.wantErrs, rowErrs)
+			assert.Equal(t, tt.wantSkipped, skipped)
+		})
+	}
+}
+
+func TestImporter_CSVErrors(t *testing.T) {
+	imp := &Importer{conf: &conf.Config{Import: conf.Import{CSV: map[string]conf.CSVProfile{
+		"nodate":   {AmountColumn: "1"},
+		"both":     {DateColumn: "1", AmountColumn: "2", DebitColumn: "3"},
+		"sign":     {DateColumn: "1", AmountColumn: "2", Sign: "inverted"},
+		"tab":      {DateColumn: "1", AmountColumn: "2", Delimiter: "tab"},
+		"headless": {Header: true, DateColumn: "when", AmountColumn: "amount"},
+	}}}}
+
+	for _, profile := range []string{"nodate", "both", "sign", "tab"} {
+		_, err := imp.CSV(profile, strings.NewReader(""))
+		assert.ErrorIs(t, err, ErrInvalidProfile, profile)
+	}
+
+	_, err := imp.CSV("missing", strings.NewReader(""))
+	assert.ErrorIs(t, err, ErrUnknownProfile)
+
+	r, err := imp.CSV("headless", strings.NewReader("date,amount\n"))
+	require.Nil(t, err)
+	_, err = r.Read()
+	assert.ErrorIs(t, err, ErrInvalidFile)
+}
@@ -0,0 +1,319 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/piotrekmonko/portfello/pkg/access"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/duplicate"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	ErrWalletAccess   = fmt.Errorf("wallet not found or not editable")
+	ErrUnknownProfile = fmt.Errorf("unknown import profile")
+	ErrInvalidProfile = fmt.Errorf("invalid import profile")
+	ErrInvalidFile    = fmt.Errorf("invalid statement file")
+	errSkipRow        = fmt.Errorf("row holds no operation")
+)
+
+// Record is an operation read from a statement.
+type Record struct {
+	// Line is where the operation starts in the statement.
+	Line        int
+	Date        time.Time
+	Amount      money.Decimal
+	Description string
+	// ExternalID identifies the operation in statements of the same account, such as OFX FITID. Records whose
+	// ExternalID is already present in the wallet are skipped, so importing a statement twice creates no duplicates.
+	ExternalID string
+	// Income records are imported as incomes, others as expenses.
+	Income bool
+}
+
+// Reader reads records of a statement one by one and returns io.EOF after the last one. Rows which cannot be read are
+// reported with a *RowError and rows holding no operation with errSkipRow, reading may continue after both. Any other
+// error means the statement cannot be read at all.
+type Reader interface {
+	Read() (*Record, error)
+}
+
+// RowError reports a statement row which could not be imported.
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Message describes why the row failed.
+func (e *RowError) Message() string {
+	return e.Err.Error()
+}
+
+// Result counts statement rows by the outcome of their import.
+type Result struct {
+	Inserted int
+	Skipped  int
+	Failed   int
+	Errors   []*RowError
+	// Duplicates are records which look like expenses already in the wallet, they are not imported unless allowed.
+	Duplicates []*Duplicate
+	// Reconciliation is set for statements reporting their balances.
+	Reconciliation *Reconciliation
+}
+
+// Duplicate is a record which may record the same purchase as an expense in the wallet.
+type Duplicate struct {
+	Line      int
+	ExpenseID string
+}
+
+// Importer loads bank statements into wallets as expenses and incomes.
+type Importer struct {
+	conf *conf.Config
+	db   *dao.DAO
+	auth *auth.Service
+}
+
+func NewImporter(c *conf.Config, db *dao.DAO, authService *auth.Service) *Importer {
+	return &Importer{
+		conf: c,
+		db:   db,
+		auth: authService,
+	}
+}
+
+// LookupUser returns the user identified by email, for imports started outside of GraphQL API.
+func (i *Importer) LookupUser(ctx context.Context, email string) (*auth.User, error) {
+	return i.auth.GetUser(ctx, email)
+}
+
+// Import inserts operations read by r into a wallet editable by user and updates its balance, all in one transaction.
+// Rows which cannot be read are counted and reported in Result, any other error rolls back the whole import. Records
+// imported before are skipped. Expenses which look like ones already in the wallet are reported in Result and left
+// out, unless allowDuplicates is set. Statements reporting their balances are reconciled with the wallet.
+func (i *Importer) Import(ctx context.Context, user *auth.User, walletID string, r Reader, allowDuplicates bool) (*Result, error) {
+	q, rollBacker, err := i.db.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot import expenses: %w", err)
+	}
+	defer rollBacker()
+
+	wallet, userAccess, err := access.Wallet(ctx, q, user.ID, walletID)
+	if err != nil && !errors.Is(err, access.ErrWalletNotFound) {
+		return nil, fmt.Errorf("cannot check wallet access: %w", err)
+	}
+	if access.Rank[userAccess] < access.Rank[access.Editor] {
+		return nil, ErrWalletAccess
+	}
+
+	result := &Result{Errors: []*RowError{}, Duplicates: []*Duplicate{}}
+	var (
+		balance    money.Decimal
+		duplicates int
+		// earliest is the time of the earliest inserted operation, balance snapshots taken since do not hold.
+		earliest time.Time
+		// ignored expenses are not compared with records, they were either inserted by this import or matched one.
+		ignored = map[string]bool{}
+	)
+	for {
+		record, err := r.Read()
+		var rowErr *RowError
+		switch {
+		case errors.Is(err, io.EOF):
+			if result.Inserted > 0 {
+				if err = q.UpdateBalance(ctx, walletID, balance, earliest); err != nil {
+					return nil, err
+				}
+			}
+			if statements, ok := r.(StatementReader); ok {
+				result.Reconciliation, err = reconcile(statements.Statements(), wallet, wallet.Balance+balance, duplicates == 0)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return result, q.Commit(ctx)
+		case errors.Is(err, errSkipRow):
+			result.Skipped++
+			continue
+		case errors.As(err, &rowErr):
+			result.Failed++
+			result.Errors = append(result.Errors, rowErr)
+			continue
+		case err != nil:
+			return nil, err
+		}
+		// Statements may have more decimal places than minor units of the wallet currency.
+		record.Amount = money.RoundIn(record.Amount, wallet.Currency)
+
+		if record.ExternalID != "" {
+			imported, err := isImported(ctx, q, walletID, record)
+			if err != nil {
+				return nil, err
+			}
+			if imported {
+				duplicates++
+				result.Skipped++
+				continue
+			}
+		}
+
+		if !record.Income && !allowDuplicates {
+			expenseID, err := findDuplicate(ctx, q, walletID, record, ignored)
+			if err != nil {
+				return nil, err
+			}
+			if expenseID != "" {
+				ignored[expenseID] = true
+				result.Duplicates = append(result.Duplicates, &Duplicate{Line: record.Line, ExpenseID: expenseID})
+				continue
+			}
+		}
+
+		if record.Income {
+			err = insertIncome(ctx, q, user, walletID, record)
+		} else {
+			var expenseID string
+			expenseID, err = insertExpense(ctx, q, user, walletID, record)
+			ignored[expenseID] = true
+		}
+		if err != nil {
+			return nil, err
+		}
+		balance += record.Amount
+		if result.Inserted == 0 || record.Date.Before(earliest) {
+			earliest = record.Date
+		}
+		result.Inserted++
+	}
+}
+
+// isImported reports whether an operation with the external ID of record is already present in wallet.
+func isImported(ctx context.Context, q dao.DBInterface, walletID string, record *Record) (bool, error) {
+	var (
+		count int64
+		err   error
+	)
+	if record.Income {
+		count, err = q.IncomeExternalIDCount(ctx, walletID, dao.NilStr(record.ExternalID))
+	} else {
+		count, err = q.ExpenseExternalIDCount(ctx, walletID, dao.NilStr(record.ExternalID))
+	}
+	if err != nil {
+		return false, fmt.Errorf("cannot check imported operations: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// findDuplicate returns the ID of an expense of wallet which may record the same purchase as record, or an empty string
+// if there is none. Ignored expenses are left out.
+func findDuplicate(ctx context.Context, q dao.DBInterface, walletID string, record *Record, ignored map[string]bool) (string, error) {
+	candidates, err := q.ExpenseListByAmount(ctx, walletID, record.Amount, record.Date.Add(-duplicate.Window), record.Date.Add(duplicate.Window))
+	if err != nil {
+		return "", fmt.Errorf("cannot look for duplicate expenses: %w", err)
+	}
+
+	expense := &dao.Expense{Amount: record.Amount, CreatedAt: record.Date, Description: dao.NilStr(record.Description)}
+	for _, candidate := range candidates {
+		if !ignored[candidate.ID] && duplicate.Match(expense, candidate) {
+			return candidate.ID, nil
+		}
+	}
+
+	return "", nil
+}
+
+// insertExpense creates an expense of record in wallet, records it in history and returns its ID.
+func insertExpense(ctx context.Context, q dao.DBInterface, user *auth.User, walletID string, record *Record) (string, error) {
+	expense := &dao.ExpenseInsertParams{
+		ID:          shortuuid.New(),
+		WalletID:    walletID,
+		Amount:      record.Amount,
+		Description: dao.NilStr(record.Description),
+		CreatedAt:   record.Date,
+		ExternalID:  dao.NilStr(record.ExternalID),
+	}
+	if err := q.ExpenseInsert(ctx, expense); err != nil {
+		return "", fmt.Errorf("cannot import expense: %w", err)
+	}
+
+	return expense.ID, auditImport(ctx, q, user, "expense", expense.ID, walletID, expense.Amount)
+}
+
+// insertIncome creates an income of record in wallet and records it in history.
+func insertIncome(ctx context.Context, q dao.DBInterface, user *auth.User, walletID string, record *Record) error {
+	income := &dao.IncomeInsertParams{
+		ID:          shortuuid.New(),
+		WalletID:    walletID,
+		Amount:      record.Amount,
+		Description: dao.NilStr(record.Description),
+		CreatedAt:   record.Date,
+		ExternalID:  dao.NilStr(record.ExternalID),
+	}
+	if err := q.IncomeInsert(ctx, income); err != nil {
+		return fmt.Errorf("cannot import income: %w", err)
+	}
+
+	return auditImport(ctx, q, user, "income", income.ID, walletID, income.Amount)
+}
+
+// auditImport records in history that user imported an operation into wallet.
+func auditImport(ctx context.Context, q dao.DBInterface, user *auth.User, namespace, reference, walletID string, amount money.Decimal) error {
+	return q.Audit(ctx, user.Email, namespace, reference, fmt.Sprintf("imported into wallet %s with amount %s", walletID, amount))
+}
+
+// readText reads a whole statement. Statements which are not valid UTF-8 are decoded as Latin-1, the usual charset of
+// older OFX and QIF exports.
+func readText(r io.Reader) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if utf8.Valid(data) {
+		return string(data), nil
+	}
+
+	runes := make([]rune, len(data))
+	for i, b := range data {
+		runes[i] = rune(b)
+	}
+	return string(runes), nil
+}
+
+// idDeriver makes external IDs for transactions which have none, from a hash of their fields. Identical transactions
+// of one statement, such as two coffees bought on the same day, are told apart by their order.
+type idDeriver struct {
+	prefix string
+	seen   map[string]int
+}
+
+func newIDDeriver(prefix string) *idDeriver {
+	return &idDeriver{prefix: prefix, seen: map[string]int{}}
+}
+
+func (d *idDeriver) id(fields ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\x1f")))
+	key := hex.EncodeToString(sum[:16])
+	d.seen[key]++
+	return fmt.Sprintf("%s:%s:%d", d.prefix, key, d.seen[key])
+}
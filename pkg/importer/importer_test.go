@@ -0,0 +1,189 @@
+package importer
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/auth"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestImporter_Import(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	imp := &Importer{conf: &conf.Config{}, db: d}
+	owner := &auth.User{ID: "u1", Email: "one@example.com"}
+
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: owner.ID, Currency: "PLN", Balance: money.FromInt(100), CreatedAt: time.Now().UTC()}))
+
+	statement := func() Reader {
+		r, err := imp.CSV("", strings.NewReader("date,amount,description\n2024-03-01,-30,Taxi\n2024-03-02,oops,Bus\n,,\n2024-03-03,5.5,\n"))
+		require.Nil(t, err)
+		return r
+	}
+
+	_, err := imp.Import(ctx, &auth.User{ID: "u2", Email: "two@example.com"}, "w1", statement(), false)
+	assert.ErrorIs(t, err, ErrWalletAccess)
+
+	result, err := imp.Import(ctx, owner, "w1", statement(), false)
+	require.Nil(t, err)
+	assert.Equal(t, 2, result.Inserted)
+	assert.Equal(t, 1, result.Skipped)
+	assert.Equal(t, 1, result.Failed)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, 3, result.Errors[0].Line)
+	assert.Equal(t, `invalid amount "oops"`, result.Errors[0].Message())
+
+	wallet, err := d.WalletGetByID(ctx, "w1")
+	require.Nil(t, err)
+	assert.Equal(t, money.MustParse("75.5"), wallet.Balance)
+
+	expenses, err := d.ExpenseList(ctx, &dao.ExpenseListParams{WalletID: "w1"}, &dao.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Len(t, expenses, 2)
+	assert.Equal(t, "Taxi", expenses[0].Description.String)
+	assert.False(t, expenses[1].Description.Valid)
+
+	// Transactions of OFX statements are imported once.
+	for i := 0; i < 2; i++ {
+		r, err := imp.OFX(strings.NewReader(ofxXML))
+		require.Nil(t, err)
+		result, err = imp.Import(ctx, owner, "w1", r, false)
+		require.Nil(t, err)
+	}
+	assert.Equal(t, 0, result.Inserted)
+	assert.Equal(t, 3, result.Skipped)
+
+	wallet, err = d.WalletGetByID(ctx, "w1")
+	require.Nil(t, err)
+	assert.Equal(t, money.MustParse("69.5"), wallet.Balance)
+}
+
+func TestImporter_ImportRounding(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	imp := &Importer{conf: &conf.Config{}, db: d}
+	owner := &auth.User{ID: "u1", Email: "one@example.com"}
+
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: owner.ID, Currency: "JPY", CreatedAt: time.Now().UTC()}))
+
+	r, err := imp.CSV("", strings.NewReader("date,amount,description\n2024-03-01,-1250.6,Sushi\n2024-03-02,300.4,Refund\n"))
+	require.Nil(t, err)
+	result, err := imp.Import(ctx, owner, "w1", r, false)
+	require.Nil(t, err)
+	assert.Equal(t, 2, result.Inserted)
+
+	// Amounts are rounded to yen, which have no minor units.
+	expenses, err := d.ExpenseList(ctx, &dao.ExpenseListParams{WalletID: "w1"}, &dao.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Len(t, expenses, 2)
+	assert.Equal(t, money.FromInt(-1251), expenses[0].Amount)
+	assert.Equal(t, money.FromInt(300), expenses[1].Amount)
+
+	wallet, err := d.WalletGetByID(ctx, "w1")
+	require.Nil(t, err)
+	assert.Equal(t, money.FromInt(-951), wallet.Balance)
+	sum, err := d.OperationSum(ctx, "w1", nil, nil)
+	require.Nil(t, err)
+	assert.Equal(t, wallet.Balance, sum)
+}
+
+func TestImporter_ImportReconcile(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	imp := &Importer{conf: &conf.Config{}, db: d}
+	owner := &auth.User{ID: "u1", Email: "one@example.com"}
+
+	for _, w := range []*dao.WalletInsertParams{
+		{ID: "eur", UserID: owner.ID, Currency: "EUR", Balance: money.FromInt(100)},
+		{ID: "off", UserID: owner.ID, Currency: "EUR", Balance: money.FromInt(50)},
+		{ID: "pln", UserID: owner.ID, Currency: "PLN"},
+	} {
+		w.CreatedAt = time.Now().UTC()
+		require.Nil(t, d.WalletInsert(ctx, w))
+	}
+
+	importMT940 := func(walletID string) (*Result, error) {
+		r, err := imp.MT940(strings.NewReader(mt940Statement))
+		require.Nil(t, err)
+		return imp.Import(ctx, owner, walletID, r, false)
+	}
+
+	result, err := importMT940("eur")
+	require.Nil(t, err)
+	assert.Equal(t, 3, result.Inserted)
+	assert.Equal(t, 1, result.Failed)
+	require.NotNil(t, result.Reconciliation)
+	assert.Empty(t, result.Reconciliation.Mismatches)
+	assert.True(t, result.Reconciliation.Reconciled())
+	assert.Equal(t, money.MustParse("1080.5"), result.Reconciliation.WalletBalance)
+
+	incomes, err := d.IncomeListByWallet(ctx, "eur")
+	require.Nil(t, err)
+	require.Len(t, incomes, 1)
+	assert.Equal(t, "SALARY", incomes[0].ExternalID.String)
+
+	// Importing again skips all bookings, the wallet still agrees with the closing balance.
+	result, err = importMT940("eur")
+	require.Nil(t, err)
+	assert.Equal(t, 0, result.Inserted)
+	assert.Equal(t, 3, result.Skipped)
+	assert.True(t, result.Reconciliation.Reconciled())
+
+	result, err = importMT940("off")
+	require.Nil(t, err)
+	assert.Equal(t, []string{
+		"opening balance 100 differs from wallet balance 50 before import",
+		"closing balance 1080.5 differs from wallet balance 1030.5 after import",
+	}, result.Reconciliation.Mismatches)
+
+	_, err = importMT940("pln")
+	assert.ErrorIs(t, err, ErrCurrency)
+	expenses, err := d.ExpenseListByWallet(ctx, "pln")
+	require.Nil(t, err)
+	assert.Empty(t, expenses, "import is rolled back")
+}
+
+func TestImporter_ImportDuplicates(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	imp := &Importer{conf: &conf.Config{}, db: d}
+	owner := &auth.User{ID: "u1", Email: "one@example.com"}
+
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: owner.ID, Currency: "PLN", CreatedAt: time.Now().UTC()}))
+	require.Nil(t, d.ExpenseInsert(ctx, &dao.ExpenseInsertParams{
+		ID: "manual", WalletID: "w1", Amount: money.MustParse("-12.5"), Description: dao.NilStr("Coffee shop"),
+		CreatedAt: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+	}))
+
+	statement := func() Reader {
+		r, err := imp.CSV("", strings.NewReader("date,amount,description\n"+
+			"2024-03-01,-12.5,CARD 1234 COFFEE SHOP\n"+
+			"2024-03-01,-12.5,CARD 1234 COFFEE SHOP\n"+
+			"2024-03-01,-12.5,Bakery\n"+
+			"2024-03-01,12.5,Coffee shop refund\n"))
+		require.Nil(t, err)
+		return r
+	}
+
+	// The first coffee is already in the wallet, the second one was bought too.
+	result, err := imp.Import(ctx, owner, "w1", statement(), false)
+	require.Nil(t, err)
+	assert.Equal(t, 3, result.Inserted)
+	assert.Equal(t, []*Duplicate{{Line: 2, ExpenseID: "manual"}}, result.Duplicates)
+
+	wallet, err := d.WalletGetByID(ctx, "w1")
+	require.Nil(t, err)
+	assert.Equal(t, money.MustParse("-12.5"), wallet.Balance)
+
+	result, err = imp.Import(ctx, owner, "w1", statement(), true)
+	require.Nil(t, err)
+	assert.Equal(t, 4, result.Inserted)
+	assert.Empty(t, result.Duplicates)
+}
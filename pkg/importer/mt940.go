@@ -0,0 +1,288 @@
+package importer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MT940 returns a Reader of a SWIFT MT940 customer statement. Lines are read as they are needed, so statements of any
+// size are read in constant memory. Debit bookings are read as expenses, credit bookings as incomes.
+func (i *Importer) MT940(r io.Reader) (Reader, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), 1<<20)
+	return &mt940Reader{scanner: scanner, ids: newIDDeriver("mt940")}, nil
+}
+
+// mt940Field is a tagged field of a statement, such as :61:, with its continuation lines.
+type mt940Field struct {
+	tag   string
+	value string
+	line  int
+}
+
+type mt940Reader struct {
+	scanner *bufio.Scanner
+	line    int
+	// next is the line read ahead while looking for the end of a field.
+	next *string
+	// field is the field read ahead while looking for details of a booking.
+	field *mt940Field
+	// booking waits for its :86: details.
+	booking *mt940Booking
+
+	reference  string
+	statements []*Statement
+	current    *Statement
+	ids        *idDeriver
+}
+
+type mt940Booking struct {
+	record *Record
+	// reference is the bank reference, or the customer one if the bank gives none.
+	reference string
+}
+
+func (r *mt940Reader) Statements() []*Statement {
+	return r.statements
+}
+
+func (r *mt940Reader) Read() (*Record, error) {
+	for {
+		f, err := r.nextField()
+		if errors.Is(err, io.EOF) {
+			if r.booking != nil {
+				return r.flush(""), nil
+			}
+			if len(r.statements) == 0 {
+				return nil, fmt.Errorf("%w: opening balance :60F: is missing", ErrInvalidFile)
+			}
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if r.booking != nil {
+			if f.tag == "86" {
+				return r.flush(f.value), nil
+			}
+			r.field = f
+			return r.flush(""), nil
+		}
+
+		switch f.tag {
+		case "20":
+			r.reference = f.value
+		case "60F", "60M":
+			currency, amount, err := mt940Balance(f.value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidFile, f.line, err)
+			}
+			r.current = &Statement{ID: r.reference, Currency: currency, Opening: &amount}
+			r.statements = append(r.statements, r.current)
+		case "62F", "62M":
+			_, amount, err := mt940Balance(f.value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidFile, f.line, err)
+			}
+			if r.current != nil {
+				r.current.Closing = &amount
+			}
+		case "61":
+			if r.current == nil {
+				return nil, fmt.Errorf("%w: line %d: booking before opening balance", ErrInvalidFile, f.line)
+			}
+			booking, err := r.parseBooking(f)
+			if err != nil {
+				return nil, &RowError{Line: f.line, Err: err}
+			}
+			if booking.record.Amount == 0 {
+				return nil, errSkipRow
+			}
+			r.booking = booking
+		}
+	}
+}
+
+// flush returns the waiting booking, described by details of its :86: field.
+func (r *mt940Reader) flush(details string) *Record {
+	booking := r.booking
+	r.booking = nil
+
+	record := booking.record
+	record.Description = mt940Description(details)
+	if booking.reference != "" {
+		record.ExternalID = booking.reference
+	} else {
+		record.ExternalID = r.ids.id(r.reference, record.Date.Format(time.DateOnly), record.Amount.String(), details)
+	}
+	return record
+}
+
+// nextField returns the next tagged field. Lines outside of fields, such as SWIFT headers, are ignored.
+func (r *mt940Reader) nextField() (*mt940Field, error) {
+	if f := r.field; f != nil {
+		r.field = nil
+		return f, nil
+	}
+
+	var f *mt940Field
+	for {
+		line, ok := r.nextLine()
+		if !ok {
+			if err := r.scanner.Err(); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+			}
+			if f != nil {
+				return f, nil
+			}
+			return nil, io.EOF
+		}
+
+		isTag := strings.HasPrefix(line, ":") && strings.Count(line, ":") >= 2
+		if f != nil && (isTag || strings.HasPrefix(line, "-") || strings.HasPrefix(line, "{")) {
+			r.next = &line
+			return f, nil
+		}
+		if f != nil {
+			f.value += "\n" + line
+			continue
+		}
+		if isTag {
+			tag, value, _ := strings.Cut(line[1:], ":")
+			f = &mt940Field{tag: tag, value: value, line: r.line}
+		}
+	}
+}
+
+func (r *mt940Reader) nextLine() (string, bool) {
+	if line := r.next; line != nil {
+		r.next = nil
+		return *line, true
+	}
+	if !r.scanner.Scan() {
+		return "", false
+	}
+	r.line++
+	// Trailing spaces are kept, details wrapped at a fixed width may break lines between words.
+	return strings.TrimRight(r.scanner.Text(), "\r"), true
+}
+
+// mt940BookingPattern matches the first line of a :61: field, eg. 2403010301DR12,50NTRFNONREF//B123, holding value
+// date, optional entry date, debit or credit mark, optional funds code, amount, transaction type, customer reference
+// and optional bank reference.
+var mt940BookingPattern = regexp.MustCompile(`^(\d{6})(\d{4})?(RC|RD|C|D)([A-Z])?(\d+,\d*)([A-Z0-9]{4})(.*?)(?://(.*))?$`)
+
+// parseBooking reads a :61: field into a Record of the current statement.
+func (r *mt940Reader) parseBooking(f *mt940Field) (*mt940Booking, error) {
+	first, _, _ := strings.Cut(f.value, "\n")
+	m := mt940BookingPattern.FindStringSubmatch(first)
+	if m == nil {
+		return nil, fmt.Errorf("invalid booking %q", first)
+	}
+
+	amount, err := mt940Amount(m[5])
+	if err != nil {
+		return nil, err
+	}
+	// Reversal of a credit takes money out, reversal of a debit brings it back.
+	if m[3] == "D" || m[3] == "RC" {
+		amount = amount.Neg()
+	}
+	r.current.Booked += amount
+
+	date, err := time.Parse("060102", m[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid value date %q", m[1])
+	}
+	if m[2] != "" {
+		// Entry date has no year, it is the one nearest to the value date.
+		entry, err := time.Parse("0102", m[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid entry date %q", m[2])
+		}
+		entry = entry.AddDate(date.Year(), 0, 0)
+		switch {
+		case entry.Sub(date) > 180*24*time.Hour:
+			entry = entry.AddDate(-1, 0, 0)
+		case date.Sub(entry) > 180*24*time.Hour:
+			entry = entry.AddDate(1, 0, 0)
+		}
+		date = entry
+	}
+
+	reference := strings.TrimSpace(m[8])
+	if reference == "" || reference == "NONREF" {
+		reference = strings.TrimSpace(m[7])
+	}
+	if reference == "NONREF" {
+		reference = ""
+	}
+
+	return &mt940Booking{
+		record:    &Record{Line: f.line, Date: date, Amount: amount, Income: amount > 0},
+		reference: reference,
+	}, nil
+}
+
+// mt940Balance reads a balance field, eg. C240301EUR1000,00, returning its currency and signed amount.
+func mt940Balance(value string) (string, money.Decimal, error) {
+	value = strings.TrimSpace(value)
+	if len(value) < 11 || (value[0] != 'C' && value[0] != 'D') {
+		return "", 0, fmt.Errorf("invalid balance %q", value)
+	}
+
+	amount, err := mt940Amount(value[10:])
+	if err != nil {
+		return "", 0, err
+	}
+	if value[0] == 'D' {
+		amount = amount.Neg()
+	}
+
+	return value[7:10], amount, nil
+}
+
+// mt940Amount reads an amount with a decimal comma, eg. 12,5.
+func mt940Amount(value string) (money.Decimal, error) {
+	amount, err := money.Parse(strings.TrimSuffix(strings.Replace(value, ",", ".", 1), "."))
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+	return amount, nil
+}
+
+// mt940StructuredPattern matches the start of structured :86: details, a transaction code or a ?NN subfield.
+var mt940StructuredPattern = regexp.MustCompile(`^(\d{3})?\?\d{2}`)
+
+// mt940Description reads :86: details. Structured details, made of ?NN subfields, are reduced to the counterparty
+// name (?32, ?33) followed by remittance information (?20-?29, ?60-?63). Other details are used as they are.
+func mt940Description(details string) string {
+	details = strings.ReplaceAll(details, "\n", "")
+	if !mt940StructuredPattern.MatchString(details) {
+		return strings.Join(strings.Fields(details), " ")
+	}
+
+	var name, remittance []string
+	for _, sub := range strings.Split(details, "?")[1:] {
+		if len(sub) < 2 {
+			continue
+		}
+		code, text := sub[:2], strings.TrimSpace(sub[2:])
+		switch {
+		case text == "":
+		case code == "32" || code == "33":
+			name = append(name, text)
+		case code >= "20" && code <= "29", code >= "60" && code <= "63":
+			remittance = append(remittance, text)
+		}
+	}
+
+	return strings.Join(strings.Fields(strings.Join(append(name, remittance...), " ")), " ")
+}
@@ -0,0 +1,72 @@
+package importer
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+const mt940Statement = `{1:F01BANKDEFFAXXX0000000000}{2:O9400000240304BANKDEFFAXXX00000000002403040000N}{4:
+:20:STMT2403
+:25:10020030/1234567
+:28C:00001/001
+:60F:C240301EUR100,00
+:61:2403010301DR12,50NTRFNONREF//B1
+:86:166?00KARTENZAHLUNG?20Coffee ?21and cake?32COFFEE SHOP
+:61:2403020302CR1000,NTRFSALARY
+:86:Salary for Febr
+uary 2024
+:61:2403030303RC7,00NTRFNONREF
+:61:240303Z1,00NTRFNONREF
+:62F:C240303EUR1080,50
+-}
+`
+
+func TestImporter_MT940(t *testing.T) {
+	imp := &Importer{conf: &conf.Config{}}
+	r, err := imp.MT940(strings.NewReader(mt940Statement))
+	require.Nil(t, err)
+
+	records, rowErrs, _ := readAll(t, r)
+	require.Len(t, records, 3)
+	assert.Equal(t, &Record{
+		Line:        6,
+		Date:        time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Amount:      money.MustParse("-12.5"),
+		Description: "COFFEE SHOP Coffee and cake",
+		ExternalID:  "B1",
+	}, records[0])
+	assert.Equal(t, &Record{
+		Line:        8,
+		Date:        time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+		Amount:      money.MustParse("1000"),
+		Description: "Salary for February 2024",
+		ExternalID:  "SALARY",
+		Income:      true,
+	}, records[1])
+	assert.Equal(t, money.MustParse("-7"), records[2].Amount, "reversed credit")
+	assert.Contains(t, records[2].ExternalID, "mt940:")
+	assert.Equal(t, []string{`line 12: invalid booking "240303Z1,00NTRFNONREF"`}, rowErrs)
+
+	opening, closing := money.MustParse("100"), money.MustParse("1080.5")
+	assert.Equal(t, []*Statement{{
+		ID:       "STMT2403",
+		Currency: "EUR",
+		Opening:  &opening,
+		Closing:  &closing,
+		Booked:   money.MustParse("980.5"),
+	}}, r.(StatementReader).Statements())
+}
+
+func TestMT940EntryDate(t *testing.T) {
+	r, err := (&Importer{}).MT940(strings.NewReader(":20:X\n:60F:C231231EUR0,\n:61:2312310102D1,NTRFNONREF//Y\n"))
+	require.Nil(t, err)
+
+	record, err := r.Read()
+	require.Nil(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), record.Date, "entry date in the next year")
+}
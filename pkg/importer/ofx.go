@@ -0,0 +1,199 @@
+package importer
+
+import (
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OFX returns a Reader of an OFX or QFX statement, written in either SGML (OFX 1) or XML (OFX 2) syntax. Transactions
+// of all accounts in the statement are read, each identified by its FITID.
+func (i *Importer) OFX(r io.Reader) (Reader, error) {
+	s, err := readText(r)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.Contains(strings.ToUpper(s), "<OFX>") {
+		return nil, fmt.Errorf("%w: OFX element is missing", ErrInvalidFile)
+	}
+
+	return &ofxReader{transactions: ofxTransactions(s), ids: newIDDeriver("ofx")}, nil
+}
+
+// ofxTransaction holds values of elements inside a STMTTRN aggregate, by element name.
+type ofxTransaction struct {
+	line   int
+	fields map[string]string
+}
+
+type ofxReader struct {
+	transactions []*ofxTransaction
+	ids          *idDeriver
+}
+
+func (r *ofxReader) Read() (*Record, error) {
+	if len(r.transactions) == 0 {
+		return nil, io.EOF
+	}
+	t := r.transactions[0]
+	r.transactions = r.transactions[1:]
+
+	record, err := r.record(t)
+	if err != nil {
+		return nil, &RowError{Line: t.line, Err: err}
+	}
+	if record == nil {
+		return nil, errSkipRow
+	}
+
+	return record, nil
+}
+
+// record converts a transaction to a Record. Transactions of zero amount hold no operation and give nil.
+func (r *ofxReader) record(t *ofxTransaction) (*Record, error) {
+	posted := t.fields["DTPOSTED"]
+	if posted == "" {
+		posted = t.fields["DTUSER"]
+	}
+	date, err := parseOFXDate(posted)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := parseOFXAmount(t.fields["TRNAMT"])
+	if err != nil {
+		return nil, err
+	}
+	if amount == 0 {
+		return nil, nil
+	}
+
+	description := t.fields["NAME"]
+	if memo := t.fields["MEMO"]; memo != "" && memo != description {
+		description = strings.TrimSpace(description + " " + memo)
+	}
+
+	// FITID is required by the specification, but not every bank provides it.
+	id := t.fields["FITID"]
+	if id == "" {
+		id = r.ids.id(posted, t.fields["TRNAMT"], t.fields["NAME"], t.fields["MEMO"], t.fields["CHECKNUM"])
+	}
+
+	return &Record{
+		Line:        t.line,
+		Date:        date,
+		Amount:      amount,
+		Description: description,
+		ExternalID:  id,
+	}, nil
+}
+
+// ofxTransactions finds STMTTRN aggregates in s. Elements of OFX 1 have no end tags, so the value of an element is
+// the text following its start tag, up to the next tag. This reads OFX 2 as well, whose end tags are ignored.
+func ofxTransactions(s string) []*ofxTransaction {
+	var (
+		transactions []*ofxTransaction
+		current      *ofxTransaction
+		line         = 1
+		counted      = 0
+	)
+	for pos := 0; ; {
+		start := strings.IndexByte(s[pos:], '<')
+		if start < 0 {
+			break
+		}
+		start += pos
+		end := strings.IndexByte(s[start:], '>')
+		if end < 0 {
+			break
+		}
+		end += start
+		tag := s[start+1 : end]
+		pos = end + 1
+
+		// Skip XML declarations, processing instructions and comments.
+		if tag == "" || tag[0] == '?' || tag[0] == '!' {
+			continue
+		}
+
+		if tag[0] == '/' {
+			if strings.EqualFold(tag[1:], "STMTTRN") {
+				current = nil
+			}
+			continue
+		}
+
+		name := strings.ToUpper(strings.Fields(tag)[0])
+		if name == "STMTTRN" {
+			line += strings.Count(s[counted:start], "\n")
+			counted = start
+			current = &ofxTransaction{line: line, fields: map[string]string{}}
+			transactions = append(transactions, current)
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		value := s[pos:]
+		if next := strings.IndexByte(value, '<'); next >= 0 {
+			value = value[:next]
+		}
+		// Aggregates, such as PAYEE, may hold a NAME of their own. The first value found is kept.
+		if value = html.UnescapeString(strings.TrimSpace(value)); value != "" {
+			if _, ok := current.fields[name]; !ok {
+				current.fields[name] = value
+			}
+		}
+	}
+
+	return transactions
+}
+
+// parseOFXDate reads an OFX datetime, YYYYMMDD[HHMMSS[.XXX]][[offset[:zone]]], eg. 20240301120000.000[-5:EST].
+// Times without offset are in UTC.
+func parseOFXDate(s string) (time.Time, error) {
+	value := s
+	loc := time.UTC
+	if i := strings.IndexByte(value, '['); i >= 0 {
+		zone := strings.TrimSuffix(value[i+1:], "]")
+		value = value[:i]
+		offset, name, _ := strings.Cut(zone, ":")
+		hours, err := strconv.ParseFloat(offset, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date %q", s)
+		}
+		loc = time.FixedZone(name, int(hours*3600))
+	}
+	value, _, _ = strings.Cut(value, ".")
+
+	const layout = "20060102150405"
+	if len(value) != 8 && len(value) != 12 && len(value) != 14 {
+		return time.Time{}, fmt.Errorf("invalid date %q", s)
+	}
+	t, err := time.ParseInLocation(layout[:len(value)], value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", s)
+	}
+
+	return t.UTC(), nil
+}
+
+// parseOFXAmount reads a signed OFX amount. Some banks write amounts with a decimal comma.
+func parseOFXAmount(s string) (money.Decimal, error) {
+	value := strings.TrimSpace(s)
+	if !strings.Contains(value, ".") {
+		value = strings.Replace(value, ",", ".", 1)
+	}
+
+	amount, err := money.Parse(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+
+	return amount, nil
+}
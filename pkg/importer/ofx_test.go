@@ -0,0 +1,116 @@
+package importer
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+const ofxSGML = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+CHARSET:1252
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>USD
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20240301120000.000[-5:EST]
+<TRNAMT>-12.50
+<FITID>T1
+<NAME>Caf` + "\xe9" + ` &amp; Bakery
+<MEMO>Card 1234
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20240302
+<TRNAMT>100,00
+<FITID>T2
+<PAYEE><NAME>Employer<ADDR1>Main St</PAYEE>
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>yesterday
+<TRNAMT>-1
+<FITID>T3
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+
+const ofxXML = `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX>
+  <CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS><BANKTRANLIST>
+    <STMTTRN>
+      <TRNTYPE>POS</TRNTYPE>
+      <DTPOSTED>20240305</DTPOSTED>
+      <TRNAMT>-3.00</TRNAMT>
+      <NAME>Coffee</NAME>
+    </STMTTRN>
+    <STMTTRN>
+      <TRNTYPE>POS</TRNTYPE>
+      <DTPOSTED>20240305</DTPOSTED>
+      <TRNAMT>-3.00</TRNAMT>
+      <NAME>Coffee</NAME>
+    </STMTTRN>
+    <STMTTRN>
+      <TRNTYPE>FEE</TRNTYPE>
+      <DTPOSTED>20240306</DTPOSTED>
+      <TRNAMT>0.00</TRNAMT>
+      <FITID>F0</FITID>
+    </STMTTRN>
+  </BANKTRANLIST></CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1>
+</OFX>
+`
+
+func TestImporter_OFX(t *testing.T) {
+	imp := &Importer{conf: &conf.Config{}}
+
+	r, err := imp.OFX(strings.NewReader(ofxSGML))
+	require.Nil(t, err)
+	records, rowErrs, skipped := readAll(t, r)
+	assert.Equal(t, []*Record{
+		{
+			Line:        10,
+			Date:        time.Date(2024, 3, 1, 17, 0, 0, 0, time.UTC),
+			Amount:      money.MustParse("-12.5"),
+			Description: "Café & Bakery Card 1234",
+			ExternalID:  "T1",
+		},
+		{
+			Line:        18,
+			Date:        time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+			Amount:      money.MustParse("100"),
+			Description: "Employer",
+			ExternalID:  "T2",
+		},
+	}, records)
+	assert.Equal(t, []string{`line 25: invalid date "yesterday"`}, rowErrs)
+	assert.Equal(t, 0, skipped)
+
+	r, err = imp.OFX(strings.NewReader(ofxXML))
+	require.Nil(t, err)
+	records, rowErrs, skipped = readAll(t, r)
+	require.Len(t, records, 2)
+	assert.Empty(t, rowErrs)
+	assert.Equal(t, 1, skipped)
+	assert.Equal(t, "Coffee", records[0].Description)
+	assert.NotEqual(t, records[0].ExternalID, records[1].ExternalID, "identical transactions get distinct IDs")
+
+	// IDs derived from the same statement are stable.
+	r, err = imp.OFX(strings.NewReader(ofxXML))
+	require.Nil(t, err)
+	again, _, _ := readAll(t, r)
+	assert.Equal(t, records, again)
+
+	_, err = imp.OFX(strings.NewReader("date,amount\n"))
+	assert.ErrorIs(t, err, ErrInvalidFile)
+}
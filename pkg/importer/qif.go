@@ -0,0 +1,193 @@
+package importer
+
+import (
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// qifAccountTypes are the QIF sections holding transactions of money accounts. Other sections, such as investment
+// transactions or category lists, are skipped.
+var qifAccountTypes = map[string]bool{
+	"BANK":  true,
+	"CASH":  true,
+	"CCARD": true,
+	"OTH A": true,
+	"OTH L": true,
+}
+
+// QIF returns a Reader of a QIF statement. QIF transactions have no identifiers, so their external IDs are derived
+// from their fields.
+func (i *Importer) QIF(r io.Reader) (Reader, error) {
+	order := i.conf.Import.QIFDateOrder
+	switch order {
+	case "":
+		order = conf.DateOrderMDY
+	case conf.DateOrderMDY, conf.DateOrderDMY, conf.DateOrderYMD:
+	default:
+		return nil, fmt.Errorf("%w: unknown QIF date order %s", ErrInvalidProfile, order)
+	}
+
+	s, err := readText(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &qifReader{
+		lines:     strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n"),
+		dateOrder: order,
+		section:   "BANK",
+		ids:       newIDDeriver("qif"),
+	}, nil
+}
+
+type qifReader struct {
+	lines     []string
+	line      int
+	dateOrder string
+	// section is the type of the current section, in upper case. Files without a type header hold bank transactions.
+	section string
+	ids     *idDeriver
+}
+
+func (r *qifReader) Read() (*Record, error) {
+	var (
+		fields = map[byte]string{}
+		start  int
+	)
+	for r.line < len(r.lines) {
+		line := strings.TrimRight(r.lines[r.line], " \t")
+		r.line++
+		if line == "" {
+			continue
+		}
+
+		if line[0] == '!' {
+			header := strings.ToUpper(line[1:])
+			switch {
+			case strings.HasPrefix(header, "TYPE:"):
+				r.section = strings.TrimSpace(header[len("TYPE:"):])
+			case header == "ACCOUNT":
+				r.section = header
+			}
+			continue
+		}
+
+		if len(fields) == 0 {
+			start = r.line
+		}
+		if line[0] != '^' {
+			// Split lines, starting with S, E or $, repeat. Only fields of the whole transaction are kept.
+			if _, ok := fields[line[0]]; !ok {
+				fields[line[0]] = strings.TrimSpace(line[1:])
+			}
+			continue
+		}
+
+		if !qifAccountTypes[r.section] {
+			return nil, errSkipRow
+		}
+		record, err := r.record(fields)
+		if err != nil {
+			return nil, &RowError{Line: start, Err: err}
+		}
+		if record == nil {
+			return nil, errSkipRow
+		}
+		record.Line = start
+		return record, nil
+	}
+
+	if len(fields) > 0 {
+		return nil, &RowError{Line: start, Err: fmt.Errorf("transaction is not terminated with ^")}
+	}
+	return nil, io.EOF
+}
+
+// record converts fields of a transaction to a Record. Transactions of zero amount hold no operation and give nil.
+func (r *qifReader) record(fields map[byte]string) (*Record, error) {
+	date, err := r.parseDate(fields['D'])
+	if err != nil {
+		return nil, err
+	}
+
+	total, ok := fields['T']
+	if !ok {
+		total = fields['U']
+	}
+	amount, err := parseQIFAmount(total)
+	if err != nil {
+		return nil, err
+	}
+	if amount == 0 {
+		return nil, nil
+	}
+
+	description := fields['P']
+	if memo := fields['M']; memo != "" && memo != description {
+		description = strings.TrimSpace(description + " " + memo)
+	}
+
+	return &Record{
+		Date:        date,
+		Amount:      amount,
+		Description: description,
+		ExternalID:  r.ids.id(fields['D'], total, fields['P'], fields['M'], fields['N']),
+	}, nil
+}
+
+// parseDate reads a QIF date, such as 03/01/2024, 3/ 1/24 or 3/1'24, in the configured order. Quicken marks years
+// after 1999 with an apostrophe, two-digit years are read as 1970-2069.
+func (r *qifReader) parseDate(s string) (time.Time, error) {
+	value := strings.NewReplacer(" ", "", "'", "/", "-", "/", ".", "/").Replace(s)
+	parts := strings.Split(value, "/")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("invalid date %q", s)
+	}
+
+	var numbers [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date %q", s)
+		}
+		numbers[i] = n
+	}
+
+	var year, month, day int
+	switch r.dateOrder {
+	case conf.DateOrderDMY:
+		day, month, year = numbers[0], numbers[1], numbers[2]
+	case conf.DateOrderYMD:
+		year, month, day = numbers[0], numbers[1], numbers[2]
+	default:
+		month, day, year = numbers[0], numbers[1], numbers[2]
+	}
+	switch {
+	case year < 70:
+		year += 2000
+	case year < 100:
+		year += 1900
+	}
+
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if t.Day() != day || int(t.Month()) != month {
+		return time.Time{}, fmt.Errorf("invalid date %q", s)
+	}
+
+	return t, nil
+}
+
+// parseQIFAmount reads a QIF amount, which may use commas to separate thousands.
+func parseQIFAmount(s string) (money.Decimal, error) {
+	amount, err := money.Parse(strings.ReplaceAll(s, ",", ""))
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,79 @@
+package importer
+
+import (
+	"github.com/piotrekmonko/portfello/pkg/conf"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+const qifStatement = `!Account
+NChecking
+TBank
+^
+!Type:Bank
+D03/01/2024
+T-1,234.56
+PLandlord
+MRent
+LHousing
+^
+D3/ 2'24
+T25.00
+PRefund
+SFood
+$10.00
+SFun
+$15.00
+^
+D13/02/2024
+T-5.00
+^
+D03/03/24
+T0.00
+^
+!Type:Invst
+D03/04/2024
+NBuy
+T-500.00
+^
+!Type:CCard
+D03/05/2024
+U-7.10
+PBus
+`
+
+func TestImporter_QIF(t *testing.T) {
+	imp := &Importer{conf: &conf.Config{}}
+	r, err := imp.QIF(strings.NewReader(qifStatement))
+	require.Nil(t, err)
+
+	records, rowErrs, skipped := readAll(t, r)
+	require.Len(t, records, 2)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), records[0].Date)
+	assert.Equal(t, money.MustParse("-1234.56"), records[0].Amount)
+	assert.Equal(t, "Landlord Rent", records[0].Description)
+	assert.Equal(t, time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), records[1].Date)
+	assert.Equal(t, money.MustParse("25"), records[1].Amount)
+	assert.NotEmpty(t, records[0].ExternalID)
+	assert.Equal(t, []string{
+		`line 20: invalid date "13/02/2024"`,
+		`line 32: transaction is not terminated with ^`,
+	}, rowErrs)
+	assert.Equal(t, 3, skipped, "account list, zero amount and investment")
+
+	imp.conf.Import.QIFDateOrder = conf.DateOrderDMY
+	r, err = imp.QIF(strings.NewReader("!Type:Cash\nD13.02.2024\nT-5\n^\n"))
+	require.Nil(t, err)
+	records, rowErrs, _ = readAll(t, r)
+	require.Len(t, records, 1)
+	assert.Empty(t, rowErrs)
+	assert.Equal(t, time.Date(2024, 2, 13, 0, 0, 0, 0, time.UTC), records[0].Date)
+
+	imp.conf.Import.QIFDateOrder = "month first"
+	_, err = imp.QIF(strings.NewReader(""))
+	assert.ErrorIs(t, err, ErrInvalidProfile)
+}
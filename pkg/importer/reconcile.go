@@ -0,0 +1,87 @@
+package importer
+
+import (
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/money"
+)
+
+var ErrCurrency = fmt.Errorf("statement currency differs from wallet currency")
+
+// Statement summarizes a statement of an account, or one page of it: its balances and the sum of its bookings, which
+// should make up their difference.
+type Statement struct {
+	ID       string
+	Currency string
+	// Opening and Closing are nil when the statement does not report them.
+	Opening *money.Decimal
+	Closing *money.Decimal
+	// Booked is the sum of all bookings of the statement, including those which failed to import or were skipped.
+	Booked money.Decimal
+}
+
+// StatementReader is a Reader of statements reporting their balances. Statements are complete once Read returns
+// io.EOF.
+type StatementReader interface {
+	Reader
+	Statements() []*Statement
+}
+
+// Reconciliation compares balances reported by statements with the balance of the wallet they were imported into.
+type Reconciliation struct {
+	// OpeningBalance of the first statement.
+	OpeningBalance *money.Decimal
+	// ClosingBalance of the last statement.
+	ClosingBalance *money.Decimal
+	// WalletBalance after import.
+	WalletBalance money.Decimal
+	Mismatches    []string
+}
+
+// Reconciled reports whether all balances match.
+func (r *Reconciliation) Reconciled() bool {
+	return len(r.Mismatches) == 0
+}
+
+// reconcile checks that statements add up and follow each other, and that they agree with the balance of wallet.
+// Wallet balance before import is compared with the opening balance only when every booking was new to the wallet.
+func reconcile(statements []*Statement, wallet *dao.Wallet, balance money.Decimal, allNew bool) (*Reconciliation, error) {
+	r := &Reconciliation{WalletBalance: balance, Mismatches: []string{}}
+	if len(statements) == 0 {
+		return r, nil
+	}
+
+	for i, s := range statements {
+		if s.Currency != "" && s.Currency != wallet.Currency {
+			return nil, fmt.Errorf("%w: statement %s is in %s, wallet in %s", ErrCurrency, s.ID, s.Currency, wallet.Currency)
+		}
+
+		if s.Opening != nil && s.Closing != nil && *s.Opening+s.Booked != *s.Closing {
+			r.mismatch("statement %s: opening balance %s and bookings of %s do not add up to closing balance %s",
+				s.ID, s.Opening, s.Booked, s.Closing)
+		}
+
+		if i == 0 {
+			continue
+		}
+		if prev := statements[i-1]; prev.Closing != nil && s.Opening != nil && *prev.Closing != *s.Opening {
+			r.mismatch("statement %s: opening balance %s differs from closing balance %s of statement %s",
+				s.ID, s.Opening, prev.Closing, prev.ID)
+		}
+	}
+
+	r.OpeningBalance = statements[0].Opening
+	r.ClosingBalance = statements[len(statements)-1].Closing
+	if allNew && r.OpeningBalance != nil && *r.OpeningBalance != wallet.Balance {
+		r.mismatch("opening balance %s differs from wallet balance %s before import", r.OpeningBalance, wallet.Balance)
+	}
+	if r.ClosingBalance != nil && *r.ClosingBalance != balance {
+		r.mismatch("closing balance %s differs from wallet balance %s after import", r.ClosingBalance, balance)
+	}
+
+	return r, nil
+}
+
+func (r *Reconciliation) mismatch(format string, args ...any) {
+	r.Mismatches = append(r.Mismatches, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,236 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrUnknownCurrency = fmt.Errorf("unknown currency")
+
+// Currency is an ISO 4217 currency.
+type Currency struct {
+	// Code is the alphabetic code, such as EUR.
+	Code string
+	// Number is the numeric code, such as 978.
+	Number int
+	Name   string
+	// MinorUnits is the number of decimal places of amounts in the currency: 0 for JPY, 2 for USD, 3 for KWD.
+	MinorUnits int32
+}
+
+// Round rounds d half away from zero to minor units of c.
+func (c *Currency) Round(d Decimal) Decimal {
+	return d.Round(c.MinorUnits)
+}
+
+// Format formats d with exactly as many decimal places as minor units of c, eg. "12.50" for USD and "1250" for JPY.
+func (c *Currency) Format(d Decimal) string {
+	return d.StringFixed(c.MinorUnits)
+}
+
+// currencies lists active ISO 4217 currencies. Fund codes, precious metals and codes reserved for testing or for
+// special drawing rights are left out, as are currencies replaced by the euro or by redenominated successors.
+var currencies = []*Currency{
+	{Code: "AED", Number: 784, Name: "UAE Dirham", MinorUnits: 2},
+	{Code: "AFN", Number: 971, Name: "Afghani", MinorUnits: 2},
+	{Code: "ALL", Number: 8, Name: "Lek", MinorUnits: 2},
+	{Code: "AMD", Number: 51, Name: "Armenian Dram", MinorUnits: 2},
+	{Code: "AOA", Number: 973, Name: "Kwanza", MinorUnits: 2},
+	{Code: "ARS", Number: 32, Name: "Argentine Peso", MinorUnits: 2},
+	{Code: "AUD", Number: 36, Name: "Australian Dollar", MinorUnits: 2},
+	{Code: "AWG", Number: 533, Name: "Aruban Florin", MinorUnits: 2},
+	{Code: "AZN", Number: 944, Name: "Azerbaijan Manat", MinorUnits: 2},
+	{Code: "BAM", Number: 977, Name: "Convertible Mark", MinorUnits: 2},
+	{Code: "BBD", Number: 52, Name: "Barbados Dollar", MinorUnits: 2},
+	{Code: "BDT", Number: 50, Name: "Taka", MinorUnits: 2},
+	{Code: "BHD", Number: 48, Name: "Bahraini Dinar", MinorUnits: 3},
+	{Code: "BIF", Number: 108, Name: "Burundi Franc", MinorUnits: 0},
+	{Code: "BMD", Number: 60, Name: "Bermudian Dollar", MinorUnits: 2},
+	{Code: "BND", Number: 96, Name: "Brunei Dollar", MinorUnits: 2},
+	{Code: "BOB", Number: 68, Name: "Boliviano", MinorUnits: 2},
+	{Code: "BRL", Number: 986, Name: "Brazilian Real", MinorUnits: 2},
+	{Code: "BSD", Number: 44, Name: "Bahamian Dollar", MinorUnits: 2},
+	{Code: "BTN", Number: 64, Name: "Ngultrum", MinorUnits: 2},
+	{Code: "BWP", Number: 72, Name: "Pula", MinorUnits: 2},
+	{Code: "BYN", Number: 933, Name: "Belarusian Ruble", MinorUnits: 2},
+	{Code: "BZD", Number: 84, Name: "Belize Dollar", MinorUnits: 2},
+	{Code: "CAD", Number: 124, Name: "Canadian Dollar", MinorUnits: 2},
+	{Code: "CDF", Number: 976, Name: "Congolese Franc", MinorUnits: 2},
+	{Code: "CHF", Number: 756, Name: "Swiss Franc", MinorUnits: 2},
+	{Code: "CLP", Number: 152, Name: "Chilean Peso", MinorUnits: 0},
+	{Code: "CNY", Number: 156, Name: "Yuan Renminbi", MinorUnits: 2},
+	{Code: "COP", Number: 170, Name: "Colombian Peso", MinorUnits: 2},
+	{Code: "CRC", Number: 188, Name: "Costa Rican Colon", MinorUnits: 2},
+	{Code: "CUP", Number: 192, Name: "Cuban Peso", MinorUnits: 2},
+	{Code: "CVE", Number: 132, Name: "Cabo Verde Escudo", MinorUnits: 2},
+	{Code: "CZK", Number: 203, Name: "Czech Koruna", MinorUnits: 2},
+	{Code: "DJF", Number: 262, Name: "Djibouti Franc", MinorUnits: 0},
+	{Code: "DKK", Number: 208, Name: "Danish Krone", MinorUnits: 2},
+	{Code: "DOP", Number: 214, Name: "Dominican Peso", MinorUnits: 2},
+	{Code: "DZD", Number: 12, Name: "Algerian Dinar", MinorUnits: 2},
+	{Code: "EGP", Number: 818, Name: "Egyptian Pound", MinorUnits: 2},
+	{Code: "ERN", Number: 232, Name: "Nakfa", MinorUnits: 2},
+	{Code: "ETB", Number: 230, Name: "Ethiopian Birr", MinorUnits: 2},
+	{Code: "EUR", Number: 978, Name: "Euro", MinorUnits: 2},
+	{Code: "FJD", Number: 242, Name: "Fiji Dollar", MinorUnits: 2},
+	{Code: "FKP", Number: 238, Name: "Falkland Islands Pound", MinorUnits: 2},
+	{Code: "GBP", Number: 826, Name: "Pound Sterling", MinorUnits: 2},
+	{Code: "GEL", Number: 981, Name: "Lari", MinorUnits: 2},
+	{Code: "GHS", Number: 936, Name: "Ghana Cedi", MinorUnits: 2},
+	{Code: "GIP", Number: 292, Name: "Gibraltar Pound", MinorUnits: 2},
+	{Code: "GMD", Number: 270, Name: "Dalasi", MinorUnits: 2},
+	{Code: "GNF", Number: 324, Name: "Guinean Franc", MinorUnits: 0},
+	{Code: "GTQ", Number: 320, Name: "Quetzal", MinorUnits: 2},
+	{Code: "GYD", Number: 328, Name: "Guyana Dollar", MinorUnits: 2},
+	{Code: "HKD", Number: 344, Name: "Hong Kong Dollar", MinorUnits: 2},
+	{Code: "HNL", Number: 340, Name: "Lempira", MinorUnits: 2},
+	{Code: "HTG", Number: 332, Name: "Gourde", MinorUnits: 2},
+	{Code: "HUF", Number: 348, Name: "Forint", MinorUnits: 2},
+	{Code: "IDR", Number: 360, Name: "Rupiah", MinorUnits: 2},
+	{Code: "ILS", Number: 376, Name: "New Israeli Sheqel", MinorUnits: 2},
+	{Code: "INR", Number: 356, Name: "Indian Rupee", MinorUnits: 2},
+	{Code: "IQD", Number: 368, Name: "Iraqi Dinar", MinorUnits: 3},
+	{Code: "IRR", Number: 364, Name: "Iranian Rial", MinorUnits: 2},
+	{Code: "ISK", Number: 352, Name: "Iceland Krona", MinorUnits: 0},
+	{Code: "JMD", Number: 388, Name: "Jamaican Dollar", MinorUnits: 2},
+	{Code: "JOD", Number: 400, Name: "Jordanian Dinar", MinorUnits: 3},
+	{Code: "JPY", Number: 392, Name: "Yen", MinorUnits: 0},
+	{Code: "KES", Number: 404, Name: "Kenyan Shilling", MinorUnits: 2},
+	{Code: "KGS", Number: 417, Name: "Som", MinorUnits: 2},
+	{Code: "KHR", Number: 116, Name: "Riel", MinorUnits: 2},
+	{Code: "KMF", Number: 174, Name: "Comorian Franc", MinorUnits: 0},
+	{Code: "KPW", Number: 408, Name: "North Korean Won", MinorUnits: 2},
+	{Code: "KRW", Number: 410, Name: "Won", MinorUnits: 0},
+	{Code: "KWD", Number: 414, Name: "Kuwaiti Dinar", MinorUnits: 3},
+	{Code: "KYD", Number: 136, Name: "Cayman Islands Dollar", MinorUnits: 2},
+	{Code: "KZT", Number: 398, Name: "Tenge", MinorUnits: 2},
+	{Code: "LAK", Number: 418, Name: "Lao Kip", MinorUnits: 2},
+	{Code: "LBP", Number: 422, Name: "Lebanese Pound", MinorUnits: 2},
+	{Code: "LKR", Number: 144, Name: "Sri Lanka Rupee", MinorUnits: 2},
+	{Code: "LRD", Number: 430, Name: "Liberian Dollar", MinorUnits: 2},
+	{Code: "LSL", Number: 426, Name: "Loti", MinorUnits: 2},
+	{Code: "LYD", Number: 434, Name: "Libyan Dinar", MinorUnits: 3},
+	{Code: "MAD", Number: 504, Name: "Moroccan Dirham", MinorUnits: 2},
+	{Code: "MDL", Number: 498, Name: "Moldovan Leu", MinorUnits: 2},
+	{Code: "MGA", Number: 969, Name: "Malagasy Ariary", MinorUnits: 2},
+	{Code: "MKD", Number: 807, Name: "Denar", MinorUnits: 2},
+	{Code: "MMK", Number: 104, Name: "Kyat", MinorUnits: 2},
+	{Code: "MNT", Number: 496, Name: "Tugrik", MinorUnits: 2},
+	{Code: "MOP", Number: 446, Name: "Pataca", MinorUnits: 2},
+	{Code: "MRU", Number: 929, Name: "Ouguiya", MinorUnits: 2},
+	{Code: "MUR", Number: 480, Name: "Mauritius Rupee", MinorUnits: 2},
+	{Code: "MVR", Number: 462, Name: "Rufiyaa", MinorUnits: 2},
+	{Code: "MWK", Number: 454, Name: "Malawi Kwacha", MinorUnits: 2},
+	{Code: "MXN", Number: 484, Name: "Mexican Peso", MinorUnits: 2},
+	{Code: "MYR", Number: 458, Name: "Malaysian Ringgit", MinorUnits: 2},
+	{Code: "MZN", Number: 943, Name: "Mozambique Metical", MinorUnits: 2},
+	{Code: "NAD", Number: 516, Name: "Namibia Dollar", MinorUnits: 2},
+	{Code: "NGN", Number: 566, Name: "Naira", MinorUnits: 2},
+	{Code: "NIO", Number: 558, Name: "Cordoba Oro", MinorUnits: 2},
+	{Code: "NOK", Number: 578, Name: "Norwegian Krone", MinorUnits: 2},
+	{Code: "NPR", Number: 524, Name: "Nepalese Rupee", MinorUnits: 2},
+	{Code: "NZD", Number: 554, Name: "New Zealand Dollar", MinorUnits: 2},
+	{Code: "OMR", Number: 512, Name: "Rial Omani", MinorUnits: 3},
+	{Code: "PAB", Number: 590, Name: "Balboa", MinorUnits: 2},
+	{Code: "PEN", Number: 604, Name: "Sol", MinorUnits: 2},
+	{Code: "PGK", Number: 598, Name: "Kina", MinorUnits: 2},
+	{Code: "PHP", Number: 608, Name: "Philippine Peso", MinorUnits: 2},
+	{Code: "PKR", Number: 586, Name: "Pakistan Rupee", MinorUnits: 2},
+	{Code: "PLN", Number: 985, Name: "Zloty", MinorUnits: 2},
+	{Code: "PYG", Number: 600, Name: "Guarani", MinorUnits: 0},
+	{Code: "QAR", Number: 634, Name: "Qatari Rial", MinorUnits: 2},
+	{Code: "RON", Number: 946, Name: "Romanian Leu", MinorUnits: 2},
+	{Code: "RSD", Number: 941, Name: "Serbian Dinar", MinorUnits: 2},
+	{Code: "RUB", Number: 643, Name: "Russian Ruble", MinorUnits: 2},
+	{Code: "RWF", Number: 646, Name: "Rwanda Franc", MinorUnits: 0},
+	{Code: "SAR", Number: 682, Name: "Saudi Riyal", MinorUnits: 2},
+	{Code: "SBD", Number: 90, Name: "Solomon Islands Dollar", MinorUnits: 2},
+	{Code: "SCR", Number: 690, Name: "Seychelles Rupee", MinorUnits: 2},
+	{Code: "SDG", Number: 938, Name: "Sudanese Pound", MinorUnits: 2},
+	{Code: "SEK", Number: 752, Name: "Swedish Krona", MinorUnits: 2},
+	{Code: "SGD", Number: 702, Name: "Singapore Dollar", MinorUnits: 2},
+	{Code: "SHP", Number: 654, Name: "Saint Helena Pound", MinorUnits: 2},
+	{Code: "SLE", Number: 925, Name: "Leone", MinorUnits: 2},
+	{Code: "SOS", Number: 706, Name: "Somali Shilling", MinorUnits: 2},
+	{Code: "SRD", Number: 968, Name: "Surinam Dollar", MinorUnits: 2},
+	{Code: "SSP", Number: 728, Name: "South Sudanese Pound", MinorUnits: 2},
+	{Code: "STN", Number: 930, Name: "Dobra", MinorUnits: 2},
+	{Code: "SVC", Number: 222, Name: "El Salvador Colon", MinorUnits: 2},
+	{Code: "SYP", Number: 760, Name: "Syrian Pound", MinorUnits: 2},
+	{Code: "SZL", Number: 748, Name: "Lilangeni", MinorUnits: 2},
+	{Code: "THB", Number: 764, Name: "Baht", MinorUnits: 2},
+	{Code: "TJS", Number: 972, Name: "Somoni", MinorUnits: 2},
+	{Code: "TMT", Number: 934, Name: "Turkmenistan New Manat", MinorUnits: 2},
+	{Code: "TND", Number: 788, Name: "Tunisian Dinar", MinorUnits: 3},
+	{Code: "TOP", Number: 776, Name: "Pa'anga", MinorUnits: 2},
+	{Code: "TRY", Number: 949, Name: "Turkish Lira", MinorUnits: 2},
+	{Code: "TTD", Number: 780, Name: "Trinidad and Tobago Dollar", MinorUnits: 2},
+	{Code: "TWD", Number: 901, Name: "New Taiwan Dollar", MinorUnits: 2},
+	{Code: "TZS", Number: 834, Name: "Tanzanian Shilling", MinorUnits: 2},
+	{Code: "UAH", Number: 980, Name: "Hryvnia", MinorUnits: 2},
+	{Code: "UGX", Number: 800, Name: "Uganda Shilling", MinorUnits: 0},
+	{Code: "USD", Number: 840, Name: "US Dollar", MinorUnits: 2},
+	{Code: "UYU", Number: 858, Name: "Peso Uruguayo", MinorUnits: 2},
+	{Code: "UZS", Number: 860, Name: "Uzbekistan Sum", MinorUnits: 2},
+	{Code: "VED", Number: 926, Name: "Bolívar Soberano", MinorUnits: 2},
+	{Code: "VES", Number: 928, Name: "Bolívar Soberano", MinorUnits: 2},
+	{Code: "VND", Number: 704, Name: "Dong", MinorUnits: 0},
+	{Code: "VUV", Number: 548, Name: "Vatu", MinorUnits: 0},
+	{Code: "WST", Number: 882, Name: "Tala", MinorUnits: 2},
+	{Code: "XAF", Number: 950, Name: "CFA Franc BEAC", MinorUnits: 0},
+	{Code: "XCD", Number: 951, Name: "East Caribbean Dollar", MinorUnits: 2},
+	{Code: "XCG", Number: 532, Name: "Caribbean Guilder", MinorUnits: 2},
+	{Code: "XOF", Number: 952, Name: "CFA Franc BCEAO", MinorUnits: 0},
+	{Code: "XPF", Number: 953, Name: "CFP Franc", MinorUnits: 0},
+	{Code: "YER", Number: 886, Name: "Yemeni Rial", MinorUnits: 2},
+	{Code: "ZAR", Number: 710, Name: "Rand", MinorUnits: 2},
+	{Code: "ZMW", Number: 967, Name: "Zambian Kwacha", MinorUnits: 2},
+	{Code: "ZWG", Number: 924, Name: "Zimbabwe Gold", MinorUnits: 2},
+}
+
+var currenciesByCode = func() map[string]*Currency {
+	byCode := make(map[string]*Currency, len(currencies))
+	for _, c := range currencies {
+		byCode[c.Code] = c
+	}
+	return byCode
+}()
+
+// LookupCurrency returns the currency of an alphabetic ISO 4217 code. Codes are matched regardless of case and
+// surrounding spaces.
+func LookupCurrency(code string) (*Currency, error) {
+	c, ok := currenciesByCode[strings.ToUpper(strings.TrimSpace(code))]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q is not an ISO 4217 currency code", ErrUnknownCurrency, code)
+	}
+	return c, nil
+}
+
+// Currencies returns all known currencies sorted by code.
+func Currencies() []*Currency {
+	sorted := make([]*Currency, len(currencies))
+	copy(sorted, currencies)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Code < sorted[j].Code
+	})
+	return sorted
+}
+
+// RoundIn rounds d to minor units of the currency of code. Amounts in unknown currencies, such as those of wallets
+// created before currencies were validated, are rounded to cents.
+func RoundIn(d Decimal, code string) Decimal {
+	if c, err := LookupCurrency(code); err == nil {
+		return c.Round(d)
+	}
+	return d.Round(2)
+}
+
+// FormatIn formats d with as many decimal places as minor units of the currency of code, or with two for unknown
+// currencies.
+func FormatIn(d Decimal, code string) string {
+	if c, err := LookupCurrency(code); err == nil {
+		return c.Format(d)
+	}
+	return d.StringFixed(2)
+}
@@ -0,0 +1,57 @@
+package money
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLookupCurrency(t *testing.T) {
+	c, err := LookupCurrency(" jpy ")
+	require.Nil(t, err)
+	assert.Equal(t, &Currency{Code: "JPY", Number: 392, Name: "Yen", MinorUnits: 0}, c)
+
+	c, err = LookupCurrency("KWD")
+	require.Nil(t, err)
+	assert.Equal(t, int32(3), c.MinorUnits)
+
+	for _, code := range []string{"", "XYZ", "EURO", "DEM", "XAU"} {
+		_, err = LookupCurrency(code)
+		assert.ErrorIs(t, err, ErrUnknownCurrency, code)
+	}
+}
+
+func TestCurrency_Round(t *testing.T) {
+	jpy, usd, kwd := currenciesByCode["JPY"], currenciesByCode["USD"], currenciesByCode["KWD"]
+
+	assert.Equal(t, MustParse("13"), jpy.Round(MustParse("12.5")))
+	assert.Equal(t, MustParse("12.35"), usd.Round(MustParse("12.345")))
+	assert.Equal(t, MustParse("-12.346"), kwd.Round(MustParse("-12.3455")))
+
+	assert.Equal(t, "1250", jpy.Format(MustParse("1250")))
+	assert.Equal(t, "12.50", usd.Format(MustParse("12.5")))
+	assert.Equal(t, "-0.100", kwd.Format(MustParse("-0.1")))
+}
+
+func TestCurrencies(t *testing.T) {
+	all := Currencies()
+	require.Len(t, all, len(currencies))
+	for i := 1; i < len(all); i++ {
+		assert.Less(t, all[i-1].Code, all[i].Code)
+	}
+
+	numbers := map[int]string{}
+	for _, c := range all {
+		assert.Len(t, c.Code, 3)
+		assert.NotContains(t, numbers, c.Number, c.Code)
+		numbers[c.Number] = c.Code
+	}
+}
+
+func TestRoundIn(t *testing.T) {
+	assert.Equal(t, MustParse("2"), RoundIn(MustParse("1.5"), "jpy"))
+	assert.Equal(t, MustParse("1.24"), RoundIn(MustParse("1.235"), "XYZ"))
+	assert.Equal(t, "2", FormatIn(MustParse("1.5"), "JPY"))
+	assert.Equal(t, "1.235", FormatIn(MustParse("1.235"), "BHD"))
+	assert.Equal(t, "3.00", FormatIn(MustParse("3"), "XYZ"))
+}
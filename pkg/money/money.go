@@ -0,0 +1,248 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Scale is the number of decimal places kept by Decimal. It is enough to represent minor units of any ISO 4217 currency.
+const Scale = 4
+
+// unit is the Decimal value of 1.
+const unit = 10000
+
+var (
+	ErrInvalid   = fmt.Errorf("invalid decimal amount")
+	ErrPrecision = fmt.Errorf("too many decimal places")
+)
+
+// Decimal is an exact fixed-point amount of money, stored as an integer number of ten-thousandths of a currency unit.
+// Decimals can be added and subtracted with regular operators. Zero value is a valid amount of 0.
+type Decimal int64
+
+// New returns a Decimal equal to value * 10^-places, eg. New(1234, 2) is 12.34. Places beyond Scale are rounded.
+func New(value int64, places int32) Decimal {
+	if places <= Scale {
+		return Decimal(value * pow10(Scale-places))
+	}
+
+	return Decimal(roundQuo(big.NewInt(value), big.NewInt(pow10(places-Scale))))
+}
+
+// FromInt returns a Decimal of whole currency units.
+func FromInt(value int64) Decimal {
+	return Decimal(value * unit)
+}
+
+// Parse reads a decimal number, such as "-12.34". It does not accept exponents nor more than Scale decimal places.
+func Parse(s string) (Decimal, error) {
+	digits := s
+	if strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		digits = digits[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+	if intPart == "" && fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalid, s)
+	}
+	if len(fracPart) > Scale {
+		return 0, fmt.Errorf("%w: %q", ErrPrecision, s)
+	}
+
+	value, err := strconv.ParseInt(intPart+fracPart+strings.Repeat("0", Scale-len(fracPart)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalid, s)
+	}
+
+	if strings.HasPrefix(s, "-") {
+		value = -value
+	}
+
+	return Decimal(value), nil
+}
+
+// ParseRound is like Parse, but rounds numbers with more than Scale decimal places half away from zero, such as prices
+// quoted to six places.
+func ParseRound(s string) (Decimal, error) {
+	d, err := Parse(s)
+	if !errors.Is(err, ErrPrecision) {
+		return d, err
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrInvalid, s)
+	}
+	r.Mul(r, new(big.Rat).SetInt64(unit))
+	if new(big.Rat).Abs(r).Cmp(new(big.Rat).SetInt64(math.MaxInt64)) > 0 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalid, s)
+	}
+	return Decimal(roundQuo(r.Num(), r.Denom())), nil
+}
+
+// MustParse is like Parse but panics on invalid input. Use with constants only.
+func MustParse(s string) Decimal {
+	d, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// String formats d without trailing zeros, eg. "12.5" or "-3".
+func (d Decimal) String() string {
+	s := d.StringFixed(Scale)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
+
+// StringFixed formats d rounded to exactly places decimal places, eg. "12.50".
+func (d Decimal) StringFixed(places int32) string {
+	if places > Scale {
+		return d.StringFixed(Scale) + strings.Repeat("0", int(places-Scale))
+	}
+
+	rounded := d.Round(places)
+	sign := ""
+	if rounded < 0 {
+		sign = "-"
+	}
+
+	// Conversion to uint64 keeps the absolute value of math.MinInt64 correct.
+	abs := uint64(rounded)
+	if rounded < 0 {
+		abs = uint64(-rounded)
+	}
+
+	digits := strconv.FormatUint(abs/uint64(pow10(Scale-places)), 10)
+	if places == 0 {
+		return sign + digits
+	}
+	if len(digits) <= int(places) {
+		digits = strings.Repeat("0", int(places)-len(digits)+1) + digits
+	}
+
+	return sign + digits[:len(digits)-int(places)] + "." + digits[len(digits)-int(places):]
+}
+
+// Round rounds d half away from zero to given number of decimal places.
+func (d Decimal) Round(places int32) Decimal {
+	if places >= Scale {
+		return d
+	}
+
+	factor := pow10(Scale - places)
+	return Decimal(roundQuo(big.NewInt(int64(d)), big.NewInt(factor)) * factor)
+}
+
+// MulRate multiplies d by an exchange rate. Rate is taken as the shortest decimal representing the float, so a rate
+// parsed from "4.3" multiplies exactly by 4.3. Result is rounded half away from zero to Scale.
+func (d Decimal) MulRate(rate float64) Decimal {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(rate, 'f', -1, 64))
+	if !ok {
+		return 0
+	}
+
+	r.Mul(r, new(big.Rat).SetInt64(int64(d)))
+	return Decimal(roundQuo(r.Num(), r.Denom()))
+}
+
+// Mul multiplies d by m, such as a price by a quantity, rounding half away from zero to Scale.
+func (d Decimal) Mul(m Decimal) Decimal {
+	return d.MulDiv(m, unit)
+}
+
+// MulDiv returns d * num / den rounded half away from zero to Scale, such as the part of the cost of den units falling
+// on num of them. It panics when den is zero.
+func (d Decimal) MulDiv(num, den Decimal) Decimal {
+	product := new(big.Int).Mul(big.NewInt(int64(d)), big.NewInt(int64(num)))
+	return Decimal(roundQuo(product, big.NewInt(int64(den))))
+}
+
+// Div divides d by n, rounding half away from zero to Scale. It panics when n is zero.
+func (d Decimal) Div(n int64) Decimal {
+	return Decimal(roundQuo(big.NewInt(int64(d)), big.NewInt(n)))
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return -d
+}
+
+// IsPositive reports whether d is greater than zero.
+func (d Decimal) IsPositive() bool {
+	return d > 0
+}
+
+// MarshalGQL writes d as a quoted decimal string.
+func (d Decimal) MarshalGQL(w io.Writer) {
+	_, _ = io.WriteString(w, strconv.Quote(d.String()))
+}
+
+// UnmarshalGQL reads a decimal string. Numbers are accepted too, floats are read as their shortest representation.
+func (d *Decimal) UnmarshalGQL(v interface{}) error {
+	var (
+		parsed Decimal
+		err    error
+	)
+
+	switch value := v.(type) {
+	case string:
+		parsed, err = Parse(value)
+	case json.Number:
+		parsed, err = Parse(value.String())
+	case int:
+		parsed = FromInt(int64(value))
+	case int64:
+		parsed = FromInt(value)
+	case float64:
+		parsed, err = Parse(strconv.FormatFloat(value, 'f', -1, 64))
+	default:
+		err = fmt.Errorf("%w: %T is not a decimal string", ErrInvalid, v)
+	}
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// roundQuo returns num/den rounded half away from zero.
+func roundQuo(num, den *big.Int) int64 {
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() != 0 && new(big.Int).Abs(new(big.Int).Mul(rem, big.NewInt(2))).Cmp(new(big.Int).Abs(den)) >= 0 {
+		if (num.Sign() < 0) != (den.Sign() < 0) {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+
+	return quo.Int64()
+}
+
+func pow10(n int32) int64 {
+	out := int64(1)
+	for i := int32(0); i < n; i++ {
+		out *= 10
+	}
+	return out
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
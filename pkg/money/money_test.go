@@ -0,0 +1,169 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Decimal
+		wantErr error
+	}{
+		{in: "0", want: 0},
+		{in: "12.34", want: 123400},
+		{in: "-12.34", want: -123400},
+		{in: "+1.5", want: 15000},
+		{in: ".5", want: 5000},
+		{in: "7.", want: 70000},
+		{in: "0.0001", want: 1},
+		{in: "0.00001", wantErr: ErrPrecision},
+		{in: "", wantErr: ErrInvalid},
+		{in: "-", wantErr: ErrInvalid},
+		{in: ".", wantErr: ErrInvalid},
+		{in: "1,5", wantErr: ErrInvalid},
+		{in: "1e3", wantErr: ErrInvalid},
+		{in: "--1", wantErr: ErrInvalid},
+		{in: "99999999999999999999", wantErr: ErrInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "got error %v", err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDecimal_String(t *testing.T) {
+	tests := []struct {
+		in         Decimal
+		want       string
+		wantFixed2 string
+		wantFixed0 string
+	}{
+		{in: 0, want: "0", wantFixed2: "0.00", wantFixed0: "0"},
+		{in: 123400, want: "12.34", wantFixed2: "12.34", wantFixed0: "12"},
+		{in: -15000, want: "-1.5", wantFixed2: "-1.50", wantFixed0: "-2"},
+		{in: 1, want: "0.0001", wantFixed2: "0.00", wantFixed0: "0"},
+		{in: -50, want: "-0.005", wantFixed2: "-0.01", wantFixed0: "0"},
+		{in: 1000000, want: "100", wantFixed2: "100.00", wantFixed0: "100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.in.String())
+			assert.Equal(t, tt.wantFixed2, tt.in.StringFixed(2))
+			assert.Equal(t, tt.wantFixed0, tt.in.StringFixed(0))
+		})
+	}
+	assert.Equal(t, "1.230000", MustParse("1.23").StringFixed(6))
+	assert.Equal(t, "-922337203685477.5808", Decimal(math.MinInt64).String())
+}
+
+func TestDecimal_Round(t *testing.T) {
+	assert.Equal(t, MustParse("1.24"), MustParse("1.235").Round(2))
+	assert.Equal(t, MustParse("-1.24"), MustParse("-1.235").Round(2))
+	assert.Equal(t, MustParse("1.23"), MustParse("1.2349").Round(2))
+	assert.Equal(t, MustParse("3"), MustParse("2.5").Round(0))
+	assert.Equal(t, MustParse("1.2349"), MustParse("1.2349").Round(6))
+}
+
+func TestNew(t *testing.T) {
+	assert.Equal(t, MustParse("12.34"), New(1234, 2))
+	assert.Equal(t, MustParse("-5"), New(-5, 0))
+	assert.Equal(t, MustParse("0.1235"), New(123456, 6))
+	assert.Equal(t, MustParse("7"), FromInt(7))
+}
+
+func TestDecimal_MulRate(t *testing.T) {
+	assert.Equal(t, MustParse("430"), MustParse("100").MulRate(4.3))
+	assert.Equal(t, MustParse("33.3333"), MustParse("100").MulRate(1.0/3))
+	assert.Equal(t, MustParse("-0.3"), MustParse("-0.1").MulRate(3))
+	assert.Equal(t, MustParse("0.6234"), MustParse("100").MulRate(0.006234))
+}
+
+func TestParseRound(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Decimal
+		wantErr error
+	}{
+		{in: "12.34", want: MustParse("12.34")},
+		{in: "189.979996", want: MustParse("189.98")},
+		{in: "-0.00005", want: MustParse("-0.0001")},
+		{in: "0.00004999", want: 0},
+		{in: "1e5", wantErr: ErrInvalid},
+		{in: "922337203685477.58079", wantErr: ErrInvalid},
+	}
+	for _, tt := range tests {
+		got, err := ParseRound(tt.in)
+		if tt.wantErr != nil {
+			assert.ErrorIs(t, err, tt.wantErr, tt.in)
+			continue
+		}
+		require.Nil(t, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+}
+
+func TestDecimal_Mul(t *testing.T) {
+	assert.Equal(t, MustParse("1504.5"), MustParse("10").Mul(MustParse("150.45")))
+	assert.Equal(t, MustParse("0.0004"), MustParse("0.0015").Mul(MustParse("0.25")))
+	assert.Equal(t, MustParse("-3.75"), MustParse("-2.5").Mul(MustParse("1.5")))
+	assert.Equal(t, MustParse("33.3333"), MustParse("100").MulDiv(MustParse("1"), MustParse("3")))
+	assert.Equal(t, MustParse("-60"), MustParse("-150").MulDiv(MustParse("2"), MustParse("5")))
+}
+
+func TestDecimal_Div(t *testing.T) {
+	assert.Equal(t, MustParse("3.3333"), MustParse("10").Div(3))
+	assert.Equal(t, MustParse("-5.8333"), MustParse("-17.5").Div(3))
+	assert.Equal(t, MustParse("0.0001"), MustParse("0.0003").Div(4))
+}
+
+func TestDecimal_Sum(t *testing.T) {
+	var sum Decimal
+	for i := 0; i < 1000; i++ {
+		sum += MustParse("0.1")
+	}
+	assert.Equal(t, MustParse("100"), sum)
+}
+
+func TestDecimal_GQL(t *testing.T) {
+	var buf bytes.Buffer
+	MustParse("-12.5").MarshalGQL(&buf)
+	assert.Equal(t, `"-12.5"`, buf.String())
+
+	tests := []struct {
+		in      interface{}
+		want    Decimal
+		wantErr bool
+	}{
+		{in: "12.34", want: 123400},
+		{in: json.Number("0.1"), want: 1000},
+		{in: 5, want: 50000},
+		{in: int64(-5), want: -50000},
+		{in: 0.1, want: 1000},
+		{in: "abc", wantErr: true},
+		{in: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		var got Decimal
+		err := got.UnmarshalGQL(tt.in)
+		if tt.wantErr {
+			assert.NotNil(t, err)
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+}
@@ -0,0 +1,249 @@
+package portfolio
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/exchange"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Kinds of trades.
+const (
+	KindBuy      = "buy"
+	KindSell     = "sell"
+	KindDividend = "dividend"
+)
+
+// maxTicker is the longest ticker fitting the ticker columns.
+const maxTicker = 32
+
+var (
+	ErrInvalidTicker = fmt.Errorf("ticker must not be empty nor longer than 32 characters")
+	ErrOversold      = fmt.Errorf("cannot sell more units than held")
+)
+
+// NormalizeTicker returns ticker in upper case without surrounding spaces, so that AAPL and aapl are one security.
+func NormalizeTicker(ticker string) (string, error) {
+	ticker = strings.ToUpper(strings.TrimSpace(ticker))
+	if ticker == "" || len(ticker) > maxTicker {
+		return "", ErrInvalidTicker
+	}
+	return ticker, nil
+}
+
+// Lot is a quantity of a security bought by one trade and not sold yet.
+type Lot struct {
+	BoughtAt time.Time
+	Quantity money.Decimal
+	// CostBasis is the part of the price paid for the lot, fees included, falling on its remaining quantity.
+	CostBasis money.Decimal
+}
+
+// Holding is a security bought in a wallet. Holdings sold entirely are kept for their realised gain and dividends.
+type Holding struct {
+	Ticker    string
+	Quantity  money.Decimal
+	CostBasis money.Decimal
+	// Lots holds the remaining quantity, oldest first.
+	Lots []*Lot
+	// RealisedGain is the sum of proceeds of sells less the cost basis of units they sold.
+	RealisedGain money.Decimal
+	Dividends    money.Decimal
+
+	// Price, PricedOn, MarketValue and UnrealisedGain are set by Value, and are nil when the price is not known.
+	Price          *money.Decimal
+	PricedOn       *time.Time
+	MarketValue    *money.Decimal
+	UnrealisedGain *money.Decimal
+}
+
+// Holdings replays trades, which must be ordered by time, and returns holdings of every traded security sorted by
+// ticker. Sells take units of the oldest lots first (FIFO). A sell of more units than held at its time fails with
+// ErrOversold.
+func Holdings(trades []*dao.Trade) ([]*Holding, error) {
+	var holdings []*Holding
+	byTicker := map[string]*Holding{}
+	for _, trade := range trades {
+		holding, ok := byTicker[trade.Ticker]
+		if !ok {
+			holding = &Holding{Ticker: trade.Ticker}
+			byTicker[trade.Ticker] = holding
+			holdings = append(holdings, holding)
+		}
+
+		switch trade.Kind {
+		case KindBuy:
+			holding.Lots = append(holding.Lots, &Lot{
+				BoughtAt:  trade.CreatedAt,
+				Quantity:  trade.Quantity,
+				CostBasis: trade.Amount.Neg(),
+			})
+			holding.Quantity += trade.Quantity
+			holding.CostBasis -= trade.Amount
+		case KindSell:
+			if trade.Quantity > holding.Quantity {
+				return nil, fmt.Errorf("%w: %s of %s held on %s", ErrOversold, holding.Quantity, trade.Ticker,
+					trade.CreatedAt.UTC().Format(time.DateOnly))
+			}
+			sold := holding.sell(trade.Quantity)
+			holding.RealisedGain += trade.Amount - sold
+		case KindDividend:
+			holding.Dividends += trade.Amount
+		default:
+			return nil, fmt.Errorf("invalid trade kind: %s", trade.Kind)
+		}
+	}
+
+	sort.Slice(holdings, func(i, j int) bool {
+		return holdings[i].Ticker < holdings[j].Ticker
+	})
+	return holdings, nil
+}
+
+// sell removes quantity from the oldest lots of h and returns their cost basis.
+func (h *Holding) sell(quantity money.Decimal) money.Decimal {
+	var cost money.Decimal
+	for quantity > 0 {
+		lot := h.Lots[0]
+		if lot.Quantity <= quantity {
+			cost += lot.CostBasis
+			quantity -= lot.Quantity
+			h.Lots = h.Lots[1:]
+			continue
+		}
+
+		part := lot.CostBasis.MulDiv(quantity, lot.Quantity)
+		lot.Quantity -= quantity
+		lot.CostBasis -= part
+		cost += part
+		quantity = 0
+	}
+
+	h.Quantity = 0
+	h.CostBasis = 0
+	for _, lot := range h.Lots {
+		h.Quantity += lot.Quantity
+		h.CostBasis += lot.CostBasis
+	}
+	return cost
+}
+
+// Portfolio is the value of securities of a wallet at a time, in the currency of the wallet.
+type Portfolio struct {
+	WalletID string
+	Currency string
+	At       time.Time
+	Holdings []*Holding
+	// CostBasis, MarketValue and UnrealisedGain sum those of holdings. MarketValue and UnrealisedGain are nil when a
+	// price of any held security is not known.
+	CostBasis      money.Decimal
+	MarketValue    *money.Decimal
+	UnrealisedGain *money.Decimal
+	RealisedGain   money.Decimal
+	Dividends      money.Decimal
+}
+
+// Price is the price of a unit of a security on a day.
+type Price struct {
+	Ticker   string
+	PricedOn time.Time
+	Price    money.Decimal
+	Currency string
+}
+
+// PriceFunc returns the latest price of a unit of ticker in currency at given time, or nil when there is none.
+type PriceFunc func(ctx context.Context, ticker, currency string, at time.Time) (*Price, error)
+
+// Value returns the portfolio of wallet after trades made up to at, which must be ordered by time. Held securities are
+// valued at their latest price returned by price.
+func Value(ctx context.Context, wallet *dao.Wallet, trades []*dao.Trade, at time.Time, price PriceFunc) (*Portfolio, error) {
+	made := trades
+	for i, trade := range trades {
+		if trade.CreatedAt.After(at) {
+			made = trades[:i]
+			break
+		}
+	}
+
+	holdings, err := Holdings(made)
+	if err != nil {
+		return nil, err
+	}
+
+	var marketValue money.Decimal
+	priced := true
+	p := &Portfolio{WalletID: wallet.ID, Currency: wallet.Currency, At: at, Holdings: holdings}
+	for _, holding := range holdings {
+		p.CostBasis += holding.CostBasis
+		p.RealisedGain += holding.RealisedGain
+		p.Dividends += holding.Dividends
+
+		if holding.Quantity == 0 {
+			var zero money.Decimal
+			holding.MarketValue, holding.UnrealisedGain = &zero, &zero
+			continue
+		}
+
+		unitPrice, err := price(ctx, holding.Ticker, wallet.Currency, at)
+		if err != nil {
+			return nil, err
+		}
+		if unitPrice == nil {
+			priced = false
+			continue
+		}
+
+		value := money.RoundIn(holding.Quantity.Mul(unitPrice.Price), wallet.Currency)
+		gain := value - holding.CostBasis
+		holding.Price, holding.PricedOn = &unitPrice.Price, &unitPrice.PricedOn
+		holding.MarketValue, holding.UnrealisedGain = &value, &gain
+		marketValue += value
+	}
+
+	if priced {
+		gain := marketValue - p.CostBasis
+		p.MarketValue, p.UnrealisedGain = &marketValue, &gain
+	}
+	return p, nil
+}
+
+// Pricer looks up stored prices of securities, converting them into the currency of a wallet at stored exchange rates.
+type Pricer struct {
+	q         dao.Querier
+	converter *exchange.Converter
+}
+
+func NewPricer(q dao.Querier, converter *exchange.Converter) *Pricer {
+	return &Pricer{
+		q:         q,
+		converter: converter,
+	}
+}
+
+// Price is a PriceFunc returning the latest price imported for the day of at or earlier. Prices in other currencies
+// are converted at the rate of the day of the price.
+func (p *Pricer) Price(ctx context.Context, ticker, currency string, at time.Time) (*Price, error) {
+	stored, err := p.q.SecurityPriceLatest(ctx, ticker, exchange.Day(at))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read price of %s: %w", ticker, err)
+	}
+
+	price := &Price{Ticker: stored.Ticker, PricedOn: stored.PricedOn.UTC(), Price: stored.Price, Currency: stored.Currency}
+	if stored.Currency != currency {
+		rate, err := p.converter.Rate(ctx, stored.Currency, currency, stored.PricedOn)
+		if err != nil {
+			return nil, err
+		}
+		price.Price, price.Currency = stored.Price.MulRate(rate), currency
+	}
+	return price, nil
+}
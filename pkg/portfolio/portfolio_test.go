@@ -0,0 +1,156 @@
+package portfolio
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/exchange"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func date(month time.Month, day int) time.Time {
+	return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func trade(kind, ticker, quantity, amount string, at time.Time) *dao.Trade {
+	t := &dao.Trade{Kind: kind, Ticker: ticker, Amount: money.MustParse(amount), CreatedAt: at}
+	if quantity != "" {
+		t.Quantity = money.MustParse(quantity)
+	}
+	return t
+}
+
+func TestNormalizeTicker(t *testing.T) {
+	ticker, err := NormalizeTicker(" aapl ")
+	require.Nil(t, err)
+	assert.Equal(t, "AAPL", ticker)
+
+	for _, invalid := range []string{"", "  ", "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456"} {
+		_, err = NormalizeTicker(invalid)
+		assert.ErrorIs(t, err, ErrInvalidTicker, invalid)
+	}
+}
+
+func TestHoldings(t *testing.T) {
+	trades := []*dao.Trade{
+		trade(KindBuy, "VWCE", "10", "-1001", date(1, 2)),
+		trade(KindBuy, "AAPL", "10", "-1500", date(1, 3)),
+		trade(KindBuy, "AAPL", "5", "-900", date(2, 1)),
+		trade(KindDividend, "AAPL", "", "2.4", date(2, 15)),
+		// Sells all 10 units of the first lot and 2 of the second, costing 1500 + 900 * 2/5.
+		trade(KindSell, "AAPL", "12", "2395", date(3, 1)),
+		trade(KindSell, "VWCE", "10", "1100", date(3, 2)),
+	}
+
+	holdings, err := Holdings(trades)
+	require.Nil(t, err)
+	require.Len(t, holdings, 2)
+
+	assert.Equal(t, &Holding{
+		Ticker:       "AAPL",
+		Quantity:     money.MustParse("3"),
+		CostBasis:    money.MustParse("540"),
+		Lots:         []*Lot{{BoughtAt: date(2, 1), Quantity: money.MustParse("3"), CostBasis: money.MustParse("540")}},
+		RealisedGain: money.MustParse("535"),
+		Dividends:    money.MustParse("2.4"),
+	}, holdings[0])
+	assert.Equal(t, &Holding{
+		Ticker:       "VWCE",
+		Lots:         []*Lot{},
+		RealisedGain: money.MustParse("99"),
+	}, holdings[1])
+
+	// Cost basis of parts of a lot is rounded, yet the parts add up to the cost of the lot.
+	holdings, err = Holdings([]*dao.Trade{
+		trade(KindBuy, "AAPL", "3", "-100", date(1, 1)),
+		trade(KindSell, "AAPL", "1", "40", date(1, 2)),
+		trade(KindSell, "AAPL", "1", "40", date(1, 3)),
+	})
+	require.Nil(t, err)
+	assert.Equal(t, money.MustParse("33.3333"), holdings[0].CostBasis)
+	assert.Equal(t, money.MustParse("13.3333"), holdings[0].RealisedGain)
+
+	_, err = Holdings([]*dao.Trade{
+		trade(KindBuy, "AAPL", "1", "-150", date(1, 3)),
+		trade(KindSell, "AAPL", "2", "300", date(1, 2)),
+	})
+	assert.ErrorIs(t, err, ErrOversold)
+}
+
+func TestValue(t *testing.T) {
+	ctx := context.Background()
+	wallet := &dao.Wallet{ID: "w1", Currency: "USD"}
+	trades := []*dao.Trade{
+		trade(KindBuy, "AAPL", "10", "-1500", date(1, 3)),
+		trade(KindBuy, "MSFT", "2", "-800", date(1, 4)),
+		trade(KindSell, "MSFT", "2", "820", date(2, 1)),
+		trade(KindBuy, "NVDA", "1", "-500", date(3, 1)),
+	}
+	prices := map[string]*Price{"AAPL": {Ticker: "AAPL", PricedOn: date(2, 28), Price: money.MustParse("170.255"), Currency: "USD"}}
+	price := func(_ context.Context, ticker, currency string, at time.Time) (*Price, error) {
+		assert.Equal(t, "USD", currency)
+		return prices[ticker], nil
+	}
+
+	p, err := Value(ctx, wallet, trades, date(2, 29), price)
+	require.Nil(t, err)
+	require.Len(t, p.Holdings, 2)
+
+	aapl, msft := p.Holdings[0], p.Holdings[1]
+	assert.Equal(t, money.MustParse("170.255"), *aapl.Price)
+	assert.Equal(t, date(2, 28), *aapl.PricedOn)
+	assert.Equal(t, money.MustParse("1702.55"), *aapl.MarketValue)
+	assert.Equal(t, money.MustParse("202.55"), *aapl.UnrealisedGain)
+	assert.Nil(t, msft.Price)
+	assert.Equal(t, money.Decimal(0), *msft.MarketValue)
+
+	assert.Equal(t, money.MustParse("1500"), p.CostBasis)
+	assert.Equal(t, money.MustParse("1702.55"), *p.MarketValue)
+	assert.Equal(t, money.MustParse("202.55"), *p.UnrealisedGain)
+	assert.Equal(t, money.MustParse("20"), p.RealisedGain)
+
+	// NVDA bought later has no price, so the portfolio has no market value.
+	p, err = Value(ctx, wallet, trades, date(3, 1), price)
+	require.Nil(t, err)
+	require.Len(t, p.Holdings, 3)
+	assert.Nil(t, p.Holdings[2].MarketValue)
+	assert.Nil(t, p.MarketValue)
+	assert.Nil(t, p.UnrealisedGain)
+	assert.Equal(t, money.MustParse("2000"), p.CostBasis)
+}
+
+func TestPricer(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	for _, price := range []*dao.SecurityPriceUpsertParams{
+		{Ticker: "AAPL", PricedOn: date(3, 1), Price: money.MustParse("180"), Currency: "USD"},
+		{Ticker: "AAPL", PricedOn: date(3, 4), Price: money.MustParse("175"), Currency: "USD"},
+	} {
+		price.Source, price.CreatedAt = "csv", price.PricedOn
+		require.Nil(t, d.SecurityPriceUpsert(ctx, price))
+	}
+	require.Nil(t, d.ExchangeRateUpsert(ctx, &dao.ExchangeRateUpsertParams{
+		Base: "EUR", Currency: "USD", ValidOn: date(3, 1), Rate: 1.08, Source: "csv", CreatedAt: date(3, 1),
+	}))
+	pricer := NewPricer(d, exchange.NewConverter(d))
+
+	price, err := pricer.Price(ctx, "AAPL", "USD", date(3, 3).Add(12*time.Hour))
+	require.Nil(t, err)
+	assert.Equal(t, &Price{Ticker: "AAPL", PricedOn: date(3, 1), Price: money.MustParse("180"), Currency: "USD"}, price)
+
+	// Prices are converted at the rate of their day, which is the latest rate for the price of March 4th.
+	price, err = pricer.Price(ctx, "AAPL", "EUR", date(3, 10))
+	require.Nil(t, err)
+	assert.Equal(t, &Price{Ticker: "AAPL", PricedOn: date(3, 4), Price: money.MustParse("162.037"), Currency: "EUR"}, price)
+
+	price, err = pricer.Price(ctx, "AAPL", "USD", date(2, 29))
+	require.Nil(t, err)
+	assert.Nil(t, price)
+
+	_, err = pricer.Price(ctx, "AAPL", "PLN", date(3, 10))
+	assert.ErrorIs(t, err, exchange.ErrNoRate)
+}
@@ -0,0 +1,137 @@
+package portfolio
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"io"
+	"strings"
+	"time"
+)
+
+var ErrInvalidFile = fmt.Errorf("invalid price file")
+
+// Reader reads prices from a price file one by one. It returns io.EOF after the last price.
+type Reader interface {
+	Read() (*Price, error)
+}
+
+// priceColumns are names of columns holding prices, in order of preference. Files exported from Yahoo Finance or Stooq
+// have a Close column, hand-made files may name it Price.
+var priceColumns = []string{"close", "price"}
+
+// ReadCSV returns a Reader of a CSV price file. Its header names a Date column, in YYYY-MM-DD format, and a Close or a
+// Price column, other columns such as Open or Volume are ignored. Files listing many securities have a Ticker column,
+// and files priced in many currencies have a Currency column, otherwise every price is of ticker in currency. Empty
+// and null prices are skipped.
+func ReadCSV(r io.Reader, ticker, currency string) (Reader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: header is missing", ErrInvalidFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := columns[name]; !ok {
+			columns[name] = i
+		}
+	}
+
+	c := &csvReader{reader: reader, date: -1, price: -1, ticker: -1, currency: -1}
+	if i, ok := columns["date"]; ok {
+		c.date = i
+	}
+	for _, name := range priceColumns {
+		if i, ok := columns[name]; ok {
+			c.price = i
+			break
+		}
+	}
+	if c.date < 0 || c.price < 0 {
+		return nil, fmt.Errorf("%w: header must name Date and Close or Price columns", ErrInvalidFile)
+	}
+
+	if i, ok := columns["ticker"]; ok {
+		c.ticker = i
+	} else if c.defaultTicker, err = NormalizeTicker(ticker); err != nil {
+		return nil, fmt.Errorf("%w: ticker is required without a Ticker column", ErrInvalidFile)
+	}
+
+	if i, ok := columns["currency"]; ok {
+		c.currency = i
+	} else {
+		cur, err := money.LookupCurrency(currency)
+		if err != nil {
+			return nil, fmt.Errorf("%w: currency is required without a Currency column: %w", ErrInvalidFile, err)
+		}
+		c.defaultCurrency = cur.Code
+	}
+
+	return c, nil
+}
+
+type csvReader struct {
+	reader *csv.Reader
+	// date, price, ticker and currency are indexes of columns, or -1 for missing columns.
+	date, price, ticker, currency  int
+	defaultTicker, defaultCurrency string
+}
+
+func (r *csvReader) Read() (*Price, error) {
+	for {
+		row, err := r.reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+		}
+
+		line, _ := r.reader.FieldPos(0)
+		value := strings.TrimSpace(field(row, r.price))
+		if value == "" || strings.EqualFold(value, "null") {
+			continue
+		}
+
+		price := &Price{Ticker: r.defaultTicker, Currency: r.defaultCurrency}
+		if price.PricedOn, err = time.Parse(time.DateOnly, strings.TrimSpace(field(row, r.date))); err != nil {
+			return nil, fmt.Errorf("%w: line %d: invalid date %q", ErrInvalidFile, line, field(row, r.date))
+		}
+		if price.Price, err = money.ParseRound(value); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidFile, line, err)
+		}
+		if !price.Price.IsPositive() {
+			return nil, fmt.Errorf("%w: line %d: price must be positive", ErrInvalidFile, line)
+		}
+		if r.ticker >= 0 {
+			if price.Ticker, err = NormalizeTicker(field(row, r.ticker)); err != nil {
+				return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidFile, line, err)
+			}
+		}
+		if r.currency >= 0 {
+			currency, err := money.LookupCurrency(field(row, r.currency))
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidFile, line, err)
+			}
+			price.Currency = currency.Code
+		}
+		return price, nil
+	}
+}
+
+// field returns the column i of row, or an empty string for short rows.
+func field(row []string, i int) string {
+	if i < 0 || i >= len(row) {
+		return ""
+	}
+	return row[i]
+}
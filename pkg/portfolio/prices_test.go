@@ -0,0 +1,116 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+)
+
+func readAll(r Reader) ([]*Price, error) {
+	var prices []*Price
+	for {
+		price, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return prices, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, price)
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	tests := map[string]struct {
+		file             string
+		ticker, currency string
+		want             []*Price
+	}{
+		"yahoo finance": {
+			file: "Date,Open,High,Low,Close,Adj Close,Volume\n" +
+				"2024-03-01,179.55,180.53,177.38,179.660004,179.2,73488000\n" +
+				"2024-03-04,176.15,176.9,173.79,null,null,null\n",
+			ticker: "aapl", currency: "usd",
+			want: []*Price{{Ticker: "AAPL", PricedOn: date(3, 1), Price: money.MustParse("179.66"), Currency: "USD"}},
+		},
+		"many securities": {
+			file: "ticker,date,price,currency\nVWCE.DE,2024-03-01,113.5,EUR\n7203.T,2024-03-01,3600,jpy\n",
+			want: []*Price{
+				{Ticker: "VWCE.DE", PricedOn: date(3, 1), Price: money.MustParse("113.5"), Currency: "EUR"},
+				{Ticker: "7203.T", PricedOn: date(3, 1), Price: money.MustParse("3600"), Currency: "JPY"},
+			},
+		},
+		"short row": {
+			file:   "Date,Price\n2024-03-01\n2024-03-04, 101.25\n",
+			ticker: "bond", currency: "PLN",
+			want: []*Price{{Ticker: "BOND", PricedOn: date(3, 4), Price: money.MustParse("101.25"), Currency: "PLN"}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, err := ReadCSV(strings.NewReader(tt.file), tt.ticker, tt.currency)
+			require.Nil(t, err)
+			prices, err := readAll(r)
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, prices)
+		})
+	}
+
+	invalidHeaders := map[string]string{
+		"empty":       "",
+		"no date":     "Close\n",
+		"no price":    "Date,Open\n",
+		"no ticker":   "Date,Close,Currency\n",
+		"no currency": "Date,Close,Ticker\n",
+	}
+	for name, header := range invalidHeaders {
+		_, err := ReadCSV(strings.NewReader(header), "", "")
+		assert.ErrorIs(t, err, ErrInvalidFile, name)
+	}
+
+	invalidRows := map[string]string{
+		"date":     "Date,Close\n01.03.2024,1\n",
+		"price":    "Date,Close\n2024-03-01,1,5\n2024-03-04,x\n",
+		"negative": "Date,Close\n2024-03-01,-1\n",
+	}
+	for name, file := range invalidRows {
+		r, err := ReadCSV(strings.NewReader(file), "AAPL", "USD")
+		require.Nil(t, err, name)
+		_, err = readAll(r)
+		assert.ErrorIs(t, err, ErrInvalidFile, name)
+	}
+}
+
+func TestStore_Import(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	store := NewStore(d)
+
+	r, err := ReadCSV(strings.NewReader("Date,Close\n2024-03-01,180\n2024-03-04,175\n"), "AAPL", "USD")
+	require.Nil(t, err)
+	count, err := store.Import(ctx, r, "csv")
+	require.Nil(t, err)
+	assert.Equal(t, 2, count)
+
+	// Importing a day again replaces its price, invalid prices roll back the whole file.
+	r, err = ReadCSV(strings.NewReader("Date,Close\n2024-03-04,176\n"), "AAPL", "USD")
+	require.Nil(t, err)
+	_, err = store.Import(ctx, r, "csv")
+	require.Nil(t, err)
+
+	r, err = ReadCSV(strings.NewReader("Date,Close\n2024-03-05,177\n2024-03-06,0\n"), "AAPL", "USD")
+	require.Nil(t, err)
+	_, err = store.Import(ctx, r, "csv")
+	assert.ErrorIs(t, err, ErrInvalidFile)
+
+	price, err := d.SecurityPriceLatest(ctx, "AAPL", date(3, 10))
+	require.Nil(t, err)
+	assert.Equal(t, date(3, 4), price.PricedOn.UTC())
+	assert.Equal(t, money.MustParse("176"), price.Price)
+}
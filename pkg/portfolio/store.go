@@ -0,0 +1,57 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/exchange"
+	"io"
+	"time"
+)
+
+// Store loads price files into the database, prices are then read by Pricers.
+type Store struct {
+	db *dao.DAO
+}
+
+func NewStore(db *dao.DAO) *Store {
+	return &Store{
+		db: db,
+	}
+}
+
+// Import stores all prices read by r in one transaction, replacing prices of the same securities and days. Source names
+// the format of the file. Any invalid price rolls back the whole import. It returns the number of stored prices.
+func (s *Store) Import(ctx context.Context, r Reader, source string) (int, error) {
+	q, rollBacker, err := s.db.BeginTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("cannot import prices: %w", err)
+	}
+	defer rollBacker()
+
+	now := time.Now().UTC()
+	count := 0
+	for {
+		price, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return count, q.Commit(ctx)
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		err = q.SecurityPriceUpsert(ctx, &dao.SecurityPriceUpsertParams{
+			Ticker:    price.Ticker,
+			PricedOn:  exchange.Day(price.PricedOn),
+			Price:     price.Price,
+			Currency:  price.Currency,
+			Source:    source,
+			CreatedAt: now,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("cannot store price: %w", err)
+		}
+		count++
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/piotrekmonko/portfello/pkg/auth"
 	"github.com/piotrekmonko/portfello/pkg/dao"
 	"github.com/piotrekmonko/portfello/pkg/logz"
+	"github.com/piotrekmonko/portfello/pkg/money"
 	"github.com/spf13/cobra"
 	"time"
 )
@@ -82,7 +83,6 @@ func (p *Provisioner) insertTestExpenses(ctx context.Context, q dao.DBInterface)
 	testWallet := &dao.WalletInsertParams{
 		ID:        shortuuid.New(),
 		UserID:    testUserID,
-		Balance:   0.0,
 		Currency:  "USD",
 		CreatedAt: expensesEarliestDate.UTC(),
 	}
@@ -90,17 +90,23 @@ func (p *Provisioner) insertTestExpenses(ctx context.Context, q dao.DBInterface)
 		return p.log.Errorw(ctx, err, "cannot insert a wallet", "args", testWallet)
 	}
 
+	var balance money.Decimal
 	for i := 0; i < numExpenses; i++ {
 		testExpense := &dao.ExpenseInsertParams{
 			ID:          shortuuid.New(),
 			WalletID:    testWallet.ID,
-			Amount:      gofakeit.Float64Range(-100, 100),
+			Amount:      money.New(int64(gofakeit.IntRange(-10000, 10000)), 2),
 			Description: dao.NilStr(gofakeit.HackerPhrase()),
 			CreatedAt:   gofakeit.DateRange(expensesEarliestDate.Add(time.Hour*24*time.Duration(i)), time.Now().UTC()),
 		}
 		if err := q.ExpenseInsert(ctx, testExpense); err != nil {
 			return p.log.Errorw(ctx, err, "cannot insert an expense", "args", testExpense)
 		}
+		balance += testExpense.Amount
+	}
+
+	if err := q.WalletUpdateBalance(ctx, balance, testWallet.ID); err != nil {
+		return p.log.Errorw(ctx, err, "cannot update wallet balance", "walletID", testWallet.ID)
 	}
 
 	p.log.Infof(ctx, "created user %s's wallet with %d expenses", testUserID, numExpenses)
@@ -0,0 +1,125 @@
+package recurring
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidRule = fmt.Errorf("invalid recurrence rule")
+
+// Frequency is the base unit of a recurrence rule.
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+	FrequencyYearly  Frequency = "YEARLY"
+)
+
+// Rule is a subset of iCalendar RRULE, eg. "FREQ=MONTHLY;INTERVAL=3;COUNT=4" for four quarterly occurrences.
+// Occurrences repeat every Interval units of Freq from the start time, at its time of day.
+type Rule struct {
+	Freq Frequency
+	// Interval defaults to 1.
+	Interval int
+	// Count limits the number of occurrences, zero means no limit.
+	Count int
+}
+
+// ParseRule reads a rule in RRULE format. An optional "RRULE:" prefix is accepted.
+func ParseRule(s string) (*Rule, error) {
+	rule := &Rule{Interval: 1}
+
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	for _, part := range strings.Split(s, ";") {
+		name, value, found := strings.Cut(part, "=")
+		if !found {
+			return nil, fmt.Errorf("%w: expected NAME=VALUE, got %q", ErrInvalidRule, part)
+		}
+
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			rule.Freq = Frequency(strings.ToUpper(value))
+			switch rule.Freq {
+			case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+			default:
+				return nil, fmt.Errorf("%w: unsupported FREQ %s", ErrInvalidRule, value)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("%w: INTERVAL must be a positive number", ErrInvalidRule)
+			}
+			rule.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("%w: COUNT must be a positive number", ErrInvalidRule)
+			}
+			rule.Count = n
+		default:
+			return nil, fmt.Errorf("%w: unsupported part %s", ErrInvalidRule, name)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	}
+
+	return rule, nil
+}
+
+// String returns the rule in RRULE format.
+func (r *Rule) String() string {
+	s := "FREQ=" + string(r.Freq) + ";INTERVAL=" + strconv.Itoa(r.Interval)
+	if r.Count > 0 {
+		s += ";COUNT=" + strconv.Itoa(r.Count)
+	}
+	return s
+}
+
+// Occurrence returns the time of occurrence n of a rule starting at start, counting from zero. It does not check
+// whether the rule has ended.
+func (r *Rule) Occurrence(start time.Time, n int) time.Time {
+	step := n * r.Interval
+	switch r.Freq {
+	case FrequencyDaily:
+		return start.AddDate(0, 0, step)
+	case FrequencyWeekly:
+		return start.AddDate(0, 0, 7*step)
+	case FrequencyYearly:
+		return AddMonths(start, 12*step)
+	default:
+		return AddMonths(start, step)
+	}
+}
+
+// Next returns the time of occurrence n of a rule starting at start and ending at end, or false when the rule ends
+// before it.
+func (r *Rule) Next(start time.Time, end sql.NullTime, n int) (time.Time, bool) {
+	if r.Count > 0 && n >= r.Count {
+		return time.Time{}, false
+	}
+
+	at := r.Occurrence(start, n)
+	if end.Valid && at.After(end.Time) {
+		return time.Time{}, false
+	}
+
+	return at, true
+}
+
+// AddMonths adds n months to t. Days past the end of resulting month are moved to its last day, so what happens on
+// the 31st happens on the last day of shorter months.
+func AddMonths(t time.Time, n int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	day := t.Day()
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
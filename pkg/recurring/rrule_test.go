@@ -0,0 +1,62 @@
+package recurring
+
+import (
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestParseRule(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "FREQ=MONTHLY", want: "FREQ=MONTHLY;INTERVAL=1"},
+		{in: "RRULE:freq=weekly;interval=2;count=10", want: "FREQ=WEEKLY;INTERVAL=2;COUNT=10"},
+		{in: "FREQ=YEARLY;COUNT=3", want: "FREQ=YEARLY;INTERVAL=1;COUNT=3"},
+		{in: "INTERVAL=2", wantErr: true},
+		{in: "FREQ=HOURLY", wantErr: true},
+		{in: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{in: "FREQ=DAILY;BYDAY=MO", wantErr: true},
+		{in: "FREQ", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			rule, err := ParseRule(tt.in)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidRule)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, rule.String())
+		})
+	}
+}
+
+func TestRule_Next(t *testing.T) {
+	start := time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC)
+	end := sql.NullTime{Time: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+
+	monthly, err := ParseRule("FREQ=MONTHLY")
+	require.Nil(t, err)
+	var got []string
+	for n := 0; ; n++ {
+		at, ok := monthly.Next(start, end, n)
+		if !ok {
+			break
+		}
+		got = append(got, at.Format(time.DateOnly))
+	}
+	assert.Equal(t, []string{"2024-01-31", "2024-02-29", "2024-03-31", "2024-04-30"}, got)
+
+	biweekly, err := ParseRule("FREQ=WEEKLY;INTERVAL=2;COUNT=2")
+	require.Nil(t, err)
+	at, ok := biweekly.Next(start, sql.NullTime{}, 1)
+	assert.True(t, ok)
+	assert.Equal(t, start.AddDate(0, 0, 14), at)
+	_, ok = biweekly.Next(start, sql.NullTime{}, 2)
+	assert.False(t, ok)
+}
@@ -0,0 +1,189 @@
+package recurring
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/piotrekmonko/portfello/pkg/access"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/logz"
+	"time"
+)
+
+// Kinds of operations created by recurring rules.
+const (
+	KindExpense = "expense"
+	KindIncome  = "income"
+)
+
+// DefaultInterval is how often Scheduler looks for due occurrences.
+const DefaultInterval = time.Minute
+
+// Scheduler creates operations of recurring rules once they are due. Every rule is handled in its own transaction,
+// which first claims the occurrences it creates, so schedulers running at the same time never create an operation
+// twice.
+type Scheduler struct {
+	log      logz.Logger
+	db       dao.DBInterface
+	clock    Clock
+	interval time.Duration
+}
+
+func NewScheduler(log *logz.Log, db *dao.DAO) *Scheduler {
+	return newScheduler(log.Named("recurring"), db, realClock{}, DefaultInterval)
+}
+
+func newScheduler(log logz.Logger, db dao.DBInterface, clock Clock, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		log:      log,
+		db:       db,
+		clock:    clock,
+		interval: interval,
+	}
+}
+
+// Run creates due operations every interval until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	for {
+		n, err := s.RunUntil(ctx, s.clock.Now())
+		if err != nil {
+			_ = s.log.Errorw(ctx, err, "cannot create recurring operations")
+		}
+		if n > 0 {
+			s.log.Infow(ctx, "created recurring operations", "count", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.clock.After(s.interval):
+		}
+	}
+}
+
+// RunUntil creates operations of all occurrences due at or before until and returns their number. A rule failing does
+// not stop the others, failures are logged and returned together once all rules have run.
+func (s *Scheduler) RunUntil(ctx context.Context, until time.Time) (int, error) {
+	rules, err := s.db.RecurringListDue(ctx, dao.NilTime(until.UTC()))
+	if err != nil {
+		return 0, fmt.Errorf("cannot list due recurring rules: %w", err)
+	}
+
+	var (
+		created int
+		errs    []error
+	)
+	for _, rule := range rules {
+		n, err := s.runRule(ctx, rule, until.UTC())
+		if err != nil {
+			_ = s.log.Errorw(ctx, err, "cannot run recurring rule", "rule", rule.ID)
+			errs = append(errs, fmt.Errorf("recurring rule %s: %w", rule.ID, err))
+			continue
+		}
+		created += n
+	}
+
+	return created, errors.Join(errs...)
+}
+
+// runRule creates due operations of a single rule. Rules whose owner can no longer change their wallet are ended.
+func (s *Scheduler) runRule(ctx context.Context, rule *dao.Recurring, until time.Time) (int, error) {
+	rrule, err := ParseRule(rule.Rrule)
+	if err != nil {
+		return 0, err
+	}
+
+	q, rollBacker, err := s.db.BeginTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("cannot create recurring operations: %w", err)
+	}
+	defer rollBacker()
+
+	_, userAccess, err := access.Wallet(ctx, q, rule.UserID, rule.WalletID)
+	if err != nil && !errors.Is(err, access.ErrWalletNotFound) {
+		return 0, fmt.Errorf("cannot check wallet access: %w", err)
+	}
+	if access.Rank[userAccess] < access.Rank[access.Editor] {
+		if claimed, err := s.advance(ctx, q, rule, sql.NullTime{}, int(rule.Occurrences)); err != nil || !claimed {
+			return 0, err
+		}
+		if err = q.Audit(ctx, rule.Email, "recurring", rule.ID, "ended, owner can no longer change the wallet"); err != nil {
+			return 0, err
+		}
+		return 0, q.Commit(ctx)
+	}
+
+	var times []time.Time
+	n := int(rule.Occurrences)
+	next, ok := rrule.Next(rule.StartsAt, rule.EndsAt, n)
+	for ok && !next.After(until) {
+		times = append(times, next)
+		n++
+		next, ok = rrule.Next(rule.StartsAt, rule.EndsAt, n)
+	}
+	if !ok {
+		next = time.Time{}
+	}
+
+	// Another scheduler has already created these occurrences.
+	if claimed, err := s.advance(ctx, q, rule, dao.NilTime(next), n); err != nil || !claimed {
+		return 0, err
+	}
+
+	for _, at := range times {
+		if err = s.createOperation(ctx, q, rule, at); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(times), q.Commit(ctx)
+}
+
+// advance moves rule to occurrence n, due at next, unless another transaction has moved it already.
+func (s *Scheduler) advance(ctx context.Context, q dao.DBInterface, rule *dao.Recurring, next sql.NullTime, n int) (bool, error) {
+	rows, err := q.RecurringAdvance(ctx, next, int32(n), rule.ID, rule.Occurrences)
+	if err != nil {
+		return false, fmt.Errorf("cannot advance recurring rule: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// createOperation creates the operation of rule occurring at time at and updates its wallet balance.
+func (s *Scheduler) createOperation(ctx context.Context, q dao.DBInterface, rule *dao.Recurring, at time.Time) error {
+	id := shortuuid.New()
+	switch rule.Kind {
+	case KindIncome:
+		err := q.IncomeInsert(ctx, &dao.IncomeInsertParams{
+			ID:          id,
+			WalletID:    rule.WalletID,
+			Amount:      rule.Amount,
+			Description: rule.Description,
+			CreatedAt:   at,
+		})
+		if err != nil {
+			return fmt.Errorf("cannot create recurring income: %w", err)
+		}
+	default:
+		err := q.ExpenseInsert(ctx, &dao.ExpenseInsertParams{
+			ID:          id,
+			WalletID:    rule.WalletID,
+			Amount:      rule.Amount,
+			Description: rule.Description,
+			CategoryID:  rule.CategoryID,
+			CreatedAt:   at,
+		})
+		if err != nil {
+			return fmt.Errorf("cannot create recurring expense: %w", err)
+		}
+	}
+
+	if err := q.UpdateBalance(ctx, rule.WalletID, rule.Amount, at); err != nil {
+		return err
+	}
+
+	event := fmt.Sprintf("created in wallet %s with amount %s by recurring rule %s", rule.WalletID, rule.Amount, rule.ID)
+	return q.Audit(ctx, rule.Email, rule.Kind, id, event)
+}
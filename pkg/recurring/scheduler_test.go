@@ -0,0 +1,218 @@
+package recurring
+
+import (
+	"context"
+	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/dao/daotest"
+	"github.com/piotrekmonko/portfello/pkg/logz"
+	"github.com/piotrekmonko/portfello/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock tells a time which only moves on Advance.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	timers  []fakeTimer
+	waiting chan struct{}
+}
+
+type fakeTimer struct {
+	at time.Time
+	c  chan time.Time
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now, waiting: make(chan struct{}, 1)}
+}
+
+func (f *fakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+func (f *fakeClock) After(d time.Duration) <-chan time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c := make(chan time.Time, 1)
+	f.timers = append(f.timers, fakeTimer{at: f.now.Add(d), c: c})
+	f.waiting <- struct{}{}
+	return c
+}
+
+// Advance moves the time forward by d, firing timers which are due.
+func (f *fakeClock) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+	timers := f.timers[:0]
+	for _, timer := range f.timers {
+		if timer.at.After(f.now) {
+			timers = append(timers, timer)
+			continue
+		}
+		timer.c <- f.now
+	}
+	f.timers = timers
+}
+
+// BlockUntilWaiting returns once a caller waits on After.
+func (f *fakeClock) BlockUntilWaiting(t *testing.T) {
+	select {
+	case <-f.waiting:
+	case <-time.After(5 * time.Second):
+		t.Fatal("scheduler did not wait for the clock")
+	}
+}
+
+func insertRule(t *testing.T, d *dao.DAO, rule *dao.RecurringInsertParams) {
+	rrule, err := ParseRule(rule.Rrule)
+	require.Nil(t, err)
+	next, _ := rrule.Next(rule.StartsAt, rule.EndsAt, 0)
+	rule.NextAt = dao.NilTime(next)
+	require.Nil(t, d.RecurringInsert(context.Background(), rule))
+}
+
+func TestScheduler_RunUntil(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: "u1", Currency: "PLN", CreatedAt: start}))
+	insertRule(t, d, &dao.RecurringInsertParams{
+		ID: "rent", UserID: "u1", Email: "one@example.com", WalletID: "w1", Kind: KindExpense,
+		Amount: money.MustParse("-1000"), Rrule: "FREQ=MONTHLY", StartsAt: start,
+	})
+	insertRule(t, d, &dao.RecurringInsertParams{
+		ID: "salary", UserID: "u1", Email: "one@example.com", WalletID: "w1", Kind: KindIncome,
+		Amount: money.MustParse("3000"), Rrule: "FREQ=MONTHLY;COUNT=2", StartsAt: start.AddDate(0, 0, 9),
+	})
+	s := newScheduler(logz.NewTestLogger(t), d, newFakeClock(start), DefaultInterval)
+
+	n, err := s.RunUntil(ctx, start.AddDate(0, 3, 0))
+	require.Nil(t, err)
+	assert.Equal(t, 6, n, "four rents and two salaries")
+
+	// Running again creates nothing, as occurrences are already created.
+	n, err = s.RunUntil(ctx, start.AddDate(0, 3, 0))
+	require.Nil(t, err)
+	assert.Equal(t, 0, n)
+
+	wallet, err := d.WalletGetByID(ctx, "w1")
+	require.Nil(t, err)
+	assert.Equal(t, money.MustParse("2000"), wallet.Balance)
+
+	rent, err := d.RecurringGetByID(ctx, "rent")
+	require.Nil(t, err)
+	assert.Equal(t, int32(4), rent.Occurrences)
+	assert.Equal(t, start.AddDate(0, 4, 0), rent.NextAt.Time)
+
+	salary, err := d.RecurringGetByID(ctx, "salary")
+	require.Nil(t, err)
+	assert.False(t, salary.NextAt.Valid, "salary rule has ended")
+
+	// A rule claimed by another run is skipped.
+	rent.Occurrences--
+	n, err = s.runRule(ctx, rent, start.AddDate(0, 5, 0))
+	require.Nil(t, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestScheduler_RunEndsRuleWithoutAccess(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: "u1", Currency: "PLN", CreatedAt: start}))
+	insertRule(t, d, &dao.RecurringInsertParams{
+		ID: "r1", UserID: "u2", Email: "two@example.com", WalletID: "w1", Kind: KindExpense,
+		Amount: money.MustParse("-10"), Rrule: "FREQ=DAILY", StartsAt: start,
+	})
+	s := newScheduler(logz.NewTestLogger(t), d, newFakeClock(start), DefaultInterval)
+
+	n, err := s.RunUntil(ctx, start.AddDate(0, 0, 3))
+	require.Nil(t, err)
+	assert.Equal(t, 0, n)
+
+	rule, err := d.RecurringGetByID(ctx, "r1")
+	require.Nil(t, err)
+	assert.False(t, rule.NextAt.Valid)
+}
+
+func TestScheduler_RunUntilContinuesAfterFailure(t *testing.T) {
+	ctx := context.Background()
+	d := daotest.New(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: "u1", Currency: "PLN", CreatedAt: start}))
+	// The broken rule is due first, its rrule cannot be parsed.
+	require.Nil(t, d.RecurringInsert(ctx, &dao.RecurringInsertParams{
+		ID: "broken", UserID: "u1", Email: "one@example.com", WalletID: "w1", Kind: KindExpense,
+		Amount: money.MustParse("-10"), Rrule: "FREQ=SOMETIMES", StartsAt: start, NextAt: dao.NilTime(start),
+	}))
+	insertRule(t, d, &dao.RecurringInsertParams{
+		ID: "rent", UserID: "u1", Email: "one@example.com", WalletID: "w1", Kind: KindExpense,
+		Amount: money.MustParse("-1000"), Rrule: "FREQ=MONTHLY", StartsAt: start.Add(time.Hour),
+	})
+	s := newScheduler(logz.NewTestLogger(t), d, newFakeClock(start), DefaultInterval)
+
+	n, err := s.RunUntil(ctx, start.AddDate(0, 1, 0).Add(time.Hour))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "recurring rule broken")
+	assert.Equal(t, 2, n, "the healthy rule runs after the broken one")
+
+	wallet, err := d.WalletGetByID(ctx, "w1")
+	require.Nil(t, err)
+	assert.Equal(t, money.MustParse("-2000"), wallet.Balance)
+
+	broken, err := d.RecurringGetByID(ctx, "broken")
+	require.Nil(t, err)
+	assert.Equal(t, int32(0), broken.Occurrences)
+	assert.Equal(t, start, broken.NextAt.Time, "the broken rule is retried on the next run")
+}
+
+func TestScheduler_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := daotest.New(t)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	require.Nil(t, d.WalletInsert(ctx, &dao.WalletInsertParams{ID: "w1", UserID: "u1", Currency: "PLN", CreatedAt: start}))
+	insertRule(t, d, &dao.RecurringInsertParams{
+		ID: "coffee", UserID: "u1", Email: "one@example.com", WalletID: "w1", Kind: KindExpense,
+		Amount: money.MustParse("-3"), Rrule: "FREQ=DAILY", StartsAt: start.Add(time.Hour),
+	})
+	clock := newFakeClock(start)
+	s := newScheduler(logz.NewTestLogger(t), d, clock, time.Hour)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(ctx)
+	}()
+
+	balance := func() money.Decimal {
+		wallet, err := d.WalletGetByID(ctx, "w1")
+		require.Nil(t, err)
+		return wallet.Balance
+	}
+
+	clock.BlockUntilWaiting(t)
+	assert.Equal(t, money.Decimal(0), balance(), "nothing is due yet")
+
+	clock.Advance(time.Hour)
+	clock.BlockUntilWaiting(t)
+	assert.Equal(t, money.MustParse("-3"), balance())
+
+	clock.Advance(48 * time.Hour)
+	clock.BlockUntilWaiting(t)
+	assert.Equal(t, money.MustParse("-9"), balance())
+
+	cancel()
+	clock.Advance(time.Hour)
+	<-done
+}
@@ -8,13 +8,14 @@ import (
 	"github.com/piotrekmonko/portfello/pkg/auth"
 	"github.com/piotrekmonko/portfello/pkg/conf"
 	"github.com/piotrekmonko/portfello/pkg/dao"
+	"github.com/piotrekmonko/portfello/pkg/export"
 	"github.com/piotrekmonko/portfello/pkg/graph"
 	"github.com/piotrekmonko/portfello/pkg/logz"
 	"net/http"
 )
 
 // NewRouter builds routing mux, registers handlers and health checks.
-func NewRouter(conf *conf.Config, dbQuerier *dao.DAO, authService *auth.Service) (*http.ServeMux, error) {
+func NewRouter(log *logz.Log, conf *conf.Config, dbQuerier *dao.DAO, authService *auth.Service) (*http.ServeMux, error) {
 	healthChecks, err := health.New(
 		health.WithComponent(health.Component{
 			Name:    "portfello",
@@ -37,7 +38,8 @@ func NewRouter(conf *conf.Config, dbQuerier *dao.DAO, authService *auth.Service)
 	mux := http.NewServeMux()
 	mux.Handle("/log/level", logz.AtomicLevel)
 	mux.Handle("/healthcheck", healthChecks.Handler())
-	mux.Handle("/query", graph.NewGraphHandler(conf, dbQuerier, authService))
+	mux.Handle("/query", graph.NewGraphHandler(log, conf, dbQuerier, authService))
+	mux.Handle("/export", authService.Middleware(export.NewHandler(export.NewExporter(dbQuerier, authService))))
 
 	if conf.Graph.EnablePlayground {
 		mux.Handle("/", playground.Handler("GraphQL playground", "/query"))